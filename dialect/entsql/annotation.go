@@ -4,7 +4,11 @@
 
 package entsql
 
-import "entgo.io/ent/schema"
+import (
+	"fmt"
+
+	"entgo.io/ent/schema"
+)
 
 // Annotation is a builtin schema annotation for attaching
 // SQL metadata to schema objects for both codegen and runtime.
@@ -104,6 +108,154 @@ type Annotation struct {
 	//	}
 	//
 	Checks map[string]string `json:"checks,omitempty"`
+
+	// Generated defines the column as a database-generated column. Generated fields
+	// are excluded from the create and update builders, and their values are read
+	// back from the database after the entity was created or updated. For example:
+	//
+	//	field.String("full_name").
+	//		Annotations(entsql.Annotation{
+	//			Generated: &entsql.Generated{
+	//				Expr:   "first_name || ' ' || last_name",
+	//				Stored: true,
+	//			},
+	//		})
+	//
+	//	CREATE TABLE `users`(..., `full_name` varchar(255) GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED NOT NULL)
+	//
+	Generated *Generated `json:"generated,omitempty"`
+
+	// Position defines the position of the column in its table. Columns are
+	// ordered by their definition in the schema by default. For example:
+	//
+	//	field.Time("created_at").
+	//		Annotations(entsql.Annotation{
+	//			Position: &entsql.Position{After: "payload"},
+	//		})
+	//
+	Position *Position `json:"position,omitempty"`
+
+	// FullText marks a string field as full-text searchable. The migration creates
+	// a FULLTEXT index in MySQL, a generated tsvector column with a GIN index in
+	// PostgreSQL, and an FTS5 virtual table (kept in sync by triggers) in SQLite.
+	// For example:
+	//
+	//	field.Text("bio").
+	//		Annotations(entsql.Annotation{
+	//			FullText: &entsql.FullText{},
+	//		})
+	//
+	FullText *FullText `json:"full_text,omitempty"`
+
+	// RowLevelSecurity enables PostgreSQL Row-Level Security (RLS) on the table, and defines
+	// its policies. The policies are enforced by the database on every statement, including
+	// raw SQL and statements that were modified using the sql/modifier feature. Session
+	// variables that are used by the policies are set by the SQL driver from the context
+	// (see sql.WithVar). The option is ignored by the other dialects. For example:
+	//
+	//	entsql.Annotation{
+	//		RowLevelSecurity: &entsql.RowLevelSecurity{
+	//			Policies: []*entsql.RowPolicy{
+	//				{
+	//					Name:  "tenant_isolation",
+	//					Using: "tenant_id = " + entsql.CurrentSetting("app.tenant_id") + "::bigint",
+	//				},
+	//			},
+	//		},
+	//	}
+	//
+	RowLevelSecurity *RowLevelSecurity `json:"row_level_security,omitempty"`
+}
+
+// RowLevelSecurity describes the row-level security configuration of a table.
+type RowLevelSecurity struct {
+	// Force applies the policies also to the owner of the table, which
+	// bypasses them by default (FORCE ROW LEVEL SECURITY).
+	Force bool `json:"force,omitempty"`
+
+	// Policies holds the policies of the table. Note that enabling row-level security
+	// without policies denies all rows to roles that are not the owner of the table.
+	Policies []*RowPolicy `json:"policies,omitempty"`
+}
+
+// RowPolicy describes a row-level security policy (CREATE POLICY).
+type RowPolicy struct {
+	// Name of the policy. Must be unique per table.
+	Name string `json:"name"`
+
+	// Command is the command the policy applies to. One of: "ALL" (the
+	// default), "SELECT", "INSERT", "UPDATE" or "DELETE".
+	Command string `json:"command,omitempty"`
+
+	// Roles are the roles the policy applies to. Defaults to PUBLIC.
+	Roles []string `json:"roles,omitempty"`
+
+	// Restrictive defines the policy as restrictive. Restrictive policies must all pass
+	// for a row to be accessible, while at least one of the permissive policies must pass.
+	Restrictive bool `json:"restrictive,omitempty"`
+
+	// Using is the expression that is checked for the existing rows (USING).
+	Using string `json:"using,omitempty"`
+
+	// Check is the expression that is checked for the new rows (WITH CHECK).
+	Check string `json:"check,omitempty"`
+}
+
+// CurrentSetting returns the SQL expression for reading the given session variable (that
+// was set using sql.WithVar) in policy expressions. Unset variables are evaluated as NULL.
+func CurrentSetting(name string) string {
+	return fmt.Sprintf("NULLIF(current_setting('%s', true), '')", name)
+}
+
+// FullText describes the full-text search configuration of a column.
+type FullText struct {
+	// Config is the text search configuration that is used by PostgreSQL
+	// for parsing and normalizing the text. Defaults to "english".
+	Config string `json:"config,omitempty"`
+}
+
+// Position describes the position of a column in its table. It is used by the table creation,
+// and by the migration when columns are added to existing tables (in dialects that support it).
+type Position struct {
+	// First places the column first in the table.
+	First bool `json:"first,omitempty"`
+
+	// After places the column after the column of the given field.
+	// Columns that do not belong to fields (e.g. foreign-keys of
+	// edges) are referenced by their column names.
+	After string `json:"after,omitempty"`
+}
+
+// Generated describes a column whose value is computed or assigned by the database.
+type Generated struct {
+	// Expr is the expression that is used for computing the column value.
+	// An empty expression defines a column that is maintained by the database
+	// (e.g. by a DEFAULT expression or a trigger), and is only read by ent.
+	Expr string `json:"expr,omitempty"`
+
+	// Exprs overrides the Expr option for specific dialects. For example:
+	//
+	//	&entsql.Generated{
+	//		Expr: "first_name || ' ' || last_name",
+	//		Exprs: map[string]string{
+	//			dialect.MySQL: "CONCAT(first_name, ' ', last_name)",
+	//		},
+	//	}
+	//
+	Exprs map[string]string `json:"exprs,omitempty"`
+
+	// Stored indicates the computed value is stored in the table (STORED), rather
+	// than computed when it is read (VIRTUAL). Note that PostgreSQL supports only
+	// stored generated columns, and therefore, this option is implied there.
+	Stored bool `json:"stored,omitempty"`
+}
+
+// ExprFor returns the generation expression of the given dialect.
+func (g Generated) ExprFor(dialect string) string {
+	if expr, ok := g.Exprs[dialect]; ok {
+		return expr
+	}
+	return g.Expr
 }
 
 // Name describes the annotation name.
@@ -156,6 +308,18 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 			a.Checks[name] = check
 		}
 	}
+	if g := ant.Generated; g != nil {
+		a.Generated = g
+	}
+	if p := ant.Position; p != nil {
+		a.Position = p
+	}
+	if f := ant.FullText; f != nil {
+		a.FullText = f
+	}
+	if r := ant.RowLevelSecurity; r != nil {
+		a.RowLevelSecurity = r
+	}
 	return a
 }
 
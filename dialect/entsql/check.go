@@ -0,0 +1,51 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entsql
+
+import (
+	"context"
+
+	"entgo.io/ent"
+)
+
+// CheckValidator is an entity-level validator that is also translated by the
+// code generation to a named CHECK constraint on the table of the schema.
+type CheckValidator struct {
+	// Name is the name of the CHECK constraint.
+	Name string
+	// Expr is the SQL expression of the CHECK constraint.
+	Expr string
+	// Func validates the mutation before save.
+	Func ent.ValidateFunc
+}
+
+// Check returns an entity-level validator that is also translated
+// to a CHECK constraint with the given name and expression. For example:
+//
+//	func (Event) Validators() []ent.Validator {
+//		return []ent.Validator{
+//			entsql.Check("valid_range", "start_time < end_time", func(ctx context.Context, m ent.Mutation) error {
+//				start, _ := m.Field("start_time")
+//				end, _ := m.Field("end_time")
+//				if start != nil && end != nil && !start.(time.Time).Before(end.(time.Time)) {
+//					return errors.New("start_time must be before end_time")
+//				}
+//				return nil
+//			}),
+//		}
+//	}
+//
+// A nil function means that the constraint is checked only by the database.
+func Check(name, expr string, fn ent.ValidateFunc) *CheckValidator {
+	return &CheckValidator{Name: name, Expr: expr, Func: fn}
+}
+
+// Validate implements the ent.Validator interface.
+func (c *CheckValidator) Validate(ctx context.Context, m ent.Mutation) error {
+	if c.Func == nil {
+		return nil
+	}
+	return c.Func(ctx, m)
+}
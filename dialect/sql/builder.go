@@ -16,6 +16,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -44,6 +45,8 @@ type ColumnBuilder struct {
 	modify bool               // modify existing.
 	fk     *ForeignKeyBuilder // foreign-key constraint.
 	check  func(*Builder)     // column checks.
+	first  bool               // first column.
+	after  string             // preceding column.
 }
 
 // Column returns a new ColumnBuilder with the given name.
@@ -79,6 +82,18 @@ func (c *ColumnBuilder) Check(check func(*Builder)) *ColumnBuilder {
 	return c
 }
 
+// First adds the FIRST clause to the ADD COLUMN and MODIFY COLUMN statements in MySQL.
+func (c *ColumnBuilder) First() *ColumnBuilder {
+	c.first, c.after = true, ""
+	return c
+}
+
+// After adds the AFTER clause to the ADD COLUMN and MODIFY COLUMN statements in MySQL.
+func (c *ColumnBuilder) After(column string) *ColumnBuilder {
+	c.first, c.after = false, column
+	return c
+}
+
 // Query returns query representation of a Column.
 func (c *ColumnBuilder) Query() (string, []interface{}) {
 	c.Ident(c.name)
@@ -102,6 +117,13 @@ func (c *ColumnBuilder) Query() (string, []interface{}) {
 		c.WriteString(" CHECK ")
 		c.Nested(c.check)
 	}
+	switch {
+	case c.first:
+		c.WriteString(" FIRST")
+	case c.after != "":
+		c.WriteString(" AFTER ")
+		c.Ident(c.after)
+	}
 	return c.String(), c.args
 }
 
@@ -910,6 +932,8 @@ func (i *InsertBuilder) OnConflict(opts ...ConflictOption) *InsertBuilder {
 type UpdateSet struct {
 	columns []string
 	update  *UpdateBuilder
+	// ignored holds the columns that are set to themselves.
+	ignored map[string]bool
 }
 
 // Table returns the table the `UPSERT` statement is executed on.
@@ -929,30 +953,39 @@ func (u *UpdateSet) UpdateColumns() []string {
 
 // Set sets a column to a given value.
 func (u *UpdateSet) Set(column string, v interface{}) *UpdateSet {
+	delete(u.ignored, column)
 	u.update.Set(column, v)
 	return u
 }
 
 // Add adds a numeric value to the given column.
 func (u *UpdateSet) Add(column string, v interface{}) *UpdateSet {
+	delete(u.ignored, column)
 	u.update.Add(column, v)
 	return u
 }
 
 // SetNull sets a column as null value.
 func (u *UpdateSet) SetNull(column string) *UpdateSet {
+	delete(u.ignored, column)
 	u.update.SetNull(column)
 	return u
 }
 
 // SetIgnore sets the column to itself. For example, "id" = "users"."id".
 func (u *UpdateSet) SetIgnore(name string) *UpdateSet {
-	return u.Set(name, Expr(u.Table().C(name)))
+	u.Set(name, Expr(u.Table().C(name)))
+	if u.ignored == nil {
+		u.ignored = make(map[string]bool)
+	}
+	u.ignored[name] = true
+	return u
 }
 
 // SetExcluded sets the column name to its EXCLUDED/VALUES value.
 // For example, "c" = "excluded"."c", or `c` = VALUES(`c`).
 func (u *UpdateSet) SetExcluded(name string) *UpdateSet {
+	delete(u.ignored, name)
 	switch u.update.Dialect() {
 	case dialect.MySQL:
 		u.update.Set(name, ExprFunc(func(b *Builder) {
@@ -965,6 +998,30 @@ func (u *UpdateSet) SetExcluded(name string) *UpdateSet {
 	return u
 }
 
+// UpdateColumns returns the columns that are changed by the `DO UPDATE` clause
+// of the statement, in case of a conflict. Columns that are set to themselves
+// (e.g. using ResolveWithIgnore) are not returned.
+func (i *InsertBuilder) UpdateColumns() []string {
+	if i.conflict == nil || i.conflict.action.nothing {
+		return nil
+	}
+	u := &UpdateSet{columns: i.columns, update: Dialect(i.dialect).Update(i.table)}
+	for _, f := range i.conflict.action.update {
+		f(u)
+	}
+	var (
+		columns []string
+		seen    = make(map[string]bool)
+	)
+	for _, c := range u.UpdateColumns() {
+		if !u.ignored[c] && !seen[c] {
+			seen[c] = true
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
 // Query returns query representation of an `INSERT INTO` statement.
 func (i *InsertBuilder) Query() (string, []interface{}) {
 	i.WriteString("INSERT INTO ")
@@ -3006,18 +3063,68 @@ func (b *Builder) writeSchema(schema string) {
 
 // Err returns a concatenated error of all errors encountered during
 // the query-building, or were added manually by calling AddError.
+// A single error is returned as is, and multiple errors are returned
+// as one error that can be inspected using errors.Is and errors.As.
 func (b *Builder) Err() error {
-	if len(b.errs) == 0 {
+	switch len(b.errs) {
+	case 0:
 		return nil
+	case 1:
+		return b.errs[0]
 	}
+	return append(multiError(nil), b.errs...)
+}
+
+// multiError holds multiple errors that were added to the builder.
+type multiError []error
+
+// Error implements the error interface.
+func (e multiError) Error() string {
 	br := strings.Builder{}
-	for i := range b.errs {
+	for i := range e {
 		if i > 0 {
 			br.WriteString("; ")
 		}
-		br.WriteString(b.errs[i].Error())
+		br.WriteString(e[i].Error())
+	}
+	return br.String()
+}
+
+// Is reports whether any of the errors matches the target.
+func (e multiError) Is(target error) bool {
+	for i := range e {
+		if errors.Is(e[i], target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error that matches the target, and sets the target to its value.
+// If the target points to a slice of errors (e.g. ValidationErrors), all errors that
+// match it are concatenated into one slice.
+func (e multiError) As(target interface{}) bool {
+	v := reflect.ValueOf(target).Elem()
+	if v.Kind() != reflect.Slice {
+		for i := range e {
+			if errors.As(e[i], target) {
+				return true
+			}
+		}
+		return false
+	}
+	all := reflect.MakeSlice(v.Type(), 0, len(e))
+	for i := range e {
+		match := reflect.New(v.Type())
+		if errors.As(e[i], match.Interface()) {
+			all = reflect.AppendSlice(all, match.Elem())
+		}
+	}
+	if all.Len() == 0 {
+		return false
 	}
-	return fmt.Errorf(br.String())
+	v.Set(all)
+	return true
 }
 
 // An Op represents an operator.
@@ -7,7 +7,9 @@ package sql
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
+	"io/fs"
 	"strconv"
 	"strings"
 	"testing"
@@ -113,6 +115,13 @@ func TestBuilder(t *testing.T) {
 				),
 			wantQuery: "ALTER TABLE `users` ADD COLUMN `group_id` int UNIQUE, ADD CONSTRAINT FOREIGN KEY(`group_id`) REFERENCES `groups`(`id`) ON DELETE CASCADE",
 		},
+		{
+			input: AlterTable("users").
+				AddColumn(Column("tenant_id").Type("int").First()).
+				AddColumn(Column("nickname").Type("varchar(255)").Attr("NULL").After("name")).
+				ModifyColumn(Column("age").Type("int").After("nickname")),
+			wantQuery: "ALTER TABLE `users` ADD COLUMN `tenant_id` int FIRST, ADD COLUMN `nickname` varchar(255) NULL AFTER `name`, MODIFY COLUMN `age` int AFTER `nickname`",
+		},
 		{
 			input: Dialect(dialect.Postgres).AlterTable("users").
 				AddColumn(Column("group_id").Type("int").Attr("UNIQUE")).
@@ -1566,6 +1575,31 @@ func TestBuilder_Err(t *testing.T) {
 	require.EqualError(t, b.Err(), "invalid; unexpected; inner")
 }
 
+type testErrors []error
+
+func (e testErrors) Error() string { return fmt.Sprint([]error(e)) }
+
+func TestBuilder_ErrAs(t *testing.T) {
+	var (
+		b       = Select("*")
+		invalid = errors.New("invalid")
+		pathErr = &fs.PathError{Op: "open", Path: "users", Err: invalid}
+	)
+	b.AddError(testErrors{invalid})
+	b.AddError(pathErr)
+	b.AddError(testErrors{errors.New("unexpected")})
+	err := b.Err()
+	require.True(t, errors.Is(err, invalid))
+	require.False(t, errors.Is(err, fs.ErrNotExist))
+	var perr *fs.PathError
+	require.True(t, errors.As(err, &perr))
+	require.Equal(t, pathErr, perr)
+	var errs testErrors
+	require.True(t, errors.As(err, &errs))
+	require.Len(t, errs, 2, "errors of the same slice type are concatenated")
+	require.EqualError(t, errs[1], "unexpected")
+}
+
 func TestSelector_OrderByExpr(t *testing.T) {
 	query, args := Select("*").
 		From(Table("users")).
@@ -1918,6 +1952,39 @@ func TestInsert_OnConflict(t *testing.T) {
 	})
 }
 
+func TestInsert_UpdateColumns(t *testing.T) {
+	columns := Insert("users").
+		Columns("id", "email", "name").
+		Values(1, "user@example.com", "a8m").
+		OnConflict(
+			ConflictColumns("email"),
+			ResolveWithNewValues(),
+			ResolveWith(func(u *UpdateSet) {
+				u.SetIgnore("id")
+				u.SetIgnore("name")
+				u.SetNull("phone")
+				u.Add("version", 1)
+			}),
+		).
+		UpdateColumns()
+	require.Equal(t, []string{"phone", "email", "version"}, columns)
+
+	columns = Insert("users").
+		Columns("id", "name").
+		Values(1, "a8m").
+		OnConflict(ResolveWithIgnore()).
+		UpdateColumns()
+	require.Empty(t, columns)
+
+	columns = Insert("users").
+		Columns("id", "name").
+		Values(1, "a8m").
+		OnConflict(DoNothing()).
+		UpdateColumns()
+	require.Empty(t, columns)
+	require.Empty(t, Insert("users").Columns("id").Values(1).UpdateColumns())
+}
+
 func TestEscapePatterns(t *testing.T) {
 	q, args := Dialect(dialect.MySQL).
 		Update("users").
@@ -61,8 +61,20 @@ func (d *Driver) BeginTx(ctx context.Context, opts *TxOptions) (dialect.Tx, erro
 	if err != nil {
 		return nil, err
 	}
+	if d.Dialect() != dialect.Postgres {
+		return &Tx{
+			ExecQuerier: Conn{tx},
+			Tx:          tx,
+		}, nil
+	}
+	// Set the session variables stored in the context (see WithVar) at the
+	// start of the transaction, and the new ones before each statement.
+	conn := &varsConn{Conn: Conn{tx}}
+	if err := conn.setVars(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
 	return &Tx{
-		ExecQuerier: Conn{tx},
+		ExecQuerier: conn,
 		Tx:          tx,
 	}, nil
 }
@@ -76,6 +88,50 @@ type Tx struct {
 	driver.Tx
 }
 
+// Savepoint creates a savepoint with the given name in the transaction, and returns
+// a nested transaction that executes its statements after the savepoint. Committing
+// the nested transaction releases the savepoint, and rolling it back undoes all
+// statements executed after the savepoint was created, without affecting the outer
+// transaction. Savepoints are supported by MySQL, PostgreSQL and SQLite.
+//
+// Note that the name is used as-is in the statements, and therefore, it must be a
+// valid identifier, and unique within the outer transaction.
+func Savepoint(ctx context.Context, tx dialect.ExecQuerier, name string) (dialect.Tx, error) {
+	if err := tx.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return nil, err
+	}
+	return &savepointTx{ExecQuerier: tx, ctx: ctx, name: name}, nil
+}
+
+// savepointTx implements the dialect.Tx interface for nested transactions.
+type savepointTx struct {
+	dialect.ExecQuerier
+	ctx  context.Context
+	name string
+	done bool
+}
+
+// Commit releases the savepoint.
+func (tx *savepointTx) Commit() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	return tx.Exec(tx.ctx, "RELEASE SAVEPOINT "+tx.name, []interface{}{}, nil)
+}
+
+// Rollback rolls back the transaction to the savepoint, and releases it.
+func (tx *savepointTx) Rollback() error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+	tx.done = true
+	if err := tx.Exec(tx.ctx, "ROLLBACK TO SAVEPOINT "+tx.name, []interface{}{}, nil); err != nil {
+		return err
+	}
+	return tx.Exec(tx.ctx, "RELEASE SAVEPOINT "+tx.name, []interface{}{}, nil)
+}
+
 // ExecQuerier wraps the standard Exec and Query methods.
 type ExecQuerier interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
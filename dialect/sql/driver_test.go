@@ -0,0 +1,94 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavepoint(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT sp1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO `users` DEFAULT VALUES").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT sp1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT sp1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT sp2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("RELEASE SAVEPOINT sp2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := OpenDB("mysql", db).Tx(ctx)
+	require.NoError(t, err)
+	sp1, err := Savepoint(ctx, tx, "sp1")
+	require.NoError(t, err)
+	require.NoError(t, sp1.Exec(ctx, "INSERT INTO `users` DEFAULT VALUES", []interface{}{}, nil))
+	require.NoError(t, sp1.Rollback())
+	require.ErrorIs(t, sp1.Commit(), sql.ErrTxDone)
+	sp2, err := Savepoint(ctx, tx, "sp2")
+	require.NoError(t, err)
+	require.NoError(t, sp2.Commit())
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestWithVar(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	drv := OpenDB("postgres", db)
+	ctx := WithVar(context.Background(), "app.tenant_id", "1")
+	ctx = WithVar(ctx, "app.user_id", "2")
+	v, ok := VarFromContext(ctx, "app.tenant_id")
+	require.True(t, ok)
+	require.Equal(t, "1", v)
+	_, ok = VarFromContext(context.Background(), "app.tenant_id")
+	require.False(t, ok)
+
+	// Statements outside of transactions are wrapped with a transaction.
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant_id", "1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.user_id", "2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	require.NoError(t, drv.Exec(ctx, `DELETE FROM "users"`, []interface{}{}, nil))
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant_id", "1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.user_id", "2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`SELECT "id" FROM "users"`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectCommit()
+	rows := &Rows{}
+	require.NoError(t, drv.Query(ctx, `SELECT "id" FROM "users"`, []interface{}{}, rows))
+	var ids []int
+	require.NoError(t, ScanSlice(rows, &ids))
+	require.Equal(t, []int{1}, ids)
+	require.NoError(t, rows.Close())
+	require.NoError(t, rows.Close())
+
+	// Variables are set at the start of the transaction, and only changed variables are set again.
+	mock.ExpectBegin()
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant_id", "1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.user_id", "2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("SELECT set_config($1, $2, true)").WithArgs("app.tenant_id", "3").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`DELETE FROM "users"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, `DELETE FROM "users"`, []interface{}{}, nil))
+	require.NoError(t, tx.Exec(WithVar(ctx, "app.tenant_id", "3"), `DELETE FROM "users"`, []interface{}{}, nil))
+	require.NoError(t, tx.Commit())
+
+	// Variables are ignored by dialects that do not support them.
+	mock.ExpectExec("DELETE FROM `users`").WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, OpenDB("mysql", db).Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,279 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package outbox provides the runtime support for the "sql/outbox" codegen feature.
+// When the feature is enabled, the generated client writes a change Event for every
+// mutation into the outbox table, within the same transaction of the mutation. A Relay
+// reads the stored events, dispatches them and marks them as dispatched.
+//
+//	relay := outbox.NewRelay(drv, outbox.DispatchFunc(publish))
+//	client := ent.NewClient(ent.Driver(drv), ent.OutboxNotify(relay.Notify))
+//	go relay.Run(ctx, time.Second)
+//
+package outbox
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// TableName is the name of the outbox table.
+const TableName = "ent_outbox"
+
+// Columns of the outbox table.
+const (
+	ColumnID           = "id"
+	ColumnType         = "type"
+	ColumnOp           = "op"
+	ColumnEntityID     = "entity_id"
+	ColumnChanges      = "changes"
+	ColumnCreatedAt    = "created_at"
+	ColumnDispatchedAt = "dispatched_at"
+)
+
+var (
+	// Columns holds the columns of the outbox table.
+	Columns = []*schema.Column{
+		{Name: ColumnID, Type: field.TypeInt64, Increment: true},
+		{Name: ColumnType, Type: field.TypeString},
+		{Name: ColumnOp, Type: field.TypeString},
+		{Name: ColumnEntityID, Type: field.TypeString},
+		{Name: ColumnChanges, Type: field.TypeJSON, Nullable: true},
+		{Name: ColumnCreatedAt, Type: field.TypeTime},
+		{Name: ColumnDispatchedAt, Type: field.TypeTime, Nullable: true},
+	}
+	// Table holds the schema information of the outbox table. The generated
+	// migration code adds it to the tables of the schema.
+	Table = &schema.Table{
+		Name:       TableName,
+		Columns:    Columns,
+		PrimaryKey: []*schema.Column{Columns[0]},
+		Indexes: []*schema.Index{
+			{Name: "ent_outbox_dispatched_at", Columns: []*schema.Column{Columns[6]}},
+		},
+	}
+)
+
+// Event describes a committed change of a single entity.
+type Event struct {
+	// ID of the event in the outbox table. Events are dispatched by their ID order.
+	ID int64 `json:"id,omitempty"`
+	// Type of the changed entity. e.g. "User".
+	Type string `json:"type"`
+	// Op is the mutation operation. e.g. "OpUpdateOne".
+	Op string `json:"op"`
+	// EntityID is the JSON encoded ID of the changed entity.
+	EntityID json.RawMessage `json:"entity_id"`
+	// Changes holds the changed fields of the entity. For delete
+	// operations, it holds the last values of all entity fields.
+	Changes []*Change `json:"changes,omitempty"`
+	// CreatedAt is the time the event was written to the outbox.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Change describes the change of a single field.
+type Change struct {
+	// Field name.
+	Field string `json:"field"`
+	// Old and New are the JSON encoded values of the field before and after
+	// the mutation. Old is empty for creations and New is empty for deletions.
+	Old json.RawMessage `json:"old,omitempty"`
+	New json.RawMessage `json:"new,omitempty"`
+	// Sensitive indicates the field is sensitive, and its values were omitted.
+	Sensitive bool `json:"sensitive,omitempty"`
+}
+
+// Write writes the given events into the outbox table using the given
+// dialect.ExecQuerier, which is usually the transaction of the mutation.
+func Write(ctx context.Context, eq dialect.ExecQuerier, dialectName string, events ...*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+	insert := sql.Dialect(dialectName).
+		Insert(TableName).
+		Columns(ColumnType, ColumnOp, ColumnEntityID, ColumnChanges, ColumnCreatedAt)
+	now := time.Now().UTC()
+	for _, e := range events {
+		changes, err := json.Marshal(e.Changes)
+		if err != nil {
+			return fmt.Errorf("outbox: encoding changes of %s event: %w", e.Type, err)
+		}
+		if e.CreatedAt.IsZero() {
+			e.CreatedAt = now
+		}
+		insert.Values(e.Type, e.Op, string(e.EntityID), changes, e.CreatedAt)
+	}
+	query, args := insert.Query()
+	if err := eq.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("outbox: writing events: %w", err)
+	}
+	return nil
+}
+
+// Dispatcher dispatches outbox events. For example, by publishing them to a message broker.
+type Dispatcher interface {
+	Dispatch(context.Context, *Event) error
+}
+
+// The DispatchFunc type is an adapter to allow the use of ordinary
+// functions as a Dispatcher.
+type DispatchFunc func(context.Context, *Event) error
+
+// Dispatch calls f(ctx, e).
+func (f DispatchFunc) Dispatch(ctx context.Context, e *Event) error {
+	return f(ctx, e)
+}
+
+// Relay reads events from the outbox table, dispatches them and marks them as dispatched.
+// Events are dispatched at-least-once. i.e. an event may be dispatched more than once if
+// the process crashed after it was dispatched, but before it was marked.
+type Relay struct {
+	drv        dialect.Driver
+	dispatcher Dispatcher
+	batchSize  int
+	notify     chan struct{}
+}
+
+// RelayOption allows configuring the Relay using functional options.
+type RelayOption func(*Relay)
+
+// WithBatchSize sets the maximum number of events processed in one batch. Defaults to 100.
+func WithBatchSize(n int) RelayOption {
+	return func(r *Relay) {
+		r.batchSize = n
+	}
+}
+
+// NewRelay returns a new Relay that reads the events using the given driver,
+// and dispatches them using the given dispatcher.
+func NewRelay(drv dialect.Driver, d Dispatcher, opts ...RelayOption) *Relay {
+	r := &Relay{drv: drv, dispatcher: d, batchSize: 100, notify: make(chan struct{}, 1)}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Notify wakes up the relay if it waits for the next polling interval. It is
+// called by the generated client after transactions with events are committed.
+func (r *Relay) Notify() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run processes the events in the outbox table until the context is canceled.
+// It polls the table every interval, or immediately after Notify was called.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		n, err := r.Process(ctx)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		// Continue immediately if the batch was full.
+		if n == r.batchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		case <-r.notify:
+		}
+	}
+}
+
+// Process processes one batch of events, and returns the number of dispatched events.
+// If the dispatching of an event fails, the events that were dispatched before it are
+// marked, and the error is returned.
+func (r *Relay) Process(ctx context.Context) (n int, err error) {
+	tx, err := r.drv.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	events, err := r.read(ctx, tx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+	ids := make([]driver.Value, 0, len(events))
+	for _, e := range events {
+		if err = r.dispatcher.Dispatch(ctx, e); err != nil {
+			err = fmt.Errorf("outbox: dispatching event %d: %w", e.ID, err)
+			break
+		}
+		ids = append(ids, e.ID)
+	}
+	if len(ids) > 0 {
+		query, args := sql.Dialect(r.drv.Dialect()).
+			Update(TableName).
+			Set(ColumnDispatchedAt, time.Now().UTC()).
+			Where(sql.InValues(ColumnID, ids...)).
+			Query()
+		if merr := tx.Exec(ctx, query, args, nil); merr != nil {
+			return 0, rollback(tx, fmt.Errorf("outbox: marking dispatched events: %w", merr))
+		}
+	}
+	if cerr := tx.Commit(); cerr != nil {
+		return 0, cerr
+	}
+	return len(ids), err
+}
+
+// read reads the next batch of events, and locks them if it is supported by the dialect.
+func (r *Relay) read(ctx context.Context, tx dialect.Tx) ([]*Event, error) {
+	d := r.drv.Dialect()
+	selector := sql.Dialect(d).
+		Select(ColumnID, ColumnType, ColumnOp, ColumnEntityID, ColumnChanges, ColumnCreatedAt).
+		From(sql.Table(TableName)).
+		Where(sql.IsNull(ColumnDispatchedAt)).
+		OrderBy(ColumnID).
+		Limit(r.batchSize)
+	if d != dialect.SQLite {
+		selector.ForUpdate()
+	}
+	query, args := selector.Query()
+	rows := &sql.Rows{}
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("outbox: reading events: %w", err)
+	}
+	defer rows.Close()
+	var events []*Event
+	for rows.Next() {
+		var (
+			e        Event
+			entityID string
+			changes  []byte
+		)
+		if err := rows.Scan(&e.ID, &e.Type, &e.Op, &entityID, &changes, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("outbox: scanning event: %w", err)
+		}
+		e.EntityID = json.RawMessage(entityID)
+		if len(changes) > 0 {
+			if err := json.Unmarshal(changes, &e.Changes); err != nil {
+				return nil, fmt.Errorf("outbox: decoding changes of event %d: %w", e.ID, err)
+			}
+		}
+		events = append(events, &e)
+	}
+	return events, rows.Err()
+}
+
+// rollback calls tx.Rollback and wraps the given error with the rollback error if occurred.
+func rollback(tx dialect.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,124 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrite(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	drv := sql.OpenDB(dialect.MySQL, db)
+	require.NoError(t, Write(context.Background(), drv, dialect.MySQL))
+
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `ent_outbox` (`type`, `op`, `entity_id`, `changes`, `created_at`) VALUES (?, ?, ?, ?, ?), (?, ?, ?, ?, ?)")).
+		WithArgs("User", "OpCreate", "1", []byte(`[{"field":"name","new":"a8m"}]`), sqlmock.AnyArg(), "User", "OpDelete", "2", []byte("null"), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 2))
+	err = Write(context.Background(), drv, dialect.MySQL,
+		&Event{Type: "User", Op: "OpCreate", EntityID: json.RawMessage("1"), Changes: []*Change{{Field: "name", New: json.RawMessage(`"a8m"`)}}},
+		&Event{Type: "User", Op: "OpDelete", EntityID: json.RawMessage("2")},
+	)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRelay_Process(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `type`, `op`, `entity_id`, `changes`, `created_at` FROM `ent_outbox` WHERE `dispatched_at` IS NULL ORDER BY `id` LIMIT 2 FOR UPDATE")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "op", "entity_id", "changes", "created_at"}).
+			AddRow(1, "User", "OpCreate", "1", []byte(`[{"field":"name","new":"a8m"}]`), now).
+			AddRow(2, "User", "OpDelete", "2", []byte("null"), now))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ent_outbox` SET `dispatched_at` = ? WHERE `id` IN (?, ?)")).
+		WithArgs(sqlmock.AnyArg(), 1, 2).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	var events []*Event
+	relay := NewRelay(sql.OpenDB(dialect.MySQL, db), DispatchFunc(func(_ context.Context, e *Event) error {
+		events = append(events, e)
+		return nil
+	}), WithBatchSize(2))
+	n, err := relay.Process(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Len(t, events, 2)
+	require.Equal(t, int64(1), events[0].ID)
+	require.Equal(t, json.RawMessage("1"), events[0].EntityID)
+	require.Equal(t, []*Change{{Field: "name", New: json.RawMessage(`"a8m"`)}}, events[0].Changes)
+	require.Equal(t, "OpDelete", events[1].Op)
+	require.Empty(t, events[1].Changes)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRelay_DispatchError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`, `type`, `op`, `entity_id`, `changes`, `created_at` FROM `ent_outbox` WHERE `dispatched_at` IS NULL ORDER BY `id` LIMIT 100 FOR UPDATE")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "op", "entity_id", "changes", "created_at"}).
+			AddRow(1, "User", "OpCreate", "1", nil, now).
+			AddRow(2, "User", "OpCreate", "2", nil, now))
+	// Only the first event is marked as dispatched.
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ent_outbox` SET `dispatched_at` = ? WHERE `id` IN (?)")).
+		WithArgs(sqlmock.AnyArg(), 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	errDispatch := errors.New("broker is down")
+	relay := NewRelay(sql.OpenDB(dialect.MySQL, db), DispatchFunc(func(_ context.Context, e *Event) error {
+		if e.ID == 2 {
+			return errDispatch
+		}
+		return nil
+	}))
+	n, err := relay.Process(context.Background())
+	require.ErrorIs(t, err, errDispatch)
+	require.Equal(t, 1, n)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRelay_Run(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	query := regexp.QuoteMeta("SELECT `id`, `type`, `op`, `entity_id`, `changes`, `created_at` FROM `ent_outbox`")
+	columns := []string{"id", "type", "op", "entity_id", "changes", "created_at"}
+	// First poll is empty.
+	mock.ExpectBegin()
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns))
+	mock.ExpectCommit()
+	// Second poll is triggered by Notify, and the third poll immediately after it, as the batch was full.
+	mock.ExpectBegin()
+	mock.ExpectQuery(query).WillReturnRows(sqlmock.NewRows(columns).AddRow(1, "User", "OpCreate", "1", nil, time.Now()))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `ent_outbox`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	errBegin := errors.New("connection refused")
+	mock.ExpectBegin().WillReturnError(errBegin)
+
+	var dispatched int
+	relay := NewRelay(sql.OpenDB(dialect.MySQL, db), DispatchFunc(func(context.Context, *Event) error {
+		dispatched++
+		return nil
+	}), WithBatchSize(1))
+	relay.Notify()
+	require.ErrorIs(t, relay.Run(context.Background(), time.Hour), errBegin)
+	require.Equal(t, 1, dispatched)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
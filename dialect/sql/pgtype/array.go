@@ -0,0 +1,223 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package pgtype
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect"
+)
+
+// StringArray represents a PostgreSQL "text[]" column.
+type StringArray []string
+
+// Value implements the driver.Valuer interface.
+func (a StringArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	elems := make([]string, len(a))
+	for i := range a {
+		elems[i] = quote(a[i])
+	}
+	return formatArray(elems), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (a *StringArray) Scan(src interface{}) error {
+	elems, err := scanArray(src)
+	if err != nil || elems == nil {
+		*a = nil
+		return err
+	}
+	arr := make(StringArray, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("pgtype: cannot scan NULL element into StringArray")
+		}
+		arr[i] = *e
+	}
+	*a = arr
+	return nil
+}
+
+// SchemaType returns the database type of the array.
+func (StringArray) SchemaType() map[string]string {
+	return map[string]string{dialect.Postgres: "text[]"}
+}
+
+// Int64Array represents a PostgreSQL "bigint[]" column.
+type Int64Array []int64
+
+// Value implements the driver.Valuer interface.
+func (a Int64Array) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	elems := make([]string, len(a))
+	for i := range a {
+		elems[i] = strconv.FormatInt(a[i], 10)
+	}
+	return formatArray(elems), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (a *Int64Array) Scan(src interface{}) error {
+	elems, err := scanArray(src)
+	if err != nil || elems == nil {
+		*a = nil
+		return err
+	}
+	arr := make(Int64Array, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("pgtype: cannot scan NULL element into Int64Array")
+		}
+		if arr[i], err = strconv.ParseInt(*e, 10, 64); err != nil {
+			return fmt.Errorf("pgtype: parsing Int64Array element: %w", err)
+		}
+	}
+	*a = arr
+	return nil
+}
+
+// SchemaType returns the database type of the array.
+func (Int64Array) SchemaType() map[string]string {
+	return map[string]string{dialect.Postgres: "bigint[]"}
+}
+
+// Float64Array represents a PostgreSQL "double precision[]" column.
+type Float64Array []float64
+
+// Value implements the driver.Valuer interface.
+func (a Float64Array) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	elems := make([]string, len(a))
+	for i := range a {
+		elems[i] = strconv.FormatFloat(a[i], 'g', -1, 64)
+	}
+	return formatArray(elems), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (a *Float64Array) Scan(src interface{}) error {
+	elems, err := scanArray(src)
+	if err != nil || elems == nil {
+		*a = nil
+		return err
+	}
+	arr := make(Float64Array, len(elems))
+	for i, e := range elems {
+		if e == nil {
+			return errors.New("pgtype: cannot scan NULL element into Float64Array")
+		}
+		if arr[i], err = strconv.ParseFloat(*e, 64); err != nil {
+			return fmt.Errorf("pgtype: parsing Float64Array element: %w", err)
+		}
+	}
+	*a = arr
+	return nil
+}
+
+// SchemaType returns the database type of the array.
+func (Float64Array) SchemaType() map[string]string {
+	return map[string]string{dialect.Postgres: "double precision[]"}
+}
+
+// formatArray formats the given (encoded) elements as an array literal.
+func formatArray(elems []string) string {
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
+// quote quotes the given string as an array element.
+func quote(s string) string {
+	var b strings.Builder
+	b.Grow(len(s) + 2)
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '"' || s[i] == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// scanArray parses the given one-dimensional array literal. NULL elements are returned
+// as nil pointers, and a nil slice is returned in case the source value is NULL.
+func scanArray(src interface{}) ([]*string, error) {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return nil, fmt.Errorf("pgtype: unexpected array type %T", src)
+	}
+	// Skip the optional dimensions decoration. e.g. "[1:2]={1,2}".
+	if i := strings.IndexByte(s, '='); i != -1 && strings.HasPrefix(s, "[") {
+		s = s[i+1:]
+	}
+	if len(s) < 2 || s[0] != '{' || s[len(s)-1] != '}' {
+		return nil, fmt.Errorf("pgtype: invalid array literal %q", s)
+	}
+	elems := make([]*string, 0)
+	s = s[1 : len(s)-1]
+	for i := 0; i < len(s); {
+		var (
+			b      strings.Builder
+			quoted = s[i] == '"'
+			null   = !quoted && strings.HasPrefix(s[i:], "NULL")
+		)
+		if s[i] == '{' {
+			return nil, errors.New("pgtype: multi-dimensional arrays are not supported")
+		}
+		if quoted {
+			i++
+		}
+		for ; i < len(s); i++ {
+			c := s[i]
+			switch {
+			case quoted && c == '\\' && i+1 < len(s):
+				i++
+				b.WriteByte(s[i])
+				continue
+			case quoted && c == '"':
+				quoted = false
+				i++
+			case !quoted && c == ',':
+			default:
+				b.WriteByte(c)
+				continue
+			}
+			break
+		}
+		if quoted {
+			return nil, fmt.Errorf("pgtype: unterminated element in array literal %q", s)
+		}
+		if e := b.String(); null && e == "NULL" {
+			elems = append(elems, nil)
+		} else {
+			elems = append(elems, &e)
+		}
+		if i < len(s) {
+			if s[i] != ',' {
+				return nil, fmt.Errorf("pgtype: unexpected character %q in array literal", s[i])
+			}
+			i++
+		}
+	}
+	return elems, nil
+}
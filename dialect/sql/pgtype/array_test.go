@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package pgtype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStringArray(t *testing.T) {
+	v, err := StringArray{"a", `b "c"`, `d\e`, "NULL", ""}.Value()
+	require.NoError(t, err)
+	require.Equal(t, `{"a","b \"c\"","d\\e","NULL",""}`, v)
+	v, err = StringArray(nil).Value()
+	require.NoError(t, err)
+	require.Nil(t, v)
+
+	var a StringArray
+	require.NoError(t, a.Scan([]byte(`{a,"b \"c\"","d\\e","NULL","",f g}`)))
+	require.Equal(t, StringArray{"a", `b "c"`, `d\e`, "NULL", "", "f g"}, a)
+	require.NoError(t, a.Scan("{}"))
+	require.Equal(t, StringArray{}, a)
+	require.NoError(t, a.Scan(nil))
+	require.Nil(t, a)
+	require.Error(t, a.Scan("{a,NULL}"))
+	require.Error(t, a.Scan(`{"a}`))
+	require.Error(t, a.Scan("{{a},{b}}"))
+	require.Error(t, a.Scan(1))
+}
+
+func TestInt64Array(t *testing.T) {
+	v, err := Int64Array{1, -2, 3}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "{1,-2,3}", v)
+
+	var a Int64Array
+	require.NoError(t, a.Scan("[0:2]={1,-2,3}"))
+	require.Equal(t, Int64Array{1, -2, 3}, a)
+	require.Error(t, a.Scan("{a}"))
+}
+
+func TestFloat64Array(t *testing.T) {
+	v, err := Float64Array{1.5, -2}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "{1.5,-2}", v)
+
+	var a Float64Array
+	require.NoError(t, a.Scan([]byte("{1.5,-2,1e+20}")))
+	require.Equal(t, Float64Array{1.5, -2, 1e20}, a)
+}
@@ -0,0 +1,95 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package pgtype provides native PostgreSQL array and range types, and
+// predicates for querying them. The types can be used as field types
+// using the field.Other builder:
+//
+//	field.Other("tags", pgtype.StringArray{}),
+//	field.Other("period", pgtype.TimeRange{}),
+//
+// Their database types (e.g. "text[]" or "tstzrange") are set by default,
+// and entc generates the operators predicates for them. For example:
+//
+//	client.User.Query().
+//		Where(user.TagsContains("a", "b"), user.PeriodContains(time.Now())).
+//		All(ctx)
+//
+package pgtype
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ArrayContains returns a predicate for checking that the array column
+// contains all elements of the given array. i.e. "column @> array".
+//
+//	pgtype.ArrayContains("tags", pgtype.StringArray{"a", "b"})
+//
+func ArrayContains(column string, arr interface{}) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Ident(column).WriteString(" @> ")
+		b.Arg(arr)
+	})
+}
+
+// ArrayOverlaps returns a predicate for checking that the array column has
+// elements in common with the given array. i.e. "column && array".
+//
+//	pgtype.ArrayOverlaps("tags", pgtype.StringArray{"a", "b"})
+//
+func ArrayOverlaps(column string, arr interface{}) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Ident(column).WriteString(" && ")
+		b.Arg(arr)
+	})
+}
+
+// ArrayAnyEQ returns a predicate for checking that one of the elements
+// of the array column is equal to the given value. i.e. "v = ANY(column)".
+//
+//	pgtype.ArrayAnyEQ("tags", "a")
+//
+func ArrayAnyEQ(column string, v interface{}) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Arg(v)
+		b.WriteOp(sql.OpEQ).WriteString("ANY").Nested(func(b *sql.Builder) {
+			b.Ident(column)
+		})
+	})
+}
+
+// RangeContains returns a predicate for checking that the range column
+// contains the given element. i.e. "column @> element".
+//
+//	pgtype.RangeContains("period", time.Now())
+//
+func RangeContains(column string, v interface{}) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Ident(column).WriteString(" @> ")
+		b.Arg(v)
+		// Elements are casted explicitly, because untyped
+		// arguments are resolved as ranges by the database.
+		switch v.(type) {
+		case time.Time:
+			b.WriteString("::timestamptz")
+		case int, int64:
+			b.WriteString("::bigint")
+		}
+	})
+}
+
+// RangeOverlaps returns a predicate for checking that the range column
+// has points in common with the given range. i.e. "column && range".
+//
+//	pgtype.RangeOverlaps("period", pgtype.TimeRange{Lower: start, Upper: end})
+//
+func RangeOverlaps(column string, r interface{}) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		b.Ident(column).WriteString(" && ")
+		b.Arg(r)
+	})
+}
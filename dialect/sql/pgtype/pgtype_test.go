@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package pgtype_test
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredicates(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		input     sql.Querier
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(pgtype.ArrayContains("tags", pgtype.StringArray{"a"})),
+			wantQuery: `SELECT * FROM "users" WHERE "tags" @> $1`,
+			wantArgs:  []interface{}{pgtype.StringArray{"a"}},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(sql.And(sql.EQ("name", "a8m"), pgtype.ArrayOverlaps("tags", pgtype.StringArray{"a"}))),
+			wantQuery: `SELECT * FROM "users" WHERE "name" = $1 AND "tags" && $2`,
+			wantArgs:  []interface{}{"a8m", pgtype.StringArray{"a"}},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(pgtype.ArrayAnyEQ("ints", int64(1))),
+			wantQuery: `SELECT * FROM "users" WHERE $1 = ANY("ints")`,
+			wantArgs:  []interface{}{int64(1)},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(pgtype.RangeContains("period", now)),
+			wantQuery: `SELECT * FROM "users" WHERE "period" @> $1::timestamptz`,
+			wantArgs:  []interface{}{now},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(pgtype.RangeContains("ids", int64(1))),
+			wantQuery: `SELECT * FROM "users" WHERE "ids" @> $1::bigint`,
+			wantArgs:  []interface{}{int64(1)},
+		},
+		{
+			input:     sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users")).Where(pgtype.RangeOverlaps("ids", pgtype.Int64Range{Lower: 1, Upper: 2})),
+			wantQuery: `SELECT * FROM "users" WHERE "ids" && $1`,
+			wantArgs:  []interface{}{pgtype.Int64Range{Lower: 1, Upper: 2}},
+		},
+	}
+	for _, tt := range tests {
+		query, args := tt.input.Query()
+		require.Equal(t, tt.wantQuery, query)
+		require.Equal(t, tt.wantArgs, args)
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package pgtype
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect"
+)
+
+// DefaultBounds are the bounds that are used by ranges
+// that do not specify their bounds. i.e. lower-inclusive
+// and upper-exclusive. The canonical form of discrete ranges.
+const DefaultBounds = "[)"
+
+// Int64Range represents a PostgreSQL "int8range" column.
+type Int64Range struct {
+	// Lower and Upper are the bounds of the range.
+	Lower, Upper int64
+	// Bounds holds the inclusivity of the bounds. For example "[]" for
+	// an inclusive range, and "(]" for a lower-exclusive range. Defaults
+	// to DefaultBounds.
+	Bounds string
+	// LowerInf and UpperInf indicate that the range has no lower or upper bound.
+	LowerInf, UpperInf bool
+	// Empty indicates an empty range.
+	Empty bool
+}
+
+// Value implements the driver.Valuer interface.
+func (r Int64Range) Value() (driver.Value, error) {
+	return formatRange(
+		r.Empty, r.Bounds,
+		r.LowerInf, strconv.FormatInt(r.Lower, 10),
+		r.UpperInf, strconv.FormatInt(r.Upper, 10),
+	)
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *Int64Range) Scan(src interface{}) (err error) {
+	if src == nil {
+		*r = Int64Range{}
+		return nil
+	}
+	raw, err := scanRange(src)
+	if err != nil {
+		return err
+	}
+	*r = Int64Range{Bounds: raw.bounds, Empty: raw.empty, LowerInf: raw.lower == nil, UpperInf: raw.upper == nil}
+	if r.Empty {
+		r.LowerInf, r.UpperInf = false, false
+		return nil
+	}
+	if raw.lower != nil {
+		if r.Lower, err = strconv.ParseInt(*raw.lower, 10, 64); err != nil {
+			return fmt.Errorf("pgtype: parsing lower bound of Int64Range: %w", err)
+		}
+	}
+	if raw.upper != nil {
+		if r.Upper, err = strconv.ParseInt(*raw.upper, 10, 64); err != nil {
+			return fmt.Errorf("pgtype: parsing upper bound of Int64Range: %w", err)
+		}
+	}
+	return nil
+}
+
+// SchemaType returns the database type of the range.
+func (Int64Range) SchemaType() map[string]string {
+	return map[string]string{dialect.Postgres: "int8range"}
+}
+
+// TimeRange represents a PostgreSQL "tstzrange" column.
+type TimeRange struct {
+	// Lower and Upper are the bounds of the range.
+	Lower, Upper time.Time
+	// Bounds holds the inclusivity of the bounds. For example "[]" for
+	// an inclusive range, and "(]" for a lower-exclusive range. Defaults
+	// to DefaultBounds.
+	Bounds string
+	// LowerInf and UpperInf indicate that the range has no lower or upper bound.
+	LowerInf, UpperInf bool
+	// Empty indicates an empty range.
+	Empty bool
+}
+
+// Value implements the driver.Valuer interface.
+func (r TimeRange) Value() (driver.Value, error) {
+	return formatRange(
+		r.Empty, r.Bounds,
+		r.LowerInf, strconv.Quote(r.Lower.Format(time.RFC3339Nano)),
+		r.UpperInf, strconv.Quote(r.Upper.Format(time.RFC3339Nano)),
+	)
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *TimeRange) Scan(src interface{}) (err error) {
+	if src == nil {
+		*r = TimeRange{}
+		return nil
+	}
+	raw, err := scanRange(src)
+	if err != nil {
+		return err
+	}
+	*r = TimeRange{Bounds: raw.bounds, Empty: raw.empty, LowerInf: raw.lower == nil, UpperInf: raw.upper == nil}
+	if r.Empty {
+		r.LowerInf, r.UpperInf = false, false
+		return nil
+	}
+	if raw.lower != nil {
+		if r.Lower, r.LowerInf, err = parseTime(*raw.lower); err != nil {
+			return fmt.Errorf("pgtype: parsing lower bound of TimeRange: %w", err)
+		}
+	}
+	if raw.upper != nil {
+		if r.Upper, r.UpperInf, err = parseTime(*raw.upper); err != nil {
+			return fmt.Errorf("pgtype: parsing upper bound of TimeRange: %w", err)
+		}
+	}
+	return nil
+}
+
+// SchemaType returns the database type of the range.
+func (TimeRange) SchemaType() map[string]string {
+	return map[string]string{dialect.Postgres: "tstzrange"}
+}
+
+// timeLayouts are the layouts used by PostgreSQL for formatting timestamps in ranges.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07:00:00",
+	time.RFC3339Nano,
+}
+
+// parseTime parses a timestamp of a range, and reports if it represents an infinite bound.
+func parseTime(s string) (t time.Time, inf bool, err error) {
+	if s == "infinity" || s == "-infinity" {
+		return t, true, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err = time.Parse(layout, s); err == nil {
+			return t, false, nil
+		}
+	}
+	return t, false, err
+}
+
+// formatRange formats the range literal from its (encoded) parts.
+func formatRange(empty bool, bounds string, lowerInf bool, lower string, upperInf bool, upper string) (driver.Value, error) {
+	if empty {
+		return "empty", nil
+	}
+	if bounds == "" {
+		bounds = DefaultBounds
+	}
+	if !validBounds(bounds) {
+		return nil, fmt.Errorf("pgtype: invalid range bounds %q", bounds)
+	}
+	if lowerInf {
+		lower = ""
+	}
+	if upperInf {
+		upper = ""
+	}
+	return bounds[:1] + lower + "," + upper + bounds[1:], nil
+}
+
+// rawRange holds the parts of a parsed range literal.
+// Unbounded (infinite) bounds are represented by nil.
+type rawRange struct {
+	lower, upper *string
+	bounds       string
+	empty        bool
+}
+
+// scanRange parses the given range literal.
+func scanRange(src interface{}) (*rawRange, error) {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return nil, fmt.Errorf("pgtype: unexpected range type %T", src)
+	}
+	if strings.EqualFold(s, "empty") {
+		return &rawRange{empty: true, bounds: DefaultBounds}, nil
+	}
+	if len(s) < 3 || !validBounds(s[:1]+s[len(s)-1:]) {
+		return nil, fmt.Errorf("pgtype: invalid range literal %q", s)
+	}
+	r := &rawRange{bounds: s[:1] + s[len(s)-1:]}
+	lower, rest, err := rangeBound(s[1 : len(s)-1])
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) == 0 || rest[0] != ',' {
+		return nil, fmt.Errorf("pgtype: invalid range literal %q", s)
+	}
+	upper, rest, err := rangeBound(rest[1:])
+	if err != nil {
+		return nil, err
+	}
+	if rest != "" {
+		return nil, fmt.Errorf("pgtype: invalid range literal %q", s)
+	}
+	r.lower, r.upper = lower, upper
+	return r, nil
+}
+
+// rangeBound reads a range bound from the given string, and returns it with the rest of the
+// string. An empty (unquoted) bound represents an unbounded (infinite) bound, and returns nil.
+func rangeBound(s string) (*string, string, error) {
+	if s == "" || s[0] == ',' {
+		return nil, s, nil
+	}
+	var (
+		b      strings.Builder
+		quoted bool
+		i      int
+	)
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case c == '"':
+			// A doubled quote inside a quoted bound represents a quote.
+			if quoted && i+1 < len(s) && s[i+1] == '"' {
+				i++
+				b.WriteByte('"')
+			} else {
+				quoted = !quoted
+			}
+		case c == ',' && !quoted:
+			v := b.String()
+			return &v, s[i:], nil
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if quoted {
+		return nil, "", fmt.Errorf("pgtype: unterminated range bound %q", s)
+	}
+	v := b.String()
+	return &v, s[i:], nil
+}
+
+// validBounds reports if the given bounds are valid.
+func validBounds(bounds string) bool {
+	return len(bounds) == 2 && (bounds[0] == '[' || bounds[0] == '(') && (bounds[1] == ']' || bounds[1] == ')')
+}
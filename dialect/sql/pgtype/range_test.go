@@ -0,0 +1,68 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package pgtype
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt64Range(t *testing.T) {
+	for _, tt := range []struct {
+		r   Int64Range
+		lit string
+	}{
+		{r: Int64Range{Lower: 1, Upper: 10, Bounds: "[)"}, lit: "[1,10)"},
+		{r: Int64Range{Lower: -1, Upper: 10, Bounds: "(]"}, lit: "(-1,10]"},
+		{r: Int64Range{Upper: 10, LowerInf: true, Bounds: "()"}, lit: "(,10)"},
+		{r: Int64Range{Lower: 1, UpperInf: true, Bounds: "[)"}, lit: "[1,)"},
+		{r: Int64Range{Empty: true, Bounds: "[)"}, lit: "empty"},
+	} {
+		v, err := tt.r.Value()
+		require.NoError(t, err)
+		require.Equal(t, tt.lit, v)
+		var r Int64Range
+		require.NoError(t, r.Scan([]byte(tt.lit)))
+		require.Equal(t, tt.r, r)
+	}
+	v, err := Int64Range{Lower: 1, Upper: 2}.Value()
+	require.NoError(t, err)
+	require.Equal(t, "[1,2)", v)
+	_, err = Int64Range{Bounds: "<>"}.Value()
+	require.Error(t, err)
+
+	var r Int64Range
+	require.Error(t, r.Scan("[1,2"))
+	require.Error(t, r.Scan("[a,2)"))
+	require.Error(t, r.Scan("[1;2)"))
+	require.NoError(t, r.Scan(nil))
+	require.Equal(t, Int64Range{}, r)
+}
+
+func TestTimeRange(t *testing.T) {
+	lower := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	upper := lower.Add(time.Hour + time.Millisecond)
+	v, err := TimeRange{Lower: lower, Upper: upper}.Value()
+	require.NoError(t, err)
+	require.Equal(t, `["2021-10-01T00:00:00Z","2021-10-01T01:00:00.001Z")`, v)
+
+	var r TimeRange
+	require.NoError(t, r.Scan(`["2021-10-01 00:00:00+00","2021-10-01 01:00:00.001+00")`))
+	require.True(t, lower.Equal(r.Lower))
+	require.True(t, upper.Equal(r.Upper))
+	require.Equal(t, "[)", r.Bounds)
+
+	require.NoError(t, r.Scan(`("2021-10-01 03:00:00+03:00",infinity]`))
+	require.True(t, lower.Equal(r.Lower))
+	require.True(t, r.UpperInf)
+	require.Equal(t, "(]", r.Bounds)
+
+	require.NoError(t, r.Scan(`[,"2021-10-01 00:00:00+00")`))
+	require.True(t, r.LowerInf)
+	require.True(t, lower.Equal(r.Upper))
+	require.Error(t, r.Scan(`["2021-10-01",)`))
+}
@@ -0,0 +1,431 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Recorder is a driver that records all operations executed on its
+// underlying driver, including their arguments and results.
+type Recorder struct {
+	dialect.Driver // underlying driver.
+	mu             sync.Mutex
+	records        []*Record
+}
+
+// NewRecorder returns a new Recorder for the given driver.
+func NewRecorder(drv dialect.Driver) *Recorder {
+	return &Recorder{Driver: drv}
+}
+
+// Records returns the operations recorded so far.
+func (r *Recorder) Records() []*Record {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Record(nil), r.records...)
+}
+
+// WriteTo writes the recorded operations to w in their golden file format.
+func (r *Recorder) WriteTo(w io.Writer) (int64, error) {
+	var b bytes.Buffer
+	if err := Write(&b, r.Records()); err != nil {
+		return 0, err
+	}
+	return b.WriteTo(w)
+}
+
+// WriteFile writes the recorded operations to the given golden file.
+func (r *Recorder) WriteFile(name string) error {
+	var b bytes.Buffer
+	if err := Write(&b, r.Records()); err != nil {
+		return err
+	}
+	return os.WriteFile(name, b.Bytes(), 0644)
+}
+
+func (r *Recorder) add(rec *Record, err error) {
+	if err != nil {
+		rec.Err, rec.err = err.Error(), err
+	}
+	r.mu.Lock()
+	r.records = append(r.records, rec)
+	r.mu.Unlock()
+}
+
+// Exec records the operation and calls the underlying driver Exec method.
+func (r *Recorder) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return r.exec(ctx, r.Driver, query, args, v)
+}
+
+// Query records the operation and calls the underlying driver Query method.
+func (r *Recorder) Query(ctx context.Context, query string, args, v interface{}) error {
+	return r.query(ctx, r.Driver, query, args, v)
+}
+
+// Tx records the operation and calls the underlying driver Tx method.
+func (r *Recorder) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := r.Driver.Tx(ctx)
+	r.add(&Record{Op: OpBegin}, err)
+	if err != nil {
+		return nil, err
+	}
+	return &recordTx{Tx: tx, r: r}, nil
+}
+
+// BeginTx records the operation and calls the underlying driver BeginTx method if it's supported.
+func (r *Recorder) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := r.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql/record: Driver.BeginTx is not supported")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	r.add(&Record{Op: OpBegin}, err)
+	if err != nil {
+		return nil, err
+	}
+	return &recordTx{Tx: tx, r: r}, nil
+}
+
+func (r *Recorder) exec(ctx context.Context, eq dialect.ExecQuerier, query string, args, v interface{}) error {
+	vs, err := values(args)
+	if err != nil {
+		return err
+	}
+	rec := &Record{Op: OpExec, Query: query, Args: vs}
+	var res sql.Result
+	if err := eq.Exec(ctx, query, args, &res); err != nil {
+		r.add(rec, err)
+		return err
+	}
+	if id, err := res.LastInsertId(); err == nil {
+		rec.LastInsertID = &id
+	}
+	if n, err := res.RowsAffected(); err == nil {
+		rec.RowsAffected = &n
+	}
+	r.add(rec, nil)
+	switch v := v.(type) {
+	case nil:
+	case *sql.Result:
+		*v = res
+	default:
+		return fmt.Errorf("dialect/sql/record: invalid type %T. expect *sql.Result", v)
+	}
+	return nil
+}
+
+func (r *Recorder) query(ctx context.Context, eq dialect.ExecQuerier, query string, args, v interface{}) error {
+	vr, ok := v.(*sql.Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql/record: invalid type %T. expect *sql.Rows", v)
+	}
+	vs, err := values(args)
+	if err != nil {
+		return err
+	}
+	rec := &Record{Op: OpQuery, Query: query, Args: vs}
+	if err := eq.Query(ctx, query, args, vr); err != nil {
+		r.add(rec, err)
+		return err
+	}
+	err = scanAll(vr, rec)
+	r.add(rec, err)
+	if err != nil {
+		return err
+	}
+	rows, err := rows(ctx, rec)
+	if err != nil {
+		return err
+	}
+	*vr = sql.Rows{ColumnScanner: rows}
+	return nil
+}
+
+// scanAll scans all rows into the record and closes them.
+func scanAll(rows *sql.Rows, rec *Record) error {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	rec.Columns = columns
+	for rows.Next() {
+		dest := make([]interface{}, len(columns))
+		for i := range dest {
+			dest[i] = new(interface{})
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		row := make([]Value, len(columns))
+		for i := range dest {
+			v := *dest[i].(*interface{})
+			// Values of type []byte may be reused by the driver.
+			if b, ok := v.([]byte); ok {
+				v = append([]byte(nil), b...)
+			}
+			row[i].V = v
+		}
+		rec.Rows = append(rec.Rows, row)
+	}
+	return rows.Err()
+}
+
+// recordTx is a transaction implementation that records all its operations.
+type recordTx struct {
+	dialect.Tx // underlying transaction.
+	r          *Recorder
+}
+
+// Exec records the operation and calls the underlying transaction Exec method.
+func (tx *recordTx) Exec(ctx context.Context, query string, args, v interface{}) error {
+	return tx.r.exec(ctx, tx.Tx, query, args, v)
+}
+
+// Query records the operation and calls the underlying transaction Query method.
+func (tx *recordTx) Query(ctx context.Context, query string, args, v interface{}) error {
+	return tx.r.query(ctx, tx.Tx, query, args, v)
+}
+
+// Commit records the operation and calls the underlying transaction Commit method.
+func (tx *recordTx) Commit() error {
+	err := tx.Tx.Commit()
+	tx.r.add(&Record{Op: OpCommit}, err)
+	return err
+}
+
+// Rollback records the operation and calls the underlying transaction Rollback method.
+func (tx *recordTx) Rollback() error {
+	err := tx.Tx.Rollback()
+	tx.r.add(&Record{Op: OpRollback}, err)
+	return err
+}
+
+// Replayer is a driver that serves recorded operations without a database.
+// Operations are expected to be executed in the same order they were recorded,
+// and with the same statements and arguments.
+type Replayer struct {
+	dialect string
+	mu      sync.Mutex
+	records []*Record
+	idx     int
+}
+
+// NewReplayer returns a new Replayer for the given dialect and records.
+func NewReplayer(dialect string, records []*Record) *Replayer {
+	return &Replayer{dialect: dialect, records: records}
+}
+
+// OpenReplayer returns a new Replayer for the given dialect that
+// serves the records stored in the given golden file.
+func OpenReplayer(dialect, name string) (*Replayer, error) {
+	records, err := ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(dialect, records), nil
+}
+
+// Done returns an error if not all records were replayed.
+func (r *Replayer) Done() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n := len(r.records) - r.idx; n > 0 {
+		return fmt.Errorf("dialect/sql/record: %d records were not replayed", n)
+	}
+	return nil
+}
+
+// next returns the next record, and verifies it matches the given operation.
+func (r *Replayer) next(op, query string, args interface{}) (*Record, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.idx >= len(r.records) {
+		return nil, fmt.Errorf("dialect/sql/record: unexpected %s operation (query=%q): no records left", op, query)
+	}
+	rec := r.records[r.idx]
+	if rec.Op != op || rec.Query != query {
+		return nil, fmt.Errorf("dialect/sql/record: unexpected %s operation (query=%q). expect %s operation (query=%q)", op, query, rec.Op, rec.Query)
+	}
+	if op == OpExec || op == OpQuery {
+		vs, err := values(args)
+		if err != nil {
+			return nil, err
+		}
+		if err := equalArgs(vs, rec.Args); err != nil {
+			return nil, fmt.Errorf("dialect/sql/record: unexpected arguments for query %q: %w", query, err)
+		}
+	}
+	r.idx++
+	return rec, nil
+}
+
+// equalArgs compares the arguments by their encoded form to
+// avoid differences in time locations and empty slices.
+func equalArgs(got, expect []Value) error {
+	b1, err := json.Marshal(got)
+	if err != nil {
+		return err
+	}
+	b2, err := json.Marshal(expect)
+	if err != nil {
+		return err
+	}
+	if len(got) == 0 && len(expect) == 0 || bytes.Equal(b1, b2) {
+		return nil
+	}
+	return fmt.Errorf("got %s, expect %s", b1, b2)
+}
+
+// knownErrs holds the standard errors that are restored when
+// records are read from a golden file.
+var knownErrs = []error{
+	stdsql.ErrNoRows,
+	stdsql.ErrTxDone,
+	stdsql.ErrConnDone,
+	driver.ErrBadConn,
+	context.Canceled,
+	context.DeadlineExceeded,
+}
+
+// replayError is an error read from a golden file. It keeps the message of
+// the recorded error, and wraps the standard error it resulted from, if known.
+type replayError struct {
+	msg string
+	err error
+}
+
+// Error implements the error interface.
+func (e *replayError) Error() string { return e.msg }
+
+// Unwrap returns the standard error the recorded error resulted from, if any.
+func (e *replayError) Unwrap() error { return e.err }
+
+// recordErr returns the recorded error of the operation, if any. Records that
+// were not read from a golden file return their original error. Otherwise, the
+// error message is preserved (e.g. for sqlgraph.IsConstraintError), and standard
+// errors like sql.ErrNoRows can be matched using errors.Is.
+func recordErr(rec *Record) error {
+	switch {
+	case rec.err != nil:
+		return rec.err
+	case rec.Err == "":
+		return nil
+	}
+	err := &replayError{msg: rec.Err}
+	for _, target := range knownErrs {
+		if msg := target.Error(); rec.Err == msg || strings.HasSuffix(rec.Err, ": "+msg) {
+			err.err = target
+			break
+		}
+	}
+	return err
+}
+
+// Exec replays the next recorded exec operation.
+func (r *Replayer) Exec(_ context.Context, query string, args, v interface{}) error {
+	rec, err := r.next(OpExec, query, args)
+	if err != nil {
+		return err
+	}
+	if err := recordErr(rec); err != nil {
+		return err
+	}
+	switch v := v.(type) {
+	case nil:
+	case *sql.Result:
+		*v = result{rec}
+	default:
+		return fmt.Errorf("dialect/sql/record: invalid type %T. expect *sql.Result", v)
+	}
+	return nil
+}
+
+// Query replays the next recorded query operation.
+func (r *Replayer) Query(ctx context.Context, query string, args, v interface{}) error {
+	vr, ok := v.(*sql.Rows)
+	if !ok {
+		return fmt.Errorf("dialect/sql/record: invalid type %T. expect *sql.Rows", v)
+	}
+	rec, err := r.next(OpQuery, query, args)
+	if err != nil {
+		return err
+	}
+	if err := recordErr(rec); err != nil {
+		return err
+	}
+	rows, err := rows(ctx, rec)
+	if err != nil {
+		return err
+	}
+	*vr = sql.Rows{ColumnScanner: rows}
+	return nil
+}
+
+// Tx replays the next recorded transaction.
+func (r *Replayer) Tx(context.Context) (dialect.Tx, error) {
+	rec, err := r.next(OpBegin, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := recordErr(rec); err != nil {
+		return nil, err
+	}
+	return &replayTx{r}, nil
+}
+
+// BeginTx replays the next recorded transaction. The options are ignored.
+func (r *Replayer) BeginTx(ctx context.Context, _ *sql.TxOptions) (dialect.Tx, error) {
+	return r.Tx(ctx)
+}
+
+// Close is a no-op.
+func (*Replayer) Close() error { return nil }
+
+// Dialect returns the configured dialect name.
+func (r *Replayer) Dialect() string { return r.dialect }
+
+// replayTx is a transaction that replays its recorded operations.
+type replayTx struct {
+	*Replayer
+}
+
+// Commit replays the next recorded commit operation.
+func (tx *replayTx) Commit() error {
+	rec, err := tx.next(OpCommit, "", nil)
+	if err != nil {
+		return err
+	}
+	return recordErr(rec)
+}
+
+// Rollback replays the next recorded rollback operation.
+func (tx *replayTx) Rollback() error {
+	rec, err := tx.next(OpRollback, "", nil)
+	if err != nil {
+		return err
+	}
+	return recordErr(rec)
+}
+
+var (
+	_ dialect.Driver = (*Recorder)(nil)
+	_ dialect.Driver = (*Replayer)(nil)
+)
@@ -0,0 +1,265 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package record provides a dialect.Driver for recording all operations
+// executed on an SQL database (including their results) into a golden file,
+// and a dialect.Driver for replaying them later without a database.
+//
+//	// Record.
+//	rec := record.NewRecorder(drv)
+//	client := ent.NewClient(ent.Driver(rec))
+//	// ...
+//	if err := rec.WriteFile("testdata/users.golden"); err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	// Replay.
+//	rep, err := record.OpenReplayer(dialect.SQLite, "testdata/users.golden")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client := ent.NewClient(ent.Driver(rep))
+//
+package record
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Operation types of a Record.
+const (
+	OpExec     = "exec"
+	OpQuery    = "query"
+	OpBegin    = "begin"
+	OpCommit   = "commit"
+	OpRollback = "rollback"
+)
+
+// Record holds a single recorded driver operation and its result.
+type Record struct {
+	// Op is the operation type. See the Op constants above.
+	Op string `json:"op"`
+	// Query and Args hold the statement and its arguments for
+	// the exec and query operations.
+	Query string  `json:"query,omitempty"`
+	Args  []Value `json:"args,omitempty"`
+	// Columns and Rows hold the result of query operations.
+	Columns []string  `json:"columns,omitempty"`
+	Rows    [][]Value `json:"rows,omitempty"`
+	// LastInsertID and RowsAffected hold the result of exec operations.
+	LastInsertID *int64 `json:"last_insert_id,omitempty"`
+	RowsAffected *int64 `json:"rows_affected,omitempty"`
+	// Err holds the error message returned by the operation, if any.
+	Err string `json:"error,omitempty"`
+	// err holds the original error of the operation. It is not encoded
+	// into golden files, and is kept only for in-memory records.
+	err error
+}
+
+// Value wraps a driver.Value to make it encodable into a golden
+// file without losing its underlying type.
+type Value struct {
+	V driver.Value
+}
+
+// value types used in the encoded form.
+const (
+	typeInt64   = "int64"
+	typeFloat64 = "float64"
+	typeBool    = "bool"
+	typeBytes   = "bytes"
+	typeString  = "string"
+	typeTime    = "time"
+)
+
+type encodedValue struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (v Value) MarshalJSON() ([]byte, error) {
+	var e encodedValue
+	switch x := v.V.(type) {
+	case nil:
+		return []byte("null"), nil
+	case int64:
+		e = encodedValue{Type: typeInt64, Value: strconv.FormatInt(x, 10)}
+	case float64:
+		e = encodedValue{Type: typeFloat64, Value: strconv.FormatFloat(x, 'g', -1, 64)}
+	case bool:
+		e = encodedValue{Type: typeBool, Value: strconv.FormatBool(x)}
+	case []byte:
+		e = encodedValue{Type: typeBytes, Value: base64.StdEncoding.EncodeToString(x)}
+	case string:
+		e = encodedValue{Type: typeString, Value: x}
+	case time.Time:
+		e = encodedValue{Type: typeTime, Value: x.Format(time.RFC3339Nano)}
+	default:
+		return nil, fmt.Errorf("dialect/sql/record: unsupported value type %T", v.V)
+	}
+	return json.Marshal(e)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (v *Value) UnmarshalJSON(b []byte) (err error) {
+	if bytes.Equal(b, []byte("null")) {
+		v.V = nil
+		return nil
+	}
+	var e encodedValue
+	if err := json.Unmarshal(b, &e); err != nil {
+		return err
+	}
+	switch e.Type {
+	case typeInt64:
+		v.V, err = strconv.ParseInt(e.Value, 10, 64)
+	case typeFloat64:
+		v.V, err = strconv.ParseFloat(e.Value, 64)
+	case typeBool:
+		v.V, err = strconv.ParseBool(e.Value)
+	case typeBytes:
+		v.V, err = base64.StdEncoding.DecodeString(e.Value)
+	case typeString:
+		v.V = e.Value
+	case typeTime:
+		v.V, err = time.Parse(time.RFC3339Nano, e.Value)
+	default:
+		err = fmt.Errorf("dialect/sql/record: unknown value type %q", e.Type)
+	}
+	return err
+}
+
+// Write writes the given records to w in their golden file format.
+func Write(w io.Writer, records []*Record) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// Read reads the records encoded in the golden file format from r.
+func Read(r io.Reader) ([]*Record, error) {
+	var records []*Record
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("dialect/sql/record: decoding records: %w", err)
+	}
+	return records, nil
+}
+
+// ReadFile reads the records stored in the given golden file.
+func ReadFile(name string) ([]*Record, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// values converts the given statement arguments into their driver
+// representation, the same way it is done by the database/sql package.
+func values(args interface{}) ([]Value, error) {
+	argv, ok := args.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql/record: invalid type %T. expect []interface{} for args", args)
+	}
+	vs := make([]Value, len(argv))
+	for i, arg := range argv {
+		if vr, ok := arg.(driver.Valuer); ok {
+			v, err := vr.Value()
+			if err != nil {
+				return nil, fmt.Errorf("dialect/sql/record: converting argument %d: %w", i, err)
+			}
+			arg = v
+		}
+		v, err := driver.DefaultParameterConverter.ConvertValue(arg)
+		if err != nil {
+			return nil, fmt.Errorf("dialect/sql/record: converting argument %d: %w", i, err)
+		}
+		vs[i].V = v
+	}
+	return vs, nil
+}
+
+// rowsDB is an in-memory database that serves the rows of the
+// record stored in the context of the query. It is used to create
+// standard *sql.Rows from records, and let the database/sql package
+// handle the conversion of the values on Scan.
+var rowsDB = stdsql.OpenDB(connector{})
+
+type recordKey struct{}
+
+// rows returns the standard *sql.Rows of the given query record.
+func rows(ctx context.Context, r *Record) (*stdsql.Rows, error) {
+	return rowsDB.QueryContext(context.WithValue(ctx, recordKey{}, r), r.Query)
+}
+
+type (
+	connector struct{}
+	conn      struct{}
+	memRows   struct {
+		*Record
+		idx int
+	}
+)
+
+func (connector) Connect(context.Context) (driver.Conn, error) { return conn{}, nil }
+func (connector) Driver() driver.Driver                        { return nil }
+
+func (conn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("dialect/sql/record: prepare is not supported")
+}
+func (conn) Close() error { return nil }
+func (conn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("dialect/sql/record: begin is not supported")
+}
+
+func (conn) QueryContext(ctx context.Context, _ string, _ []driver.NamedValue) (driver.Rows, error) {
+	r, ok := ctx.Value(recordKey{}).(*Record)
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql/record: missing query record")
+	}
+	return &memRows{Record: r}, nil
+}
+
+func (r *memRows) Columns() []string { return r.Record.Columns }
+func (r *memRows) Close() error      { return nil }
+
+func (r *memRows) Next(dest []driver.Value) error {
+	if r.idx >= len(r.Record.Rows) {
+		return io.EOF
+	}
+	for i, v := range r.Record.Rows[r.idx] {
+		dest[i] = v.V
+	}
+	r.idx++
+	return nil
+}
+
+// result implements the sql.Result interface for recorded exec operations.
+type result struct{ *Record }
+
+func (r result) LastInsertId() (int64, error) {
+	if r.LastInsertID == nil {
+		return 0, fmt.Errorf("dialect/sql/record: LastInsertId was not recorded")
+	}
+	return *r.LastInsertID, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	if r.Record.RowsAffected == nil {
+		return 0, fmt.Errorf("dialect/sql/record: RowsAffected was not recorded")
+	}
+	return *r.Record.RowsAffected, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReplay(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	now := time.Date(2021, 5, 6, 7, 8, 9, 0, time.UTC)
+	mock.ExpectExec("INSERT INTO `users` (`name`, `created_at`) VALUES (?, ?)").
+		WithArgs("a8m", now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT `id`, `name`, `created_at` FROM `users` WHERE `id` = ?").
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "created_at"}).AddRow(1, []byte("a8m"), now))
+	mock.ExpectExec("DELETE FROM `users`").
+		WillReturnError(errors.New("Error 1451: Cannot delete or update a parent row"))
+	mock.ExpectRollback()
+
+	rec := NewRecorder(sql.OpenDB(dialect.MySQL, db))
+	run(t, rec, now)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	var b bytes.Buffer
+	_, err = rec.WriteTo(&b)
+	require.NoError(t, err)
+	records, err := Read(&b)
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+
+	rep := NewReplayer(dialect.MySQL, records)
+	run(t, rep, now)
+	require.NoError(t, rep.Done())
+}
+
+func run(t *testing.T, drv dialect.Driver, now time.Time) {
+	ctx := context.Background()
+	var res sql.Result
+	err := drv.Exec(ctx, "INSERT INTO `users` (`name`, `created_at`) VALUES (?, ?)", []interface{}{"a8m", now}, &res)
+	require.NoError(t, err)
+	id, err := res.LastInsertId()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), id)
+
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	rows := &sql.Rows{}
+	err = tx.Query(ctx, "SELECT `id`, `name`, `created_at` FROM `users` WHERE `id` = ?", []interface{}{1}, rows)
+	require.NoError(t, err)
+	var (
+		names []string
+		ids   []int
+		times []time.Time
+	)
+	for rows.Next() {
+		var (
+			id   int
+			name string
+			at   time.Time
+		)
+		require.NoError(t, rows.Scan(&id, &name, &at))
+		ids, names, times = append(ids, id), append(names, name), append(times, at)
+	}
+	require.NoError(t, rows.Close())
+	require.Equal(t, []int{1}, ids)
+	require.Equal(t, []string{"a8m"}, names)
+	require.True(t, now.Equal(times[0]))
+	err = tx.Exec(ctx, "DELETE FROM `users`", []interface{}{}, nil)
+	require.EqualError(t, err, "Error 1451: Cannot delete or update a parent row")
+	require.NoError(t, tx.Rollback())
+}
+
+func TestReplayer_Errors(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err)
+	uniqueErr := errors.New("Error 1062: Duplicate entry 'a8m' for key 'name'")
+	mock.ExpectExec("INSERT INTO `users` (`name`) VALUES (?)").
+		WithArgs("a8m").
+		WillReturnError(uniqueErr)
+	mock.ExpectQuery("SELECT `id` FROM `users`").
+		WillReturnError(stdsql.ErrNoRows)
+	ctx := context.Background()
+	check := func(drv dialect.Driver) (error, error) {
+		err1 := drv.Exec(ctx, "INSERT INTO `users` (`name`) VALUES (?)", []interface{}{"a8m"}, nil)
+		require.True(t, sqlgraph.IsUniqueConstraintError(err1))
+		err2 := drv.Query(ctx, "SELECT `id` FROM `users`", []interface{}{}, &sql.Rows{})
+		require.True(t, errors.Is(err2, stdsql.ErrNoRows))
+		return err1, err2
+	}
+	rec := NewRecorder(sql.OpenDB(dialect.MySQL, db))
+	check(rec)
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	// In-memory records keep the original errors.
+	err1, err2 := check(NewReplayer(dialect.MySQL, rec.Records()))
+	require.Equal(t, uniqueErr, err1)
+	require.Equal(t, stdsql.ErrNoRows, err2)
+
+	var b bytes.Buffer
+	_, err = rec.WriteTo(&b)
+	require.NoError(t, err)
+	records, err := Read(&b)
+	require.NoError(t, err)
+	err1, err2 = check(NewReplayer(dialect.MySQL, records))
+	require.EqualError(t, err1, uniqueErr.Error())
+	require.EqualError(t, err2, stdsql.ErrNoRows.Error())
+}
+
+func TestReplayer_Mismatch(t *testing.T) {
+	rep := NewReplayer(dialect.SQLite, []*Record{
+		{Op: OpExec, Query: "DELETE FROM `users` WHERE `id` = ?", Args: []Value{{V: int64(1)}}},
+	})
+	ctx := context.Background()
+	err := rep.Query(ctx, "SELECT 1", []interface{}{}, &sql.Rows{})
+	require.Error(t, err)
+	err = rep.Exec(ctx, "DELETE FROM `users` WHERE `id` = ?", []interface{}{2}, nil)
+	require.Error(t, err)
+	require.Error(t, rep.Done())
+	err = rep.Exec(ctx, "DELETE FROM `users` WHERE `id` = ?", []interface{}{1}, nil)
+	require.NoError(t, err)
+	require.NoError(t, rep.Done())
+}
@@ -0,0 +1,282 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package replica provides a dialect.Driver that splits reads and writes
+// between a primary database and one or more read replicas.
+//
+//	drv := replica.NewDriver(primary, []dialect.Driver{replica1, replica2})
+//	client := ent.NewClient(ent.Driver(drv))
+//
+// Read queries are routed to the replicas, while Exec operations, write queries
+// (e.g. INSERT ... RETURNING) and all operations executed inside a transaction
+// are routed to the primary. Since
+// schema migrations are always executed inside a transaction, they are always
+// executed on the primary as well.
+package replica
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Driver is a dialect.Driver implementation that routes read queries
+// to read replicas, and Exec and transactional operations to the primary.
+type Driver struct {
+	primary  dialect.Driver
+	replicas []*Replica
+	selector Selector
+}
+
+// Option allows configuring the Driver using functional options.
+type Option func(*Driver)
+
+// WithSelector sets the Selector used for picking a replica for
+// read operations. Defaults to RoundRobin.
+func WithSelector(s Selector) Option {
+	return func(d *Driver) {
+		d.selector = s
+	}
+}
+
+// NewDriver returns a new Driver that executes all write operations on the
+// primary driver, and spreads the read operations between the given replicas.
+// If no replicas were provided, all operations are executed on the primary.
+func NewDriver(primary dialect.Driver, replicas []dialect.Driver, opts ...Option) *Driver {
+	d := &Driver{primary: primary, selector: RoundRobin()}
+	for _, r := range replicas {
+		d.replicas = append(d.replicas, &Replica{Driver: r})
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Primary returns the underlying primary driver.
+func (d *Driver) Primary() dialect.Driver {
+	return d.primary
+}
+
+// Replicas returns the underlying replica drivers.
+func (d *Driver) Replicas() []*Replica {
+	return d.replicas
+}
+
+// Exec executes the query on the primary. If the context was marked with
+// ReadYourWrites, all future Query operations that use it are executed on
+// the primary as well.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	markWrite(ctx)
+	return d.primary.Exec(ctx, query, args, v)
+}
+
+// Query executes the query on one of the replicas, picked by the configured
+// Selector. The primary is used if there are no replicas, or if the context
+// was marked with UsePrimary, or with ReadYourWrites after a write occurred.
+//
+// Statements that are not plain reads (e.g. INSERT ... RETURNING, which
+// is executed by Query on PostgreSQL and SQLite) are executed on the
+// primary, and considered as writes by ReadYourWrites contexts.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if !isRead(query) {
+		markWrite(ctx)
+		return d.primary.Query(ctx, query, args, v)
+	}
+	r := d.pick(ctx)
+	if r == nil {
+		return d.primary.Query(ctx, query, args, v)
+	}
+	atomic.AddInt64(&r.inflight, 1)
+	defer atomic.AddInt64(&r.inflight, -1)
+	return r.Query(ctx, query, args, v)
+}
+
+// isRead reports if the given statement is a plain read (SELECT or WITH) statement.
+func isRead(query string) bool {
+	query = strings.TrimLeft(query, " \t\r\n(")
+	for _, prefix := range []string{"SELECT", "WITH"} {
+		if len(query) >= len(prefix) && strings.EqualFold(query[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// pick returns the replica for executing the given read operation,
+// or nil if it should be executed on the primary.
+func (d *Driver) pick(ctx context.Context) *Replica {
+	if len(d.replicas) == 0 || usePrimary(ctx) {
+		return nil
+	}
+	return d.selector.Select(ctx, d.replicas)
+}
+
+// Tx starts and returns a new transaction on the primary. Since transactions
+// may execute writes, a context that was marked with ReadYourWrites executes
+// all future Query operations on the primary as well.
+func (d *Driver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.primary.Tx(ctx)
+	if err == nil {
+		markWrite(ctx)
+	}
+	return tx, err
+}
+
+// BeginTx starts a transaction with options on the primary,
+// if the primary driver supports it. See Tx for more info.
+func (d *Driver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.primary.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("dialect/sql/replica: Driver.BeginTx is not supported by the primary driver")
+	}
+	tx, err := drv.BeginTx(ctx, opts)
+	if err == nil {
+		markWrite(ctx)
+	}
+	return tx, err
+}
+
+// Dialect returns the dialect name of the primary driver.
+func (d *Driver) Dialect() string {
+	return d.primary.Dialect()
+}
+
+// Close closes the primary and all replica drivers.
+func (d *Driver) Close() error {
+	var errs []string
+	if err := d.primary.Close(); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, r := range d.replicas {
+		if err := r.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("dialect/sql/replica: closing drivers: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+var _ dialect.Driver = (*Driver)(nil)
+
+// Replica wraps a replica driver with its runtime statistics.
+type Replica struct {
+	dialect.Driver
+	inflight int64
+}
+
+// InFlight returns the number of in-flight read operations on the replica.
+func (r *Replica) InFlight() int64 {
+	return atomic.LoadInt64(&r.inflight)
+}
+
+// Selector picks the replica for executing a read operation.
+type Selector interface {
+	// Select returns one of the given replicas. The given slice is
+	// guaranteed to contain at least one replica.
+	Select(context.Context, []*Replica) *Replica
+}
+
+// The SelectorFunc type is an adapter to allow the use of ordinary
+// functions as replica selectors.
+type SelectorFunc func(context.Context, []*Replica) *Replica
+
+// Select calls f(ctx, replicas).
+func (f SelectorFunc) Select(ctx context.Context, replicas []*Replica) *Replica {
+	return f(ctx, replicas)
+}
+
+// RoundRobin returns a Selector that picks the replicas in a round-robin order.
+func RoundRobin() Selector {
+	var next uint64
+	return SelectorFunc(func(_ context.Context, replicas []*Replica) *Replica {
+		n := atomic.AddUint64(&next, 1) - 1
+		return replicas[n%uint64(len(replicas))]
+	})
+}
+
+// LeastLoaded returns a Selector that picks the replica with the smallest
+// number of in-flight read operations. Ties are broken by the replicas order.
+func LeastLoaded() Selector {
+	return SelectorFunc(func(_ context.Context, replicas []*Replica) *Replica {
+		r := replicas[0]
+		for _, c := range replicas[1:] {
+			if c.InFlight() < r.InFlight() {
+				r = c
+			}
+		}
+		return r
+	})
+}
+
+type (
+	primaryKey struct{}
+	sessionKey struct{}
+	// session tracks whether a write operation occurred in a context.
+	session struct {
+		mu    sync.RWMutex
+		wrote bool
+	}
+)
+
+func (s *session) markWrite() {
+	s.mu.Lock()
+	s.wrote = true
+	s.mu.Unlock()
+}
+
+func (s *session) written() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.wrote
+}
+
+// UsePrimary returns a new context that forces all read operations
+// executed with it to go to the primary.
+//
+//	u, err := client.User.Query().Only(replica.UsePrimary(ctx))
+//
+func UsePrimary(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// ReadYourWrites returns a new context that routes read operations
+// to the replicas until a write operation is executed, or a transaction
+// is started, with it (or with a context derived from it). From that point, all read operations are
+// executed on the primary, in order to avoid reading stale data from
+// replicas that did not catch up yet.
+//
+//	ctx = replica.ReadYourWrites(ctx)
+//	client.User.Create().SetName("a8m").SaveX(ctx)
+//	client.User.Query().AllX(ctx) // Executed on the primary.
+//
+func ReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sessionKey{}, &session{})
+}
+
+// markWrite marks the ReadYourWrites session of the context (if
+// there is one) as one that executed a write operation.
+func markWrite(ctx context.Context) {
+	if s, ok := ctx.Value(sessionKey{}).(*session); ok {
+		s.markWrite()
+	}
+}
+
+// usePrimary reports if the read operations should be executed on the primary.
+func usePrimary(ctx context.Context) bool {
+	if v, _ := ctx.Value(primaryKey{}).(bool); v {
+		return true
+	}
+	s, ok := ctx.Value(sessionKey{}).(*session)
+	return ok && s.written()
+}
@@ -0,0 +1,174 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package replica
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriver_Routing(t *testing.T) {
+	primary, replica1, replica2 := &countDriver{}, &countDriver{}, &countDriver{}
+	drv := NewDriver(primary, []dialect.Driver{replica1, replica2})
+	ctx := context.Background()
+	for i := 0; i < 4; i++ {
+		require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	}
+	require.Zero(t, primary.queries)
+	require.Equal(t, 2, replica1.queries)
+	require.Equal(t, 2, replica2.queries)
+
+	require.NoError(t, drv.Exec(ctx, "INSERT", []interface{}{}, nil))
+	require.Equal(t, 1, primary.execs)
+	require.Zero(t, replica1.execs+replica2.execs)
+
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	require.NoError(t, tx.Commit())
+	require.Equal(t, 1, primary.queries)
+	require.Equal(t, 1, primary.txs)
+
+	require.NoError(t, drv.Query(UsePrimary(ctx), "SELECT 1", []interface{}{}, nil))
+	require.Equal(t, 2, primary.queries)
+}
+
+func TestDriver_NoReplicas(t *testing.T) {
+	primary := &countDriver{}
+	drv := NewDriver(primary, nil)
+	require.NoError(t, drv.Query(context.Background(), "SELECT 1", []interface{}{}, nil))
+	require.Equal(t, 1, primary.queries)
+}
+
+func TestDriver_ReadYourWrites(t *testing.T) {
+	primary, replica := &countDriver{}, &countDriver{}
+	drv := NewDriver(primary, []dialect.Driver{replica})
+	ctx := ReadYourWrites(context.Background())
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	require.Equal(t, 1, replica.queries)
+	require.NoError(t, drv.Exec(ctx, "INSERT", []interface{}{}, nil))
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	require.Equal(t, 1, replica.queries)
+	require.Equal(t, 1, primary.queries)
+	// Other contexts are not affected.
+	require.NoError(t, drv.Query(context.Background(), "SELECT 1", []interface{}{}, nil))
+	require.Equal(t, 2, replica.queries)
+}
+
+func TestDriver_ReadYourWritesTx(t *testing.T) {
+	primary, replica := &countDriver{}, &countDriver{}
+	drv := NewDriver(primary, []dialect.Driver{replica})
+	ctx := ReadYourWrites(context.Background())
+	tx, err := drv.Tx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Exec(ctx, "INSERT", []interface{}{}, nil))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	require.Zero(t, replica.queries, "reads after a transaction are executed on the primary")
+	require.Equal(t, 1, primary.queries)
+}
+
+func TestDriver_QueryWrites(t *testing.T) {
+	primary, replica := &countDriver{}, &countDriver{}
+	drv := NewDriver(primary, []dialect.Driver{replica})
+	ctx := ReadYourWrites(context.Background())
+	require.NoError(t, drv.Query(ctx, " (SELECT 1)", []interface{}{}, nil))
+	require.NoError(t, drv.Query(ctx, "with t AS (SELECT 1) SELECT * FROM t", []interface{}{}, nil))
+	require.Equal(t, 2, replica.queries)
+	require.NoError(t, drv.Query(ctx, "INSERT INTO users DEFAULT VALUES RETURNING id", []interface{}{}, nil))
+	require.Equal(t, 1, primary.queries)
+	require.NoError(t, drv.Query(ctx, "SELECT 1", []interface{}{}, nil))
+	require.Equal(t, 2, primary.queries, "read should be executed on the primary after a write")
+	require.Equal(t, 2, replica.queries)
+}
+
+func TestDriver_CreatePostgres(t *testing.T) {
+	pdb, pmock, err := sqlmock.New()
+	require.NoError(t, err)
+	rdb, rmock, err := sqlmock.New()
+	require.NoError(t, err)
+	pmock.ExpectQuery(regexp.QuoteMeta(`INSERT INTO "users" ("name") VALUES ($1) RETURNING "id"`)).
+		WithArgs("a8m").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	pmock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "users"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	drv := NewDriver(sql.OpenDB(dialect.Postgres, pdb), []dialect.Driver{sql.OpenDB(dialect.Postgres, rdb)})
+	ctx := ReadYourWrites(context.Background())
+	spec := &sqlgraph.CreateSpec{
+		Table:  "users",
+		ID:     &sqlgraph.FieldSpec{Column: "id", Type: field.TypeInt},
+		Fields: []*sqlgraph.FieldSpec{{Column: "name", Type: field.TypeString, Value: "a8m"}},
+	}
+	require.NoError(t, sqlgraph.CreateNode(ctx, drv, spec))
+	require.Equal(t, int64(1), spec.ID.Value)
+	rows := &sql.Rows{}
+	require.NoError(t, drv.Query(ctx, `SELECT "id" FROM "users"`, []interface{}{}, rows))
+	require.NoError(t, rows.Close())
+	require.NoError(t, pmock.ExpectationsWereMet())
+	require.NoError(t, rmock.ExpectationsWereMet())
+}
+
+func TestLeastLoaded(t *testing.T) {
+	replicas := []*Replica{{inflight: 2}, {inflight: 1}, {inflight: 1}}
+	r := LeastLoaded().Select(context.Background(), replicas)
+	require.True(t, r == replicas[1])
+}
+
+func TestDriver_Migrate(t *testing.T) {
+	pdb, pmock, err := sqlmock.New()
+	require.NoError(t, err)
+	rdb, rmock, err := sqlmock.New()
+	require.NoError(t, err)
+	pmock.ExpectBegin()
+	pmock.ExpectQuery("SHOW VARIABLES LIKE 'version'").
+		WillReturnRows(sqlmock.NewRows([]string{"Variable_name", "Value"}).AddRow("version", "8.0.19"))
+	pmock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM `INFORMATION_SCHEMA`.`TABLES`")).
+		WillReturnRows(sqlmock.NewRows([]string{"COUNT"}).AddRow(0))
+	pmock.ExpectExec("CREATE TABLE IF NOT EXISTS `users`").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	pmock.ExpectCommit()
+	drv := NewDriver(sql.OpenDB(dialect.MySQL, pdb), []dialect.Driver{sql.OpenDB(dialect.MySQL, rdb)})
+	m, err := schema.NewMigrate(drv)
+	require.NoError(t, err)
+	err = m.Create(context.Background(), &schema.Table{
+		Name:    "users",
+		Columns: []*schema.Column{{Name: "id", Type: field.TypeInt, Increment: true}},
+	})
+	require.NoError(t, err)
+	require.NoError(t, pmock.ExpectationsWereMet())
+	require.NoError(t, rmock.ExpectationsWereMet())
+}
+
+type countDriver struct {
+	dialect.Driver
+	execs, queries, txs int
+}
+
+func (d *countDriver) Exec(context.Context, string, interface{}, interface{}) error {
+	d.execs++
+	return nil
+}
+
+func (d *countDriver) Query(context.Context, string, interface{}, interface{}) error {
+	d.queries++
+	return nil
+}
+
+func (d *countDriver) Tx(context.Context) (dialect.Tx, error) {
+	d.txs++
+	return dialect.NopTx(d), nil
+}
+
+func (d *countDriver) Dialect() string { return dialect.MySQL }
@@ -68,6 +68,7 @@ func TestInspector_Tables(t *testing.T) {
 							AddRow("text", "longtext", "YES", "YES", "NULL", "", "", "", nil, nil).
 							AddRow("uuid", "char(36)", "YES", "YES", "NULL", "", "", "utf8mb4_bin", nil, nil).
 							AddRow("price", "decimal(6, 4)", "NO", "YES", "NULL", "", "", "", "6", "4").
+							AddRow("amount", "decimal(10, 2)", "YES", "YES", "NULL", "", "", "", "10", "2").
 							AddRow("bank_id", "varchar(255)", "NO", "YES", "NULL", "", "", "", nil, nil))
 					mock.ExpectQuery(escape("SELECT `index_name`, `column_name`, `sub_part`, `non_unique`, `seq_in_index` FROM `INFORMATION_SCHEMA`.`STATISTICS` WHERE `TABLE_SCHEMA` = ? AND `TABLE_NAME` = ? ORDER BY `index_name`, `seq_in_index`")).
 						WithArgs("public", "users").
@@ -117,6 +118,7 @@ func TestInspector_Tables(t *testing.T) {
 							AddRow("text", "text", 0, "NULL", 0).
 							AddRow("uuid", "uuid", 0, "NULL", 0).
 							AddRow("price", "real", 1, "NULL", 0).
+							AddRow("amount", "decimal(10,2)", 0, "NULL", 0).
 							AddRow("bank_id", "varchar(255)", 1, "NULL", 0))
 					mock.ExpectQuery(escape("SELECT `name`, `unique`, `origin` FROM pragma_index_list('users')")).
 						WillReturnRows(sqlmock.NewRows([]string{"name", "unique", "unique"}))
@@ -159,6 +161,7 @@ func TestInspector_Tables(t *testing.T) {
 							AddRow("text", "text", "YES", "NULL", "text", nil, nil, nil).
 							AddRow("uuid", "uuid", "YES", "NULL", "uuid", nil, nil, nil).
 							AddRow("price", "numeric", "NO", "NULL", "numeric", "6", "4", nil).
+							AddRow("amount", "numeric", "YES", "NULL", "numeric", "10", "2", nil).
 							AddRow("bank_id", "character", "NO", "NULL", "bpchar", nil, nil, 20))
 					mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "$1", "users"))).
 						WithArgs("public").
@@ -215,6 +218,7 @@ func TestInspector_Tables(t *testing.T) {
 							dialect.MySQL:    "decimal(6,4)",
 							dialect.Postgres: "numeric(6,4)",
 						}},
+						{Name: "amount", Type: field.TypeDecimal, Precision: 10, Scale: 2, Nullable: true},
 						{Name: "bank_id", Type: field.TypeString, SchemaType: map[string]string{
 							dialect.Postgres: "varchar(20)",
 						}},
@@ -318,6 +322,11 @@ func columnsMatch(t *testing.T, drv string, got, expected []*Column) {
 		if c2.SchemaType[drv] != "" {
 			require.Equal(t, c2.SchemaType[drv], c1.SchemaType[drv])
 		}
+		if c2.Type == field.TypeDecimal {
+			require.Equal(t, c2.Type, c1.Type)
+			require.Equal(t, c2.Precision, c1.Precision)
+			require.Equal(t, c2.Scale, c1.Scale)
+		}
 	}
 }
 
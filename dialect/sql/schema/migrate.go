@@ -43,6 +43,15 @@ func WithDropColumn(b bool) MigrateOption {
 	}
 }
 
+// WithDropDeprecated sets the deprecated columns dropping option to the migration.
+// When enabled, the existing columns of deprecated fields are dropped from their
+// tables, even if the WithDropColumn option is disabled. Defaults to false.
+func WithDropDeprecated(b bool) MigrateOption {
+	return func(m *Migrate) {
+		m.dropDeprecated = b
+	}
+}
+
 // WithDropIndex sets the indexes dropping option to the migration.
 // Defaults to false.
 func WithDropIndex(b bool) MigrateOption {
@@ -107,6 +116,7 @@ type Migrate struct {
 	sqlDialect
 	universalID     bool     // global unique ids.
 	dropColumns     bool     // drop deleted columns.
+	dropDeprecated  bool     // drop deprecated columns.
 	dropIndexes     bool     // drop deleted indexes.
 	withFixture     bool     // with fks rename fixture.
 	withForeignKeys bool     // with foreign keys
@@ -196,6 +206,12 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 			if err := m.apply(ctx, tx, t.Name, change); err != nil {
 				return err
 			}
+			if err := m.fullText(ctx, tx, curr, t); err != nil {
+				return err
+			}
+			if err := m.rowLevelSecurity(ctx, tx, curr, t); err != nil {
+				return err
+			}
 		default: // !exist
 			query, args := m.tBuilder(t).Query()
 			if err := tx.Exec(ctx, query, args, nil); err != nil {
@@ -215,6 +231,12 @@ func (m *Migrate) txCreate(ctx context.Context, tx dialect.Tx, tables ...*Table)
 					return fmt.Errorf("create index %q: %w", idx.Name, err)
 				}
 			}
+			if err := m.fullText(ctx, tx, nil, t); err != nil {
+				return err
+			}
+			if err := m.rowLevelSecurity(ctx, tx, nil, t); err != nil {
+				return err
+			}
 		}
 	}
 	if !m.withForeignKeys {
@@ -272,6 +294,9 @@ func (m *Migrate) apply(ctx context.Context, tx dialect.Tx, table string, change
 	if m.dropColumns {
 		drop = change.column.drop
 	}
+	if m.dropDeprecated {
+		drop = append(drop, change.column.deprecated...)
+	}
 	queries := m.alterColumns(table, change.column.add, change.column.modify, drop)
 	// If there's actual action to execute on ALTER TABLE.
 	for i := range queries {
@@ -296,6 +321,8 @@ type changes struct {
 		add    []*Column
 		drop   []*Column
 		modify []*Column
+		// deprecated columns to drop.
+		deprecated []*Column
 	}
 	// index changes.
 	index struct {
@@ -335,6 +362,13 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		if c1.PrimaryKey() {
 			continue
 		}
+		// Deprecated columns are dropped only if it was requested explicitly.
+		if c1.Deprecated && m.dropDeprecated {
+			if c2, ok := curr.column(c1.Name); ok {
+				change.column.deprecated = append(change.column.deprecated, c2)
+			}
+			continue
+		}
 		switch c2, ok := curr.column(c1.Name); {
 		case !ok:
 			change.column.add = append(change.column.add, c1)
@@ -387,7 +421,7 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 		// no longer behave the same. Therefore, these indexes should be dropped too. There's no need
 		// to do it explicitly (here), because entc will remove them from the schema specification,
 		// and they will be dropped in the block below.
-		if _, ok := new.column(c1.Name); !ok {
+		if _, ok := new.column(c1.Name); !ok && !new.fullTextName(c1.Name) {
 			change.column.drop = append(change.column.drop, c1)
 		}
 	}
@@ -413,13 +447,47 @@ func (m *Migrate) changeSet(curr, new *Table) (*changes, error) {
 
 	// Drop indexes.
 	for _, idx := range curr.Indexes {
-		if _, isFK := new.fk(idx.Name); !isFK && !new.hasIndex(idx.Name, idx.realname) {
+		if _, isFK := new.fk(idx.Name); !isFK && !new.hasIndex(idx.Name, idx.realname) && !new.fullTextName(idx.Name) && !new.fullTextName(idx.realname) {
 			change.index.drop.append(idx)
 		}
 	}
 	return change, nil
 }
 
+// fullText creates the full-text search resources of the given table (if it has full-text
+// searchable columns). The curr table is nil if the table was created by this migration.
+func (m *Migrate) fullText(ctx context.Context, tx dialect.Tx, curr, t *Table) error {
+	if len(t.fullTextColumns()) == 0 {
+		return nil
+	}
+	ft, ok := m.sqlDialect.(fullTexter)
+	if !ok {
+		return fmt.Errorf("full-text search is not supported by dialect %q", m.Dialect())
+	}
+	if err := ft.fullText(ctx, tx, curr, t); err != nil {
+		return fmt.Errorf("create full-text search for %q: %w", t.Name, err)
+	}
+	return nil
+}
+
+// rowLevelSecurity enables the row-level security of the given table and creates its policies
+// (if it was configured by the table annotation). The curr table is nil if the table was created
+// by this migration. Row-level security is a PostgreSQL feature, and it is ignored by dialects
+// that do not support it, like the session variables that are used by its policies.
+func (m *Migrate) rowLevelSecurity(ctx context.Context, tx dialect.Tx, curr, t *Table) error {
+	if t.Annotation == nil || t.Annotation.RowLevelSecurity == nil {
+		return nil
+	}
+	rs, ok := m.sqlDialect.(rowSecurer)
+	if !ok {
+		return nil
+	}
+	if err := rs.rowLevelSecurity(ctx, tx, curr, t); err != nil {
+		return fmt.Errorf("create row-level security for %q: %w", t.Name, err)
+	}
+	return nil
+}
+
 // fixture is a special migration code for renaming foreign-key columns (issue-#285).
 func (m *Migrate) fixture(ctx context.Context, tx dialect.Tx, curr, new *Table) error {
 	d, ok := m.sqlDialect.(fkRenamer)
@@ -688,3 +756,13 @@ type fkRenamer interface {
 type verifyRanger interface {
 	verifyRange(context.Context, dialect.Tx, *Table, int) error
 }
+
+// fullTexter wraps the method for creating the full-text search resources of a table.
+type fullTexter interface {
+	fullText(ctx context.Context, tx dialect.Tx, curr, t *Table) error
+}
+
+// rowSecurer wraps the method for enabling the row-level security of a table.
+type rowSecurer interface {
+	rowLevelSecurity(ctx context.Context, tx dialect.Tx, curr, t *Table) error
+}
@@ -14,9 +14,13 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlfts"
 	"entgo.io/ent/schema/field"
 )
 
+// maxKeyLen is the maximum length (in bytes) of index keys in InnoDB.
+const maxKeyLen = 3072
+
 // MySQL is a MySQL migration driver.
 type MySQL struct {
 	dialect.Driver
@@ -231,6 +235,10 @@ func (d *MySQL) cType(c *Column) (t string) {
 			size = c.Size
 		}
 		switch {
+		// BLOB columns cannot be indexed without a prefix length,
+		// and therefore, bounded unique columns use VARBINARY.
+		case c.Unique && c.Size > 0 && c.Size <= maxKeyLen:
+			t = fmt.Sprintf("varbinary(%d)", c.Size)
 		case size <= math.MaxUint8:
 			t = "tinyblob"
 		case size <= math.MaxUint16:
@@ -262,6 +270,14 @@ func (d *MySQL) cType(c *Column) (t string) {
 		}
 	case field.TypeFloat32, field.TypeFloat64:
 		t = c.scanTypeOr("double")
+	case field.TypeDecimal:
+		// Without precision, MySQL defaults to decimal(10,0). Therefore,
+		// we fall back to the maximum precision and scale that it supports.
+		p, s := c.Precision, c.Scale
+		if p == 0 {
+			p, s = 65, 30
+		}
+		t = c.scanTypeOr(fmt.Sprintf("decimal(%d,%d)", p, s))
 	case field.TypeTime:
 		t = c.scanTypeOr("timestamp")
 		// In MariaDB or in MySQL < v8.0.2, the TIMESTAMP column has both `DEFAULT CURRENT_TIMESTAMP`
@@ -289,14 +305,20 @@ func (d *MySQL) cType(c *Column) (t string) {
 // addColumn returns the DSL query for adding the given column to a table.
 // The syntax/order is: datatype [Charset] [Unique|Increment] [Collation] [Nullable].
 func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
-	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	b := sql.Column(c.Name).Type(d.cType(c))
+	// The collation of generated columns must precede the generation expression.
+	if c.Generated != nil && c.Collation != "" {
+		b.Attr("COLLATE " + c.Collation)
+	}
+	c.generated(b, dialect.MySQL, false)
+	b.Attr(c.Attr)
 	c.unique(b)
 	if c.Increment {
 		b.Attr("AUTO_INCREMENT")
 	}
 	c.nullable(b)
 	c.defaultValue(b)
-	if c.Collation != "" {
+	if c.Generated == nil && c.Collation != "" {
 		b.Attr("COLLATE " + c.Collation)
 	}
 	if c.Type == field.TypeJSON {
@@ -311,6 +333,18 @@ func (d *MySQL) addColumn(c *Column) *sql.ColumnBuilder {
 	return b
 }
 
+// position sets the position of the added or modified column, if it was defined.
+func (d *MySQL) position(b *sql.ColumnBuilder, c *Column) *sql.ColumnBuilder {
+	switch p := c.Position; {
+	case p == nil:
+	case p.First:
+		b.First()
+	case p.After != "":
+		b.After(p.After)
+	}
+	return b
+}
+
 // addIndex returns the querying for adding an index to MySQL.
 func (d *MySQL) addIndex(i *Index, table string) *sql.IndexBuilder {
 	idx := sql.CreateIndex(i.Name).Table(table)
@@ -335,6 +369,25 @@ func (d *MySQL) dropIndex(ctx context.Context, tx dialect.Tx, idx *Index, table
 	return tx.Exec(ctx, query, args, nil)
 }
 
+// fullText creates the FULLTEXT indexes of the full-text searchable columns.
+func (d *MySQL) fullText(ctx context.Context, tx dialect.Tx, curr, t *Table) error {
+	for _, c := range t.fullTextColumns() {
+		name := sqlfts.IndexName(t.Name, c.Name)
+		if curr != nil && curr.hasIndex(name) {
+			continue
+		}
+		b := &sql.Builder{}
+		b.SetDialect(dialect.MySQL)
+		b.WriteString("CREATE FULLTEXT INDEX ").Ident(name).WriteString(" ON ").Ident(t.Name).Nested(func(b *sql.Builder) {
+			b.Ident(c.Name)
+		})
+		if err := tx.Exec(ctx, b.String(), []interface{}{}, nil); err != nil {
+			return fmt.Errorf("mysql: create fulltext index %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
 // prepare runs preparation work that needs to be done to apply the change-set.
 func (d *MySQL) prepare(ctx context.Context, tx dialect.Tx, change *changes, table string) error {
 	for _, idx := range change.index.drop {
@@ -434,9 +487,10 @@ func (d *MySQL) scanColumn(c *Column, rows *sql.Rows) error {
 	case "double", "float":
 		c.Type = field.TypeFloat64
 	case "numeric", "decimal":
-		c.Type = field.TypeFloat64
+		c.Type = field.TypeDecimal
 		// If precision is specified then we should take that into account.
 		if numericPrecision.Valid {
+			c.Precision, c.Scale = numericPrecision.Int64, numericScale.Int64
 			schemaType := fmt.Sprintf("%s(%d,%d)", parts[0], numericPrecision.Int64, numericScale.Int64)
 			c.SchemaType = map[string]string{dialect.MySQL: schemaType}
 		}
@@ -598,10 +652,10 @@ func (d *MySQL) tables() sql.Querier {
 func (d *MySQL) alterColumns(table string, add, modify, drop []*Column) sql.Queries {
 	b := sql.Dialect(dialect.MySQL).AlterTable(table)
 	for _, c := range add {
-		b.AddColumn(d.addColumn(c))
+		b.AddColumn(d.position(d.addColumn(c), c))
 	}
 	for _, c := range modify {
-		b.ModifyColumn(d.addColumn(c))
+		b.ModifyColumn(d.position(d.addColumn(c), c))
 	}
 	for _, c := range drop {
 		b.DropColumn(sql.Dialect(dialect.MySQL).Column(c.Name))
@@ -7,12 +7,15 @@ package schema
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlfts"
 	"entgo.io/ent/schema/field"
 )
 
@@ -256,9 +259,10 @@ func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 	case "double precision":
 		c.Type = field.TypeFloat64
 	case "numeric", "decimal":
-		c.Type = field.TypeFloat64
+		c.Type = field.TypeDecimal
 		// If precision is specified then we should take that into account.
 		if numericPrecision.Valid {
+			c.Precision, c.Scale = numericPrecision.Int64, numericScale.Int64
 			schemaType := fmt.Sprintf("%s(%d,%d)", c.typ, numericPrecision.Int64, numericScale.Int64)
 			c.SchemaType = map[string]string{dialect.Postgres: schemaType}
 		}
@@ -280,7 +284,7 @@ func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 		c.Type = field.TypeJSON
 	case "uuid":
 		c.Type = field.TypeUUID
-	case "cidr", "inet", "macaddr", "macaddr8":
+	case "cidr", "inet", "macaddr", "macaddr8", "tsvector":
 		c.Type = field.TypeOther
 	case "ARRAY":
 		c.Type = field.TypeOther
@@ -293,7 +297,7 @@ func (d *Postgres) scanColumn(c *Column, rows *sql.Rows) error {
 		// database ignores any size or multi-dimensions constraints.
 		c.SchemaType = map[string]string{dialect.Postgres: "ARRAY"}
 		c.typ = udt.String
-	case "USER-DEFINED", "tstzrange", "interval":
+	case "USER-DEFINED", "interval", "int4range", "int8range", "numrange", "tsrange", "tstzrange", "daterange":
 		c.Type = field.TypeOther
 		if !udt.Valid {
 			return fmt.Errorf("missing user defined type for column %q", c.Name)
@@ -346,6 +350,14 @@ func (d *Postgres) cType(c *Column) (t string) {
 		t = c.scanTypeOr("real")
 	case field.TypeFloat64:
 		t = c.scanTypeOr("double precision")
+	case field.TypeDecimal:
+		// A numeric column without precision can store
+		// values of any precision (up to the limit).
+		numeric := "numeric"
+		if c.Precision != 0 {
+			numeric = fmt.Sprintf("numeric(%d,%d)", c.Precision, c.Scale)
+		}
+		t = c.scanTypeOr(numeric)
 	case field.TypeBytes:
 		t = "bytea"
 	case field.TypeJSON:
@@ -374,7 +386,10 @@ func (d *Postgres) cType(c *Column) (t string) {
 // addColumn returns the ColumnBuilder for adding the given column to a table.
 func (d *Postgres) addColumn(c *Column) *sql.ColumnBuilder {
 	b := sql.Dialect(dialect.Postgres).
-		Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+		Column(c.Name).Type(d.cType(c))
+	// PostgreSQL supports only stored generated columns.
+	c.generated(b, dialect.Postgres, true)
+	b.Attr(c.Attr)
 	c.unique(b)
 	if c.Increment {
 		b.Attr("GENERATED BY DEFAULT AS IDENTITY")
@@ -484,6 +499,191 @@ func (d *Postgres) dropIndex(ctx context.Context, tx dialect.Tx, idx *Index, tab
 	return tx.Exec(ctx, query, args, nil)
 }
 
+// fullText creates the generated tsvector columns of the full-text searchable columns,
+// and the GIN indexes for searching them. Generated columns require PostgreSQL 12.
+// Since generated columns cannot be altered, columns (and their indexes) that were
+// created with a different text search configuration are dropped and recreated.
+func (d *Postgres) fullText(ctx context.Context, tx dialect.Tx, curr, t *Table) error {
+	if compareVersions(d.version, "12.0.0") == -1 {
+		return fmt.Errorf("postgres: full-text search columns require version 12 or above: %s", d.version)
+	}
+	build := sql.Dialect(dialect.Postgres)
+	for _, c := range t.fullTextColumns() {
+		name := sqlfts.VectorColumn(c.Name)
+		exists := curr != nil && curr.HasColumn(name)
+		if exists {
+			config, err := d.vectorConfig(ctx, tx, t.Name, name)
+			if err != nil {
+				return err
+			}
+			expect := c.FullText.Config
+			if expect == "" {
+				expect = sqlfts.DefaultConfig
+			}
+			if config != "" && config != expect {
+				query, args := build.AlterTable(t.Name).DropColumn(build.Column(name)).Query()
+				if err := tx.Exec(ctx, query, args, nil); err != nil {
+					return fmt.Errorf("postgres: drop column %q: %w", name, err)
+				}
+				exists = false
+			}
+		}
+		if !exists {
+			query, args := build.AlterTable(t.Name).
+				AddColumn(build.Column(name).Type("tsvector").Attr(fmt.Sprintf("GENERATED ALWAYS AS (%s) STORED", sqlfts.VectorExpr(c.Name, c.FullText.Config)))).
+				Query()
+			if err := tx.Exec(ctx, query, args, nil); err != nil {
+				return fmt.Errorf("postgres: add column %q: %w", name, err)
+			}
+		}
+		idx := sqlfts.IndexName(t.Name, c.Name)
+		// Recreated columns require a new index, as dropping
+		// a column drops its indexes as well.
+		if exists && curr.hasIndex(idx) {
+			continue
+		}
+		query, args := build.CreateIndex(idx).IfNotExists().Table(t.Name).Using("gin").Column(name).Query()
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("postgres: create index %q: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+// vectorConfigRe matches the text search configuration in the generation expression
+// of tsvector columns. e.g. to_tsvector('english'::regconfig, COALESCE(...)).
+var vectorConfigRe = regexp.MustCompile(`to_tsvector\('([^']+)'::regconfig`)
+
+// vectorConfig returns the text search configuration of the given generated tsvector
+// column, or an empty string if it cannot be extracted from its generation expression.
+func (d *Postgres) vectorConfig(ctx context.Context, tx dialect.Tx, table, column string) (string, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(dialect.Postgres).
+		Select("generation_expression").
+		From(sql.Table("columns").Schema("information_schema")).
+		Where(sql.And(
+			d.matchSchema(),
+			sql.EQ("table_name", table),
+			sql.EQ("column_name", column),
+		)).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return "", fmt.Errorf("postgres: reading generation expression of column %q: %w", column, err)
+	}
+	defer rows.Close()
+	var expr sql.NullString
+	if rows.Next() {
+		if err := rows.Scan(&expr); err != nil {
+			return "", fmt.Errorf("postgres: scanning generation expression of column %q: %w", column, err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	if m := vectorConfigRe.FindStringSubmatch(expr.String); len(m) == 2 {
+		return m[1], nil
+	}
+	return "", nil
+}
+
+// rowLevelSecurity enables the row-level security of the table and creates its policies. Policies
+// that already exist in the database are altered to match the schema. Note that the command of
+// existing policies cannot be changed, and policies that were removed from the schema are kept.
+func (d *Postgres) rowLevelSecurity(ctx context.Context, tx dialect.Tx, curr, t *Table) error {
+	rls := t.Annotation.RowLevelSecurity
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("ALTER TABLE ").Ident(t.Name).WriteString(" ENABLE ROW LEVEL SECURITY")
+	switch {
+	case rls.Force:
+		b.WriteString(", FORCE ROW LEVEL SECURITY")
+	case curr != nil:
+		b.WriteString(", NO FORCE ROW LEVEL SECURITY")
+	}
+	if err := tx.Exec(ctx, b.String(), []interface{}{}, nil); err != nil {
+		return fmt.Errorf("postgres: enable row level security: %w", err)
+	}
+	exist := make(map[string]bool)
+	if curr != nil {
+		names, err := d.policies(ctx, tx, t.Name)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			exist[name] = true
+		}
+	}
+	for _, p := range rls.Policies {
+		query := createPolicy(t.Name, p)
+		if exist[p.Name] {
+			query = alterPolicy(t.Name, p)
+		}
+		if err := tx.Exec(ctx, query, []interface{}{}, nil); err != nil {
+			return fmt.Errorf("postgres: create policy %q: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+// policies returns the names of the row-level security policies of the given table.
+func (d *Postgres) policies(ctx context.Context, tx dialect.Tx, table string) ([]string, error) {
+	rows := &sql.Rows{}
+	query, args := sql.Dialect(dialect.Postgres).
+		Select("policyname").
+		From(sql.Table("pg_policies").Schema("pg_catalog")).
+		Where(sql.And(
+			d.matchSchema("schemaname"),
+			sql.EQ("tablename", table),
+		)).Query()
+	if err := tx.Query(ctx, query, args, rows); err != nil {
+		return nil, fmt.Errorf("postgres: reading table policies %w", err)
+	}
+	defer rows.Close()
+	var names []string
+	if err := sql.ScanSlice(rows, &names); err != nil {
+		return nil, fmt.Errorf("postgres: scanning table policies %w", err)
+	}
+	return names, nil
+}
+
+// createPolicy returns the CREATE POLICY statement of the given policy.
+func createPolicy(table string, p *entsql.RowPolicy) string {
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("CREATE POLICY ").Ident(p.Name).WriteString(" ON ").Ident(table)
+	if p.Restrictive {
+		b.WriteString(" AS RESTRICTIVE")
+	}
+	if p.Command != "" {
+		b.WriteString(" FOR ").WriteString(strings.ToUpper(p.Command))
+	}
+	policyClauses(b, p)
+	return b.String()
+}
+
+// alterPolicy returns the ALTER POLICY statement of the given policy.
+func alterPolicy(table string, p *entsql.RowPolicy) string {
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("ALTER POLICY ").Ident(p.Name).WriteString(" ON ").Ident(table)
+	policyClauses(b, p)
+	return b.String()
+}
+
+// policyClauses writes the TO, USING and WITH CHECK clauses of the policy.
+func policyClauses(b *sql.Builder, p *entsql.RowPolicy) {
+	roles := p.Roles
+	if len(roles) == 0 {
+		roles = []string{"PUBLIC"}
+	}
+	b.WriteString(" TO ").WriteString(strings.Join(roles, ", "))
+	if p.Using != "" {
+		b.WriteString(" USING (").WriteString(p.Using).WriteByte(')')
+	}
+	if p.Check != "" {
+		b.WriteString(" WITH CHECK (").WriteString(p.Check).WriteByte(')')
+	}
+}
+
 // isImplicitIndex reports if the index was created implicitly for the unique column.
 func (d *Postgres) isImplicitIndex(idx *Index, col *Column) bool {
 	return strings.TrimSuffix(idx.Name, "_key") == col.Name && col.Unique
@@ -549,7 +749,13 @@ func (d *Postgres) alterColumns(table string, add, modify, drop []*Column) sql.Q
 // (by table altering) to column "new".
 func (d *Postgres) needsConversion(old, new *Column) bool {
 	oldT, newT := d.cType(old), d.cType(new)
-	return oldT != newT && (oldT != "ARRAY" || !arrayType(newT))
+	if oldT == "ARRAY" && arrayType(newT) {
+		// Inspected arrays hold only their element type (e.g. "_int4"),
+		// and therefore, only the element types can be compared.
+		udt, ok := arrayUDT(newT)
+		return ok && old.typ != "" && old.typ != udt
+	}
+	return oldT != newT
 }
 
 // callExpr reports if the given string ~looks like a function call expression.
@@ -573,6 +779,42 @@ func isAlpha(r rune, digit bool) bool {
 	return 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || r == '_' || digit && '0' <= r && r <= '9'
 }
 
+// arrayElems maps the names and aliases of array element types to their internal names.
+var arrayElems = map[string]string{
+	"bool":                     "bool",
+	"boolean":                  "bool",
+	"smallint":                 "int2",
+	"int2":                     "int2",
+	"int":                      "int4",
+	"integer":                  "int4",
+	"int4":                     "int4",
+	"bigint":                   "int8",
+	"int8":                     "int8",
+	"real":                     "float4",
+	"float4":                   "float4",
+	"double precision":         "float8",
+	"float8":                   "float8",
+	"numeric":                  "numeric",
+	"text":                     "text",
+	"varchar":                  "varchar",
+	"character varying":        "varchar",
+	"uuid":                     "uuid",
+	"jsonb":                    "jsonb",
+	"timestamptz":              "timestamptz",
+	"timestamp with time zone": "timestamptz",
+}
+
+// arrayUDT returns the internal name of the given array type as reported by
+// the "udt_name" column of the information schema. e.g. "_int8" for "bigint[]".
+func arrayUDT(t string) (string, bool) {
+	elem := strings.TrimSpace(strings.ToLower(t[:strings.IndexByte(t, '[')]))
+	name, ok := arrayElems[elem]
+	if !ok {
+		return "", false
+	}
+	return "_" + name, true
+}
+
 // arrayType reports if the given string is an array type (e.g. int[], text[2]).
 func arrayType(t string) bool {
 	i, j := strings.LastIndexByte(t, '['), strings.LastIndexByte(t, ']')
@@ -85,6 +85,139 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create decimal columns",
+			tables: []*Table{
+				{
+					Name: "accounts",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "balance", Type: field.TypeDecimal, Precision: 10, Scale: 2, Default: "0.00"},
+						{Name: "rate", Type: field.TypeDecimal, Nullable: true},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("accounts", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "accounts"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "balance" numeric(10,2) NOT NULL DEFAULT 0.00, "rate" numeric NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create generated columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "first_name", Type: field.TypeString},
+						{Name: "full_name", Type: field.TypeString, Generated: &entsql.Generated{Expr: "first_name || ' ' || last_name", Exprs: map[string]string{dialect.MySQL: "CONCAT(first_name, ' ', last_name)"}}},
+						{Name: "revision", Type: field.TypeInt, Default: 1, Generated: &entsql.Generated{}},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "first_name" varchar NOT NULL, "full_name" varchar GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED NOT NULL, "revision" bigint NOT NULL DEFAULT 1, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create full-text search columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "bio", Type: field.TypeString, Nullable: true, FullText: &entsql.FullText{Config: "simple"}},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "bio" varchar NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "bio_fts" tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce("bio", ''))) STORED`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX IF NOT EXISTS "users_bio_fts" ON "users" USING "gin"("bio_fts")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "full-text search columns on unsupported version",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "bio", Type: field.TypeString, FullText: &entsql.FullText{}},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("110000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "bio" varchar NOT NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+		{
+			name: "create row-level security policies",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "tenant_id", Type: field.TypeInt},
+					},
+					Annotation: &entsql.Annotation{
+						RowLevelSecurity: &entsql.RowLevelSecurity{
+							Force: true,
+							Policies: []*entsql.RowPolicy{
+								{Name: "tenant_isolation", Using: "tenant_id = " + entsql.CurrentSetting("app.tenant_id") + "::bigint"},
+								{Name: "tenant_insert", Command: "insert", Roles: []string{"app"}, Restrictive: true, Check: "tenant_id > 0"},
+							},
+						},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape(`CREATE TABLE IF NOT EXISTS "users"("id" bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, "tenant_id" bigint NOT NULL, PRIMARY KEY("id"))`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ENABLE ROW LEVEL SECURITY, FORCE ROW LEVEL SECURITY`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(escape(`CREATE POLICY "tenant_isolation" ON "users" TO PUBLIC USING (tenant_id = NULLIF(current_setting('app.tenant_id', true), '')::bigint)`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(escape(`CREATE POLICY "tenant_insert" ON "users" AS RESTRICTIVE FOR INSERT TO app WITH CHECK (tenant_id > 0)`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "create new table with foreign key",
 			tables: func() []*Table {
@@ -328,6 +461,44 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "change array and range columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "tags", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "text[]"}},
+						{Name: "ints", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "bigint[]"}},
+						{Name: "custom", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "mytype[]"}},
+						{Name: "period", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "tstzrange"}},
+						{Name: "ids", Type: field.TypeOther, SchemaType: map[string]string{dialect.Postgres: "int8range"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8", nil, nil, nil).
+						AddRow("tags", "ARRAY", "NO", "NULL", "_text", nil, nil, nil).
+						AddRow("ints", "ARRAY", "NO", "NULL", "_int4", nil, nil, nil).
+						AddRow("custom", "ARRAY", "NO", "NULL", "_mytype", nil, nil, nil).
+						AddRow("period", "tstzrange", "NO", "NULL", "tstzrange", nil, nil, nil).
+						AddRow("ids", "int4range", "NO", "NULL", "int4range", nil, nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ALTER COLUMN "ints" TYPE bigint[], ALTER COLUMN "ints" SET NOT NULL, ALTER COLUMN "ids" TYPE int8range, ALTER COLUMN "ids" SET NOT NULL`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "add blob columns",
 			tables: []*Table{
@@ -485,6 +656,125 @@ func TestPostgres_Create(t *testing.T) {
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "keep full-text search columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "bio", Type: field.TypeString, Nullable: true, FullText: &entsql.FullText{}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			options: []MigrateOption{WithDropColumn(true), WithDropIndex(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8", nil, nil, nil).
+						AddRow("bio", "character varying", "YES", "NULL", "varchar", nil, nil, nil).
+						AddRow("bio_fts", "tsvector", "YES", "NULL", "tsvector", nil, nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0).
+						AddRow("users_bio_fts", "bio_fts", "f", "f", 0))
+				mock.ExpectQuery(escape(`SELECT "generation_expression" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1 AND "column_name" = $2`)).
+					WithArgs("users", "bio_fts").
+					WillReturnRows(sqlmock.NewRows([]string{"generation_expression"}).
+						AddRow(`to_tsvector('english'::regconfig, (COALESCE(bio, ''::character varying))::text)`))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "recreate full-text search columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "bio", Type: field.TypeString, Nullable: true, FullText: &entsql.FullText{Config: "simple"}},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+				},
+			},
+			options: []MigrateOption{WithDropColumn(true), WithDropIndex(true)},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8", nil, nil, nil).
+						AddRow("bio", "character varying", "YES", "NULL", "varchar", nil, nil, nil).
+						AddRow("bio_fts", "tsvector", "YES", "NULL", "tsvector", nil, nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0).
+						AddRow("users_bio_fts", "bio_fts", "f", "f", 0))
+				mock.ExpectQuery(escape(`SELECT "generation_expression" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1 AND "column_name" = $2`)).
+					WithArgs("users", "bio_fts").
+					WillReturnRows(sqlmock.NewRows([]string{"generation_expression"}).
+						AddRow(`to_tsvector('english'::regconfig, (COALESCE(bio, ''::character varying))::text)`))
+				mock.ExpectExec(escape(`ALTER TABLE "users" DROP COLUMN "bio_fts"`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ADD COLUMN "bio_fts" tsvector GENERATED ALWAYS AS (to_tsvector('simple', coalesce("bio", ''))) STORED`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape(`CREATE INDEX IF NOT EXISTS "users_bio_fts" ON "users" USING "gin"("bio_fts")`)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "update row-level security policies",
+			tables: []*Table{
+				{
+					Name: "users",
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Annotation: &entsql.Annotation{
+						RowLevelSecurity: &entsql.RowLevelSecurity{
+							Policies: []*entsql.RowPolicy{
+								{Name: "owner", Using: "id = 1"},
+								{Name: "reader", Command: "SELECT", Using: "true"},
+							},
+						},
+					},
+				},
+			},
+			before: func(mock pgMock) {
+				mock.start("120000")
+				mock.tableExists("users", true)
+				mock.ExpectQuery(escape(`SELECT "column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length" FROM "information_schema"."columns" WHERE "table_schema" = CURRENT_SCHEMA() AND "table_name" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"column_name", "data_type", "is_nullable", "column_default", "udt_name", "numeric_precision", "numeric_scale", "character_maximum_length"}).
+						AddRow("id", "bigint", "NO", "NULL", "int8", nil, nil, nil))
+				mock.ExpectQuery(escape(fmt.Sprintf(indexesQuery, "CURRENT_SCHEMA()", "users"))).
+					WillReturnRows(sqlmock.NewRows([]string{"index_name", "column_name", "primary", "unique", "seq_in_index"}).
+						AddRow("users_pkey", "id", "t", "t", 0))
+				mock.ExpectExec(escape(`ALTER TABLE "users" ENABLE ROW LEVEL SECURITY, NO FORCE ROW LEVEL SECURITY`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(escape(`SELECT "policyname" FROM "pg_catalog"."pg_policies" WHERE "schemaname" = CURRENT_SCHEMA() AND "tablename" = $1`)).
+					WithArgs("users").
+					WillReturnRows(sqlmock.NewRows([]string{"policyname"}).AddRow("owner"))
+				mock.ExpectExec(escape(`ALTER POLICY "owner" ON "users" TO PUBLIC USING (id = 1)`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(escape(`CREATE POLICY "reader" ON "users" FOR SELECT TO PUBLIC USING (true)`)).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectCommit()
+			},
+		},
 		{
 			name: "modify column to nullable",
 			tables: []*Table{
@@ -13,6 +13,7 @@ import (
 
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlfts"
 	"entgo.io/ent/schema/field"
 )
 
@@ -162,6 +163,28 @@ func (t *Table) hasIndex(names ...string) bool {
 	return false
 }
 
+// fullTextColumns returns the full-text searchable columns of the table.
+func (t *Table) fullTextColumns() []*Column {
+	var columns []*Column
+	for _, c := range t.Columns {
+		if c.FullText != nil {
+			columns = append(columns, c)
+		}
+	}
+	return columns
+}
+
+// fullTextName reports if the given name is a name of a resource (column or index)
+// that was created by the migration for searching one of the table columns.
+func (t *Table) fullTextName(name string) bool {
+	for _, c := range t.fullTextColumns() {
+		if name == sqlfts.VectorColumn(c.Name) || name == sqlfts.IndexName(t.Name, c.Name) {
+			return true
+		}
+	}
+	return false
+}
+
 // fk returns a table foreign-key by its symbol.
 // faster than map lookup for most cases.
 func (t *Table) fk(symbol string) (*ForeignKey, bool) {
@@ -180,6 +203,8 @@ type Column struct {
 	SchemaType map[string]string // optional schema type per dialect.
 	Attr       string            // extra attributes.
 	Size       int64             // max size parameter for string, blob, etc.
+	Precision  int64             // precision of decimal columns.
+	Scale      int64             // scale of decimal columns.
 	Key        string            // key definition (PRI, UNI or MUL).
 	Unique     bool              // column with unique constraint.
 	Increment  bool              // auto increment attribute.
@@ -187,6 +212,10 @@ type Column struct {
 	Default    interface{}       // default value.
 	Enums      []string          // enum values.
 	Collation  string            // collation type (utf8mb4_unicode_ci, utf8mb4_general_ci)
+	Generated  *entsql.Generated // generated column definition.
+	Deprecated bool              // deprecated column.
+	Position   *entsql.Position  // column position.
+	FullText   *entsql.FullText  // full-text search configuration.
 	typ        string            // row column type (used for Rows.Scan).
 	indexes    Indexes           // linked indexes.
 	foreign    *ForeignKey       // linked foreign-key.
@@ -203,6 +232,13 @@ func (c *Column) PrimaryKey() bool { return c.Key == PrimaryKey }
 // ConvertibleTo reports whether a column can be converted to the new column without altering its data.
 func (c *Column) ConvertibleTo(d *Column) bool {
 	switch {
+	case c.Type == field.TypeDecimal && d.Type == field.TypeDecimal:
+		// Widening a decimal column must not reduce the
+		// number of integer digits or the fractional digits.
+		if c.Precision == 0 || d.Precision == 0 {
+			return d.Precision == 0
+		}
+		return c.Scale <= d.Scale && c.Precision-c.Scale <= d.Precision-d.Scale
 	case c.Type == d.Type:
 		if c.Size != 0 && d.Size != 0 {
 			// Types match and have a size constraint.
@@ -219,6 +255,12 @@ func (c *Column) ConvertibleTo(d *Column) bool {
 		return true
 	case c.Type.Integer() && d.Type == field.TypeString:
 		return true
+	case c.Type.Integer() && d.Type == field.TypeDecimal:
+		return true
+	// Decimal columns were inspected as float columns in previous versions, and
+	// float fields were stored in decimal columns using a custom SchemaType.
+	case c.Type == field.TypeDecimal && d.FloatType() || c.FloatType() && d.Type == field.TypeDecimal:
+		return true
 	}
 	return c.FloatType() && d.FloatType()
 }
@@ -254,6 +296,9 @@ func (c *Column) ScanDefault(value string) error {
 			return fmt.Errorf("scanning float value for column %q: %w", c.Name, err)
 		}
 		c.Default = v.Float64
+	case c.Type == field.TypeDecimal:
+		// Decimal defaults are kept as strings for not losing precision.
+		c.Default = strings.Trim(value, "'")
 	case c.Type == field.TypeBool:
 		v := &sql.NullBool{}
 		if err := v.Scan(value); err != nil {
@@ -318,6 +363,24 @@ func (c Column) supportDefault() bool {
 	}
 }
 
+// generated adds the `GENERATED ALWAYS AS` attribute if the column is generated
+// by an expression. Columns that are maintained by the database without a
+// generation expression (e.g. using triggers) are defined as regular columns.
+func (c *Column) generated(b *sql.ColumnBuilder, dialect string, stored bool) {
+	if c.Generated == nil {
+		return
+	}
+	expr := c.Generated.ExprFor(dialect)
+	if expr == "" {
+		return
+	}
+	kind := "VIRTUAL"
+	if stored || c.Generated.Stored {
+		kind = "STORED"
+	}
+	b.Attr(fmt.Sprintf("GENERATED ALWAYS AS (%s) %s", expr, kind))
+}
+
 // unique adds the `UNIQUE` attribute if the column is a unique type.
 // it is exist in a different function to share the common declaration
 // between the two dialects.
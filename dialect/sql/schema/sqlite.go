@@ -7,10 +7,12 @@ package schema
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlfts"
 	"entgo.io/ent/schema/field"
 )
 
@@ -116,6 +118,13 @@ func (*SQLite) cType(c *Column) (t string) {
 		t = fmt.Sprintf("varchar(%d)", DefaultStringLen)
 	case field.TypeFloat32, field.TypeFloat64:
 		t = "real"
+	case field.TypeDecimal:
+		// Decimal columns have the NUMERIC type affinity,
+		// and the precision is used only for documentation.
+		t = "decimal"
+		if c.Precision != 0 {
+			t = fmt.Sprintf("decimal(%d,%d)", c.Precision, c.Scale)
+		}
 	case field.TypeTime:
 		t = "datetime"
 	case field.TypeJSON:
@@ -132,7 +141,9 @@ func (*SQLite) cType(c *Column) (t string) {
 
 // addColumn returns the DSL query for adding the given column to a table.
 func (d *SQLite) addColumn(c *Column) *sql.ColumnBuilder {
-	b := sql.Column(c.Name).Type(d.cType(c)).Attr(c.Attr)
+	b := sql.Column(c.Name).Type(d.cType(c))
+	c.generated(b, dialect.SQLite, false)
+	b.Attr(c.Attr)
 	c.unique(b)
 	if c.PrimaryKey() && c.Increment {
 		b.Attr("PRIMARY KEY AUTOINCREMENT")
@@ -153,6 +164,53 @@ func (d *SQLite) dropIndex(ctx context.Context, tx dialect.Tx, idx *Index, table
 	return tx.Exec(ctx, query, args, nil)
 }
 
+// fullText creates the external content FTS5 virtual tables of the full-text searchable
+// columns, and the triggers that keep them in sync with the content table. Note that
+// FTS5 is enabled in github.com/mattn/go-sqlite3 by the "sqlite_fts5" build tag.
+func (d *SQLite) fullText(ctx context.Context, tx dialect.Tx, curr, t *Table) error {
+	if len(t.PrimaryKey) != 1 || !t.PrimaryKey[0].IntType() && !t.PrimaryKey[0].UintType() {
+		return fmt.Errorf("sqlite: full-text search requires a single integer primary key for table %q", t.Name)
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.SQLite)
+	var (
+		table = b.Quote(t.Name)
+		rowid = b.Quote(t.PrimaryKey[0].Name)
+	)
+	for _, c := range t.fullTextColumns() {
+		name := sqlfts.IndexName(t.Name, c.Name)
+		exists, err := d.tableExist(ctx, tx, name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		var (
+			fts     = b.Quote(name)
+			column  = b.Quote(c.Name)
+			insert  = fmt.Sprintf("INSERT INTO %s(rowid, %s) VALUES (new.%s, new.%s);", fts, column, rowid, column)
+			remove  = fmt.Sprintf("INSERT INTO %s(%s, rowid, %s) VALUES ('delete', old.%s, old.%s);", fts, fts, column, rowid, column)
+			queries = []string{
+				fmt.Sprintf("CREATE VIRTUAL TABLE %s USING fts5(%s, content='%s', content_rowid='%s')", fts, column, t.Name, t.PrimaryKey[0].Name),
+				fmt.Sprintf("CREATE TRIGGER %s AFTER INSERT ON %s BEGIN %s END", b.Quote(name+"_insert"), table, insert),
+				fmt.Sprintf("CREATE TRIGGER %s AFTER DELETE ON %s BEGIN %s END", b.Quote(name+"_delete"), table, remove),
+				fmt.Sprintf("CREATE TRIGGER %s AFTER UPDATE OF %s ON %s BEGIN %s %s END", b.Quote(name+"_update"), column, table, remove, insert),
+			}
+		)
+		// Index the rows that already exist in the table.
+		if curr != nil {
+			queries = append(queries, fmt.Sprintf("INSERT INTO %s(%s) VALUES ('rebuild')", fts, fts))
+		}
+		for _, query := range queries {
+			if err := tx.Exec(ctx, query, []interface{}{}, nil); err != nil {
+				return fmt.Errorf("sqlite: create full-text search table %q: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
+
 // fkExist returns always true to disable foreign-keys creation after the table was created.
 func (d *SQLite) fkExist(context.Context, dialect.Tx, string) (bool, error) { return true, nil }
 
@@ -309,7 +367,17 @@ func (d *SQLite) scanColumn(c *Column, rows *sql.Rows) error {
 		c.Size = size
 		c.Type = field.TypeString
 	case "decimal", "numeric":
-		c.Type = field.TypeOther
+		c.Type = field.TypeDecimal
+		if len(parts) > 1 {
+			if c.Precision, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+				return fmt.Errorf("converting %s precision to int: %w", parts[0], err)
+			}
+		}
+		if len(parts) > 2 {
+			if c.Scale, err = strconv.ParseInt(parts[2], 10, 64); err != nil {
+				return fmt.Errorf("converting %s scale to int: %w", parts[0], err)
+			}
+		}
 	}
 	if defaults.Valid {
 		return c.ScanDefault(defaults.String)
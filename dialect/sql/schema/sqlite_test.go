@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/field"
 
@@ -65,17 +66,126 @@ func TestSQLite_Create(t *testing.T) {
 						{Name: "doc", Type: field.TypeJSON, Nullable: true},
 						{Name: "uuid", Type: field.TypeUUID, Nullable: true},
 						{Name: "decimal", Type: field.TypeFloat32, SchemaType: map[string]string{dialect.SQLite: "decimal(6,2)"}},
+						{Name: "balance", Type: field.TypeDecimal, Precision: 10, Scale: 2},
+						{Name: "rate", Type: field.TypeDecimal, Nullable: true},
 					},
 				},
 			},
 			before: func(mock sqliteMock) {
 				mock.start()
 				mock.tableExists("users", false)
-				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) NULL, `age` integer NOT NULL, `doc` json NULL, `uuid` uuid NULL, `decimal` decimal(6,2) NOT NULL)")).
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `name` varchar(255) NULL, `age` integer NOT NULL, `doc` json NULL, `uuid` uuid NULL, `decimal` decimal(6,2) NOT NULL, `balance` decimal(10,2) NOT NULL, `rate` decimal NULL)")).
 					WillReturnResult(sqlmock.NewResult(0, 1))
 				mock.ExpectCommit()
 			},
 		},
+		{
+			name: "create generated columns",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "first_name", Type: field.TypeString},
+						{Name: "full_name", Type: field.TypeString, Generated: &entsql.Generated{Expr: "first_name || ' ' || last_name", Stored: true}},
+						{Name: "name_length", Type: field.TypeInt, Generated: &entsql.Generated{Expr: "length(first_name)"}},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `first_name` varchar(255) NOT NULL, `full_name` varchar(255) GENERATED ALWAYS AS (first_name || ' ' || last_name) STORED NOT NULL, `name_length` integer GENERATED ALWAYS AS (length(first_name)) VIRTUAL NOT NULL)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "ignore row-level security",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "tenant_id", Type: field.TypeInt},
+					},
+					Annotation: &entsql.Annotation{
+						RowLevelSecurity: &entsql.RowLevelSecurity{
+							Policies: []*entsql.RowPolicy{
+								{Name: "tenant_isolation", Using: "tenant_id = 1"},
+							},
+						},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `tenant_id` integer NOT NULL)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "create full-text search table",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeInt, Increment: true},
+						{Name: "bio", Type: field.TypeString, Nullable: true, FullText: &entsql.FullText{}},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` integer PRIMARY KEY AUTOINCREMENT NOT NULL, `bio` varchar(255) NULL)")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.tableExists("users_bio_fts", false)
+				mock.ExpectExec(escape("CREATE VIRTUAL TABLE `users_bio_fts` USING fts5(`bio`, content='users', content_rowid='id')")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE TRIGGER `users_bio_fts_insert` AFTER INSERT ON `users` BEGIN INSERT INTO `users_bio_fts`(rowid, `bio`) VALUES (new.`id`, new.`bio`); END")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE TRIGGER `users_bio_fts_delete` AFTER DELETE ON `users` BEGIN INSERT INTO `users_bio_fts`(`users_bio_fts`, rowid, `bio`) VALUES ('delete', old.`id`, old.`bio`); END")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(escape("CREATE TRIGGER `users_bio_fts_update` AFTER UPDATE OF `bio` ON `users` BEGIN INSERT INTO `users_bio_fts`(`users_bio_fts`, rowid, `bio`) VALUES ('delete', old.`id`, old.`bio`); INSERT INTO `users_bio_fts`(rowid, `bio`) VALUES (new.`id`, new.`bio`); END")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "full-text search without integer primary key",
+			tables: []*Table{
+				{
+					Name: "users",
+					PrimaryKey: []*Column{
+						{Name: "id", Type: field.TypeString},
+					},
+					Columns: []*Column{
+						{Name: "id", Type: field.TypeString},
+						{Name: "bio", Type: field.TypeString, FullText: &entsql.FullText{}},
+					},
+				},
+			},
+			before: func(mock sqliteMock) {
+				mock.start()
+				mock.tableExists("users", false)
+				mock.ExpectExec(escape("CREATE TABLE `users`(`id` varchar(255) NOT NULL, `bio` varchar(255) NOT NULL, PRIMARY KEY(`id`))")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
 		{
 			name: "create new table with foreign key",
 			tables: func() []*Table {
@@ -0,0 +1,175 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package sqlfts provides full-text search predicates and orderings for columns
+// that were annotated with the entsql.FullText annotation. The search is backed
+// by the native capabilities of each dialect:
+//
+//	MySQL:      FULLTEXT index (MATCH ... AGAINST).
+//	PostgreSQL: generated tsvector column with a GIN index (@@ operator).
+//	SQLite:     external content FTS5 virtual table kept in sync with triggers.
+//
+package sqlfts
+
+import (
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DefaultConfig is the default text search configuration used by PostgreSQL.
+const DefaultConfig = "english"
+
+// Column describes a full-text searchable column.
+type Column struct {
+	// Table and Name are the names of the table and the column.
+	Table, Name string
+	// RowID is the name of the integer primary-key column of the table.
+	// It is used by SQLite for linking the rows to the FTS5 table.
+	RowID string
+	// Config is the text search configuration used by PostgreSQL.
+	// Defaults to DefaultConfig.
+	Config string
+}
+
+// VectorColumn returns the name of the generated tsvector column
+// that is used by PostgreSQL for searching the given column.
+func VectorColumn(column string) string {
+	return column + "_fts"
+}
+
+// VectorExpr returns the PostgreSQL expression that is used for
+// generating the tsvector column of the given column.
+func VectorExpr(column, config string) string {
+	if config == "" {
+		config = DefaultConfig
+	}
+	b := &sql.Builder{}
+	b.SetDialect(dialect.Postgres)
+	b.WriteString("to_tsvector(").WriteString(quote(config)).WriteString(", coalesce(").Ident(column).WriteString(", ''))")
+	return b.String()
+}
+
+// IndexName returns the name of the index that is used by MySQL and
+// PostgreSQL for searching the column. In SQLite, it is the name of
+// the FTS5 virtual table.
+func IndexName(table, column string) string {
+	return table + "_" + column + "_fts"
+}
+
+// Matches returns a predicate for checking that the full-text searchable
+// column matches the given query.
+//
+//	s.Where(sqlfts.Matches(s, sqlfts.Column{Table: "users", Name: "bio", RowID: "id"}, "graph orm"))
+//
+func Matches(s *sql.Selector, c Column, query string) *sql.Predicate {
+	return sql.P(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.MySQL:
+			match(b, s, c, query)
+		case dialect.Postgres:
+			b.WriteString(s.C(VectorColumn(c.Name))).WriteString(" @@ ")
+			tsquery(b, c, query)
+		case dialect.SQLite:
+			name := IndexName(c.Table, c.Name)
+			b.WriteString(s.C(rowID(c))).WriteOp(sql.OpIn).Nested(func(b *sql.Builder) {
+				b.WriteString("SELECT rowid FROM ").Ident(name).WriteString(" WHERE ").Ident(name).WriteString(" MATCH ").Arg(query)
+			})
+		default:
+			b.AddError(&UnsupportedDialectError{Dialect: b.Dialect()})
+		}
+	})
+}
+
+// OrderRelevance returns a custom ordering function for ordering the rows
+// by their relevance to the given query. The most relevant rows first.
+//
+//	client.User.Query().
+//		Where(user.BioMatches("graph orm")).
+//		Order(user.ByBioRelevance("graph orm"))
+//
+func OrderRelevance(c Column, query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			switch b.Dialect() {
+			case dialect.MySQL:
+				match(b, s, c, query)
+				b.WriteString(" DESC")
+			case dialect.Postgres:
+				b.WriteString("ts_rank").Nested(func(b *sql.Builder) {
+					b.WriteString(s.C(VectorColumn(c.Name))).Comma()
+					tsquery(b, c, query)
+				})
+				b.WriteString(" DESC")
+			case dialect.SQLite:
+				// The FTS5 rank is the negated BM25 score, and therefore, better
+				// matches have smaller values. Rows that do not match are last.
+				name := IndexName(c.Table, c.Name)
+				b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+					b.Nested(func(b *sql.Builder) {
+						b.WriteString("SELECT rank FROM ").Ident(name).WriteString(" WHERE ").Ident(name).WriteString(" MATCH ").Arg(query)
+						b.WriteString(" AND rowid = ").WriteString(s.C(rowID(c)))
+					})
+					b.WriteString(", 0")
+				})
+			default:
+				b.AddError(&UnsupportedDialectError{Dialect: b.Dialect()})
+			}
+		}))
+	}
+}
+
+// UnsupportedDialectError is returned when a full-text
+// search is used with an unsupported dialect.
+type UnsupportedDialectError struct {
+	Dialect string
+}
+
+// Error implements the error interface.
+func (e *UnsupportedDialectError) Error() string {
+	return "sqlfts: full-text search is not supported by dialect " + e.Dialect
+}
+
+// match writes the MySQL MATCH expression for the given column.
+func match(b *sql.Builder, s *sql.Selector, c Column, query string) {
+	b.WriteString("MATCH").Nested(func(b *sql.Builder) {
+		b.WriteString(s.C(c.Name))
+	})
+	b.WriteString(" AGAINST").Nested(func(b *sql.Builder) {
+		b.Arg(query).WriteString(" IN NATURAL LANGUAGE MODE")
+	})
+}
+
+// tsquery writes the PostgreSQL tsquery for the given column.
+func tsquery(b *sql.Builder, c Column, query string) {
+	b.WriteString("plainto_tsquery").Nested(func(b *sql.Builder) {
+		b.WriteString(quote(config(c))).Comma().Arg(query)
+	})
+}
+
+func config(c Column) string {
+	if c.Config != "" {
+		return c.Config
+	}
+	return DefaultConfig
+}
+
+func rowID(c Column) string {
+	if c.RowID != "" {
+		return c.RowID
+	}
+	return "id"
+}
+
+// quote returns the given string as a single-quoted SQL literal.
+func quote(s string) string {
+	b := make([]byte, 0, len(s)+2)
+	b = append(b, '\'')
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\'' {
+			b = append(b, '\'')
+		}
+		b = append(b, s[i])
+	}
+	return string(append(b, '\''))
+}
@@ -0,0 +1,95 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlfts_test
+
+import (
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlfts"
+	"github.com/stretchr/testify/require"
+)
+
+var bio = sqlfts.Column{Table: "users", Name: "bio", RowID: "id"}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		dialect   string
+		column    sqlfts.Column
+		wantQuery string
+	}{
+		{
+			dialect:   dialect.MySQL,
+			column:    bio,
+			wantQuery: "SELECT * FROM `users` WHERE `age` > ? AND MATCH(`users`.`bio`) AGAINST(? IN NATURAL LANGUAGE MODE)",
+		},
+		{
+			dialect:   dialect.Postgres,
+			column:    bio,
+			wantQuery: `SELECT * FROM "users" WHERE "age" > $1 AND "users"."bio_fts" @@ plainto_tsquery('english', $2)`,
+		},
+		{
+			dialect:   dialect.Postgres,
+			column:    sqlfts.Column{Table: "users", Name: "bio", Config: "simple"},
+			wantQuery: `SELECT * FROM "users" WHERE "age" > $1 AND "users"."bio_fts" @@ plainto_tsquery('simple', $2)`,
+		},
+		{
+			dialect:   dialect.SQLite,
+			column:    bio,
+			wantQuery: "SELECT * FROM `users` WHERE `age` > ? AND `users`.`id` IN (SELECT rowid FROM `users_bio_fts` WHERE `users_bio_fts` MATCH ?)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("users"))
+			s.Where(sql.And(sql.GT("age", 30), sqlfts.Matches(s, tt.column, "graph orm")))
+			query, args := s.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, []interface{}{30, "graph orm"}, args)
+		})
+	}
+}
+
+func TestOrderRelevance(t *testing.T) {
+	tests := []struct {
+		dialect   string
+		wantQuery string
+	}{
+		{
+			dialect:   dialect.MySQL,
+			wantQuery: "SELECT * FROM `users` WHERE `age` > ? ORDER BY MATCH(`users`.`bio`) AGAINST(? IN NATURAL LANGUAGE MODE) DESC",
+		},
+		{
+			dialect:   dialect.Postgres,
+			wantQuery: `SELECT * FROM "users" WHERE "age" > $1 ORDER BY ts_rank("users"."bio_fts", plainto_tsquery('english', $2)) DESC`,
+		},
+		{
+			dialect:   dialect.SQLite,
+			wantQuery: "SELECT * FROM `users` WHERE `age` > ? ORDER BY COALESCE((SELECT rank FROM `users_bio_fts` WHERE `users_bio_fts` MATCH ? AND rowid = `users`.`id`), 0)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("users")).Where(sql.GT("age", 30))
+			sqlfts.OrderRelevance(bio, "graph orm")(s)
+			query, args := s.Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, []interface{}{30, "graph orm"}, args)
+		})
+	}
+}
+
+func TestVectorExpr(t *testing.T) {
+	require.Equal(t, `to_tsvector('english', coalesce("bio", ''))`, sqlfts.VectorExpr("bio", ""))
+	require.Equal(t, `to_tsvector('it''s', coalesce("bio", ''))`, sqlfts.VectorExpr("bio", "it's"))
+}
+
+func TestUnsupportedDialect(t *testing.T) {
+	s := sql.Dialect("gremlin").Select("*").From(sql.Table("users"))
+	s.Where(sqlfts.Matches(s, bio, "graph orm"))
+	s.Query()
+	require.Error(t, s.Err())
+}
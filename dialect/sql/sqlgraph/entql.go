@@ -6,8 +6,13 @@ package sqlgraph
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entql"
 )
 
@@ -86,21 +91,205 @@ func (g *Schema) MustAddE(name string, spec *EdgeSpec, from, to string) {
 
 // EvalP evaluates the entql predicate on the given selector (query builder).
 func (g *Schema) EvalP(nodeType string, p entql.P, selector *sql.Selector) error {
-	var node *Node
+	node, err := g.node(nodeType)
+	if err != nil {
+		return err
+	}
+	pr, err := evalExpr(node, selector, p)
+	if err != nil {
+		return err
+	}
+	selector.Where(pr)
+	return nil
+}
+
+// AllowList maps node types to the names of the fields and edges that
+// can be used in predicates parsed from untrusted input. For example:
+//
+//	sqlgraph.AllowList{
+//		"User": {"name", "age", "pets"},
+//		"Pet":  {"name"},
+//	}
+//
+type AllowList map[string][]string
+
+// allowed reports if the field or edge is allowed for the node type.
+func (a AllowList) allowed(nodeType, name string) bool {
+	for _, n := range a[nodeType] {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseP parses the textual representation of an entql predicate (see entql.Parse)
+// of the given node type, and validates its fields and edges against the graph schema
+// and the allow-list. A nil allow-list permits all fields and edges in the schema,
+// and should be used only for trusted input.
+func (g *Schema) ParseP(nodeType, input string, allow AllowList) (entql.P, error) {
+	if _, err := g.node(nodeType); err != nil {
+		return nil, err
+	}
+	return entql.ParseWith(input, nodeType, &checker{graph: g, allow: allow})
+}
+
+// CheckQuery validates the fields and edges that are used for filtering, ordering, projecting
+// and eager-loading by the query, and by the queries of its eager-loaded edges, against the
+// graph schema and the allow-list. A nil allow-list permits all fields and edges in the schema.
+func (g *Schema) CheckQuery(nodeType string, q *entql.Query, allow AllowList) error {
+	if _, err := g.node(nodeType); err != nil {
+		return err
+	}
+	return g.checkQuery(nodeType, q, &checker{graph: g, allow: allow})
+}
+
+func (g *Schema) checkQuery(nodeType string, q *entql.Query, c *checker) error {
+	if q == nil {
+		return nil
+	}
+	if q.Limit < 0 || q.Offset < 0 {
+		return fmt.Errorf("invalid limit (%d) or offset (%d) for node %q", q.Limit, q.Offset, nodeType)
+	}
+	if q.Where != nil {
+		if err := c.checkExpr(nodeType, q.Where); err != nil {
+			return err
+		}
+	}
+	for _, o := range q.Order {
+		if o == nil {
+			return fmt.Errorf("nil order for node %q", nodeType)
+		}
+		if err := c.CheckField(nodeType, o.Field); err != nil {
+			return err
+		}
+	}
+	for _, f := range q.Select {
+		if err := c.CheckField(nodeType, f); err != nil {
+			return err
+		}
+	}
+	for name, wq := range q.With {
+		to, err := c.CheckEdge(nodeType, name)
+		if err != nil {
+			return err
+		}
+		if err := g.checkQuery(to, wq, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// node returns the node of the given type.
+func (g *Schema) node(nodeType string) (*Node, error) {
 	for i := range g.Nodes {
 		if g.Nodes[i].Type == nodeType {
-			node = g.Nodes[i]
-			break
+			return g.Nodes[i], nil
 		}
 	}
-	if node == nil {
-		return fmt.Errorf("node %s was not found in the graph schema", nodeType)
+	return nil, fmt.Errorf("node %s was not found in the graph schema", nodeType)
+}
+
+// checker implements the entql.Checker interface
+// using the graph schema and an allow-list.
+type checker struct {
+	graph *Schema
+	allow AllowList
+}
+
+// CheckField implements the entql.Checker interface.
+func (c *checker) CheckField(nodeType, field string) error {
+	node, err := c.graph.node(nodeType)
+	if err != nil {
+		return err
+	}
+	if _, ok := node.Fields[field]; !ok && node.ID.Column != field {
+		return fmt.Errorf("field %q was not found for node %q", field, nodeType)
 	}
-	pr, err := evalExpr(node, selector, p)
+	if c.allow != nil && !c.allow.allowed(nodeType, field) {
+		return fmt.Errorf("field %q is not allowed for node %q", field, nodeType)
+	}
+	return nil
+}
+
+// CheckEdge implements the entql.Checker interface.
+func (c *checker) CheckEdge(nodeType, edge string) (string, error) {
+	node, err := c.graph.node(nodeType)
 	if err != nil {
+		return "", err
+	}
+	e, ok := node.Edges[edge]
+	if !ok {
+		return "", fmt.Errorf("edge %q was not found for node %q", edge, nodeType)
+	}
+	if c.allow != nil && !c.allow.allowed(nodeType, edge) {
+		return "", fmt.Errorf("edge %q is not allowed for node %q", edge, nodeType)
+	}
+	return e.To.Type, nil
+}
+
+// checkExpr validates the fields and edges that are used by the expression.
+func (c *checker) checkExpr(nodeType string, x entql.Expr) error {
+	switch x := x.(type) {
+	case *entql.UnaryExpr:
+		return c.checkExpr(nodeType, x.X)
+	case *entql.BinaryExpr:
+		if err := c.checkExpr(nodeType, x.X); err != nil {
+			return err
+		}
+		return c.checkExpr(nodeType, x.Y)
+	case *entql.NaryExpr:
+		for _, x := range x.Xs {
+			if err := c.checkExpr(nodeType, x); err != nil {
+				return err
+			}
+		}
+	case *entql.CallExpr:
+		return c.checkCall(nodeType, x)
+	case *entql.Field:
+		return c.CheckField(nodeType, x.Name)
+	case *entql.Edge:
+		_, err := c.CheckEdge(nodeType, x.Name)
 		return err
 	}
-	selector.Where(pr)
+	return nil
+}
+
+// checkCall validates the fields and edges that are used by the call expression.
+// The arguments of edge functions are validated against the neighbors node type.
+func (c *checker) checkCall(nodeType string, x *entql.CallExpr) error {
+	switch x.Func {
+	case entql.FuncHasEdge, entql.FuncEdgeCount, entql.FuncEdgeField:
+		if len(x.Args) == 0 {
+			return fmt.Errorf("invalid number of arguments for %s", x.Func)
+		}
+		edge, ok := x.Args[0].(*entql.Edge)
+		if !ok {
+			return fmt.Errorf("expect *entql.Edge for %s, got %T", x.Func, x.Args[0])
+		}
+		to, err := c.CheckEdge(nodeType, edge.Name)
+		if err != nil {
+			return err
+		}
+		for _, arg := range x.Args[1:] {
+			if err := c.checkExpr(to, arg); err != nil {
+				return err
+			}
+		}
+	case FuncSelector:
+		// Selector functions can access any column, and
+		// therefore, they cannot be used with allow-lists.
+		if c.allow != nil {
+			return fmt.Errorf("function %s is not allowed for node %q", x.Func, nodeType)
+		}
+	default:
+		for _, arg := range x.Args {
+			if err := c.checkExpr(nodeType, arg); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -113,6 +302,11 @@ type wrappedFunc struct {
 	Func func(*sql.Selector)
 }
 
+// String implements the fmt.Stringer interface.
+func (wrappedFunc) String() string {
+	return "func(*sql.Selector)"
+}
+
 // WrapFunc wraps a selector-func with an entql call expression.
 func WrapFunc(s func(*sql.Selector)) *entql.CallExpr {
 	return &entql.CallExpr{
@@ -147,6 +341,22 @@ var (
 		entql.OpEQ:  sql.IsNull,
 		entql.OpNEQ: sql.NotNull,
 	}
+	jsonOp = [...]func(string, interface{}, ...sqljson.Option) *sql.Predicate{
+		entql.OpEQ:  sqljson.ValueEQ,
+		entql.OpNEQ: sqljson.ValueNEQ,
+		entql.OpGT:  sqljson.ValueGT,
+		entql.OpGTE: sqljson.ValueGTE,
+		entql.OpLT:  sqljson.ValueLT,
+		entql.OpLTE: sqljson.ValueLTE,
+	}
+	// truncFormats holds the MySQL and SQLite formats of the date_trunc units.
+	truncFormats = map[string][2]string{
+		"year":   {"%Y-01-01 00:00:00", "%Y-01-01 00:00:00"},
+		"month":  {"%Y-%m-01 00:00:00", "%Y-%m-01 00:00:00"},
+		"day":    {"%Y-%m-%d 00:00:00", "%Y-%m-%d 00:00:00"},
+		"hour":   {"%Y-%m-%d %H:00:00", "%Y-%m-%d %H:00:00"},
+		"minute": {"%Y-%m-%d %H:%i:00", "%Y-%m-%d %H:%M:00"},
+	}
 )
 
 // state represents the state of a predicate evaluation.
@@ -156,6 +366,9 @@ type state struct {
 	sql.Builder
 	context  *Node
 	selector *sql.Selector
+	// aliases counts the table aliases that were
+	// generated for correlated sub-queries.
+	aliases *int
 }
 
 // evalExpr evaluates the entql expression and returns a new SQL predicate to be applied on the database.
@@ -163,6 +376,19 @@ func evalExpr(context *Node, selector *sql.Selector, expr entql.Expr) (p *sql.Pr
 	ex := &state{
 		context:  context,
 		selector: selector,
+		aliases:  new(int),
+	}
+	defer catch(&err)
+	p = ex.evalExpr(expr)
+	return
+}
+
+// evalNested evaluates the entql expression on a selector of a sub-query.
+func (e *state) evalNested(context *Node, selector *sql.Selector, expr entql.Expr) (p *sql.Predicate, err error) {
+	ex := &state{
+		context:  context,
+		selector: selector,
+		aliases:  e.aliases,
 	}
 	defer catch(&err)
 	p = ex.evalExpr(expr)
@@ -193,6 +419,37 @@ func (e *state) evalExpr(expr entql.Expr) *sql.Predicate {
 			s, ok := v.V.(string)
 			expect(ok, "string value, got %T", v.V)
 			return strFunc[expr.Func](e.field(f), s)
+		case entql.FuncLike, entql.FuncRegexp:
+			expect(len(expr.Args) == 2, "invalid number of arguments for %s", expr.Func)
+			c, s := e.fieldString(expr.Args[0], expr.Args[1])
+			if expr.Func == entql.FuncLike {
+				return sql.Like(c, s)
+			}
+			op := " REGEXP "
+			switch e.selector.Dialect() {
+			case dialect.Postgres:
+				op = " ~ "
+			case dialect.SQLite:
+				// SQLite does not provide a default implementation for the REGEXP operator.
+				panic(evalError{fmt.Sprintf("%s is not supported by the %s dialect", expr.Func, dialect.SQLite)})
+			}
+			return sql.P(func(b *sql.Builder) {
+				b.Ident(c).WriteString(op).Arg(s)
+			})
+		case entql.FuncJSONHasKey:
+			expect(len(expr.Args) == 2, "invalid number of arguments for %s", expr.Func)
+			c, path := e.fieldString(expr.Args[0], expr.Args[1])
+			expect(path != "", "non-empty path for %s", expr.Func)
+			return sqljson.HasKey(c, jsonPath(path))
+		case entql.FuncJSONContains:
+			expect(len(expr.Args) == 3, "invalid number of arguments for %s", expr.Func)
+			c, path := e.fieldString(expr.Args[0], expr.Args[1])
+			v, ok := expr.Args[2].(*entql.Value)
+			expect(ok && v != nil, "*entql.Value, got %T", expr.Args[2])
+			if path == "" {
+				return sqljson.ValueContains(c, v.V)
+			}
+			return sqljson.ValueContains(c, v.V, jsonPath(path))
 		case entql.FuncHasEdge:
 			expect(len(expr.Args) > 0, "invalid number of arguments for %s", expr.Func)
 			edge, ok := expr.Args[0].(*entql.Edge)
@@ -212,34 +469,245 @@ func (e *state) evalBinary(expr *entql.BinaryExpr) *sql.Predicate {
 		return sql.And(e.evalExpr(expr.X), e.evalExpr(expr.Y))
 	case entql.OpEQ, entql.OpNEQ:
 		if expr.Y == (*entql.Value)(nil) {
+			if call, ok := expr.X.(*entql.CallExpr); ok && call.Func == entql.FuncJSONValue {
+				c, path := e.jsonValue(call)
+				p := sqljson.ValueIsNull(c, jsonPath(path))
+				if expr.Op == entql.OpNEQ {
+					p = sql.Not(p)
+				}
+				return p
+			}
 			f, ok := expr.X.(*entql.Field)
 			expect(ok, "*entql.Field, got %T", expr.Y)
 			return nullFunc[expr.Op](e.field(f))
 		}
 		fallthrough
 	default:
-		field, ok := expr.X.(*entql.Field)
-		expect(ok, "expr.X to be *entql.Field (got %T)", expr.X)
-		_, ok = expr.Y.(*entql.Field)
-		if !ok {
-			_, ok = expr.Y.(*entql.Value)
-		}
-		expect(ok, "expr.Y to be *entql.Field or *entql.Value (got %T)", expr.X)
-		switch x := expr.Y.(type) {
-		case *entql.Field:
-			return sql.ColumnsOp(e.field(field), e.field(x), binary[expr.Op])
-		case *entql.Value:
-			c := e.field(field)
-			return sql.P(func(b *sql.Builder) {
-				b.Ident(c).WriteOp(binary[expr.Op])
-				args(b, x)
-			})
-		default:
-			panic("unreachable")
+		expect(int(expr.Op) < len(binary), "binary operator, got %s", expr.Op)
+		if isCall(expr.X, entql.FuncEdgeField) || isCall(expr.Y, entql.FuncEdgeField) {
+			return e.evalEdgeField(expr)
+		}
+		if call, ok := expr.X.(*entql.CallExpr); ok && call.Func == entql.FuncJSONValue {
+			v, ok := expr.Y.(*entql.Value)
+			expect(ok, "%s to be compared with *entql.Value (got %T)", entql.FuncJSONValue, expr.Y)
+			expect(int(expr.Op) < len(jsonOp) && jsonOp[expr.Op] != nil, "%s to be compared using a comparison operator (got %s)", entql.FuncJSONValue, expr.Op)
+			c, path := e.jsonValue(call)
+			return jsonOp[expr.Op](c, v.V, jsonPath(path))
+		}
+		_, isValue := expr.X.(*entql.Value)
+		expect(!isValue, "expr.X to be *entql.Field or an operand call (got %T)", expr.X)
+		x, y := e.operand(expr.X), e.operand(expr.Y)
+		return sql.P(func(b *sql.Builder) {
+			x(b)
+			b.WriteOp(binary[expr.Op])
+			y(b)
+		})
+	}
+}
+
+// operand evaluates the operands of binary expressions.
+func (e *state) operand(expr entql.Expr) func(*sql.Builder) {
+	switch x := expr.(type) {
+	case *entql.Field:
+		c := e.field(x)
+		return func(b *sql.Builder) {
+			b.Ident(c)
+		}
+	case *entql.Value:
+		expect(x != nil, "non-nil *entql.Value")
+		return func(b *sql.Builder) {
+			args(b, x)
+		}
+	case *entql.CallExpr:
+		switch x.Func {
+		case entql.FuncEdgeCount:
+			return e.evalEdgeCount(x)
+		case entql.FuncDateTrunc:
+			return e.evalDateTrunc(x)
+		case entql.FuncDateAdd:
+			return e.evalDateAdd(x)
+		}
+	}
+	panic(evalError{fmt.Sprintf("expect *entql.Field, *entql.Value or an operand call, got %s", expr)})
+}
+
+// evalEdgeCount evaluates the edge_count operand to a counting sub-query.
+func (e *state) evalEdgeCount(call *entql.CallExpr) func(*sql.Builder) {
+	expect(len(call.Args) > 0, "invalid number of arguments for %s", call.Func)
+	edge, ok := call.Args[0].(*entql.Edge)
+	expect(ok, "*entql.Edge, got %T", call.Args[0])
+	sub, to := e.neighbors(edge.Name)
+	for _, expr := range call.Args[1:] {
+		p, err := e.evalNested(to, sub, expr)
+		expect(err == nil, "edge evaluation failed for %s->%s: %s", e.context.Type, edge.Name, err)
+		sub.Where(p)
+	}
+	sub.Count()
+	return func(b *sql.Builder) {
+		b.Nested(func(b *sql.Builder) {
+			b.Join(sub)
+		})
+	}
+}
+
+// evalEdgeField evaluates a binary expression that compares a field of the
+// neighbors (edge_field) to an operand of the node, using an EXISTS sub-query.
+func (e *state) evalEdgeField(expr *entql.BinaryExpr) *sql.Predicate {
+	call, other, swap := expr.X, expr.Y, false
+	if !isCall(call, entql.FuncEdgeField) {
+		call, other, swap = expr.Y, expr.X, true
+	}
+	expect(!isCall(other, entql.FuncEdgeField), "a single %s operand in a binary expression", entql.FuncEdgeField)
+	args := call.(*entql.CallExpr).Args
+	expect(len(args) == 2, "invalid number of arguments for %s", entql.FuncEdgeField)
+	edge, ok := args[0].(*entql.Edge)
+	expect(ok, "*entql.Edge, got %T", args[0])
+	f, ok := args[1].(*entql.Field)
+	expect(ok, "*entql.Field, got %T", args[1])
+	sub, to := e.neighbors(edge.Name)
+	x := (&state{context: to, selector: sub, aliases: e.aliases}).operand(f)
+	y := e.operand(other)
+	if swap {
+		x, y = y, x
+	}
+	sub.Select(sub.C(to.ID.Column)).Where(sql.P(func(b *sql.Builder) {
+		x(b)
+		b.WriteOp(binary[expr.Op])
+		y(b)
+	}))
+	return sql.Exists(sub)
+}
+
+// neighbors returns a sub-query that selects the neighbors of the edge that are connected
+// to the nodes of the current selector (a correlated sub-query), and the type of the neighbors.
+// The tables of the sub-query are aliased, to allow referencing the nodes of self-edges.
+func (e *state) neighbors(name string) (*sql.Selector, *Node) {
+	edge, ok := e.context.Edges[name]
+	expect(ok, "edge %q was not found for node %q", name, e.context.Type)
+	builder := sql.Dialect(e.selector.Dialect())
+	to := builder.Table(edge.To.Table).Schema(edge.To.Schema).As(e.alias())
+	sub := builder.Select().From(to)
+	sub.WithContext(e.selector.Context())
+	switch r, spec := edge.Spec.Rel, edge.Spec; {
+	case r == M2M:
+		pk1, pk2 := spec.Columns[1], spec.Columns[0]
+		if spec.Inverse {
+			pk1, pk2 = pk2, pk1
+		}
+		join := builder.Table(spec.Table).Schema(spec.Schema).As(e.alias())
+		sub.Join(join).
+			On(join.C(pk1), to.C(edge.To.ID.Column)).
+			Where(sql.ColumnsEQ(join.C(pk2), e.selector.C(e.context.ID.Column)))
+	case r == M2O || (r == O2O && spec.Inverse):
+		sub.Where(sql.ColumnsEQ(to.C(edge.To.ID.Column), e.selector.C(spec.Columns[0])))
+	case r == O2M || (r == O2O && !spec.Inverse):
+		sub.Where(sql.ColumnsEQ(to.C(spec.Columns[0]), e.selector.C(e.context.ID.Column)))
+	}
+	return sub, edge.To
+}
+
+// alias returns a new table alias for a correlated sub-query.
+func (e *state) alias() string {
+	*e.aliases++
+	return "e" + strconv.Itoa(*e.aliases)
+}
+
+// evalDateTrunc evaluates the date_trunc operand.
+func (e *state) evalDateTrunc(call *entql.CallExpr) func(*sql.Builder) {
+	expect(len(call.Args) == 2, "invalid number of arguments for %s", call.Func)
+	v, ok := call.Args[0].(*entql.Value)
+	expect(ok && v != nil, "*entql.Value, got %T", call.Args[0])
+	unit, ok := v.V.(string)
+	expect(ok, "string value, got %T", v.V)
+	formats, ok := truncFormats[strings.ToLower(unit)]
+	expect(ok, "unit to be one of year, month, day, hour or minute (got %q)", unit)
+	x := e.operand(call.Args[1])
+	switch e.selector.Dialect() {
+	case dialect.Postgres:
+		return func(b *sql.Builder) {
+			b.WriteString("date_trunc('" + strings.ToLower(unit) + "', ")
+			x(b)
+			b.WriteByte(')')
+		}
+	case dialect.MySQL:
+		return func(b *sql.Builder) {
+			b.WriteString("CAST(DATE_FORMAT(")
+			x(b)
+			b.WriteString(", '" + formats[0] + "') AS DATETIME)")
+		}
+	default:
+		return func(b *sql.Builder) {
+			b.WriteString("strftime('" + formats[1] + "', ")
+			x(b)
+			b.WriteByte(')')
 		}
 	}
 }
 
+// evalDateAdd evaluates the date_add operand.
+func (e *state) evalDateAdd(call *entql.CallExpr) func(*sql.Builder) {
+	expect(len(call.Args) == 2, "invalid number of arguments for %s", call.Func)
+	v, ok := call.Args[1].(*entql.Value)
+	expect(ok && v != nil, "*entql.Value, got %T", call.Args[1])
+	s, ok := v.V.(string)
+	expect(ok, "string value, got %T", v.V)
+	d, err := time.ParseDuration(s)
+	expect(err == nil, "valid duration: %v", err)
+	x := e.operand(call.Args[0])
+	secs := strconv.FormatFloat(d.Seconds(), 'f', -1, 64)
+	switch e.selector.Dialect() {
+	case dialect.Postgres:
+		return func(b *sql.Builder) {
+			x(b)
+			b.WriteString(" + INTERVAL '" + secs + " seconds'")
+		}
+	case dialect.MySQL:
+		return func(b *sql.Builder) {
+			b.WriteString("DATE_ADD(")
+			x(b)
+			b.WriteString(", INTERVAL " + strconv.FormatInt(d.Microseconds(), 10) + " MICROSECOND)")
+		}
+	default:
+		return func(b *sql.Builder) {
+			b.WriteString("datetime(")
+			x(b)
+			b.WriteString(", '" + secs + " seconds')")
+		}
+	}
+}
+
+// jsonValue returns the column and the path of a json_value call.
+func (e *state) jsonValue(call *entql.CallExpr) (string, string) {
+	expect(len(call.Args) == 2, "invalid number of arguments for %s", call.Func)
+	c, path := e.fieldString(call.Args[0], call.Args[1])
+	expect(path != "", "non-empty path for %s", call.Func)
+	return c, path
+}
+
+// fieldString returns the column of a field argument, and the string value of a value argument.
+func (e *state) fieldString(fx, vx entql.Expr) (string, string) {
+	f, ok := fx.(*entql.Field)
+	expect(ok, "*entql.Field, got %T", fx)
+	v, ok := vx.(*entql.Value)
+	expect(ok && v != nil, "*entql.Value, got %T", vx)
+	s, ok := v.V.(string)
+	expect(ok, "string value, got %T", v.V)
+	return e.field(f), s
+}
+
+// jsonPath returns the sqljson option for the given dot-path.
+func jsonPath(path string) sqljson.Option {
+	_, err := sqljson.ParsePath(path)
+	expect(err == nil, "valid JSON path: %v", err)
+	return sqljson.DotPath(path)
+}
+
+// isCall reports if the expression is a call to the given function.
+func isCall(expr entql.Expr, fn entql.Func) bool {
+	call, ok := expr.(*entql.CallExpr)
+	return ok && call.Func == fn
+}
+
 // evalEdge evaluates has-edge and has-edge-with calls.
 func (e *state) evalEdge(name string, exprs ...entql.Expr) *sql.Predicate {
 	edge, ok := e.context.Edges[name]
@@ -263,7 +731,7 @@ func (e *state) evalEdge(name string, exprs ...entql.Expr) *sql.Predicate {
 				expect(ok, "invalid argument for %s: %T", FuncSelector, cx.Args[0])
 				wrapped.Func(s)
 			} else {
-				p, err := evalExpr(edge.To, s, expr)
+				p, err := e.evalNested(edge.To, s, expr)
 				expect(err == nil, "edge evaluation failed for %s->%s: %s", e.context.Type, name, err)
 				s.Where(p)
 			}
@@ -7,6 +7,7 @@ package sqlgraph
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -39,8 +40,10 @@ func TestGraph_EvalP(t *testing.T) {
 					ID:    &FieldSpec{Column: "uid"},
 				},
 				Fields: map[string]*FieldSpec{
-					"name": {Column: "name", Type: field.TypeString},
-					"last": {Column: "last", Type: field.TypeString},
+					"name":       {Column: "name", Type: field.TypeString},
+					"last":       {Column: "last", Type: field.TypeString},
+					"labels":     {Column: "labels", Type: field.TypeJSON},
+					"created_at": {Column: "created_at", Type: field.TypeTime},
 				},
 			},
 			{
@@ -73,6 +76,8 @@ func TestGraph_EvalP(t *testing.T) {
 	require.NoError(t, err)
 	err = g.AddE("users", &EdgeSpec{Rel: M2M, Inverse: true, Table: "user_groups", Columns: []string{"user_id", "group_id"}}, "group", "user")
 	require.NoError(t, err)
+	err = g.AddE("friends", &EdgeSpec{Rel: M2M, Table: "user_friends", Columns: []string{"user_id", "friend_id"}}, "user", "user")
+	require.NoError(t, err)
 
 	tests := []struct {
 		s         *sql.Selector
@@ -159,6 +164,95 @@ func TestGraph_EvalP(t *testing.T) {
 			wantQuery: `SELECT * FROM "users" WHERE "active" = $1 AND "users"."uid" IN (SELECT "pets"."owner_id" FROM "pets" WHERE "pets"."name" = $2 AND "owner_id" = $3)`,
 			wantArgs:  []interface{}{true, "pedro", 10},
 		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.And(entql.FieldLike("name", "a_%"), entql.FieldRegexp("last", "^a+$")),
+			wantQuery: `SELECT * FROM "users" WHERE "users"."name" LIKE $1 AND "users"."last" ~ $2`,
+			wantArgs:  []interface{}{"a_%", "^a+$"},
+		},
+		{
+			s:         sql.Dialect(dialect.MySQL).Select().From(sql.Table("users")),
+			p:         entql.FieldRegexp("last", "^a+$"),
+			wantQuery: "SELECT * FROM `users` WHERE `users`.`last` REGEXP ?",
+			wantArgs:  []interface{}{"^a+$"},
+		},
+		{
+			s:         sql.Dialect(dialect.SQLite).Select().From(sql.Table("users")),
+			p:         entql.FieldRegexp("last", "^a+$"),
+			wantQuery: "SELECT * FROM `users`",
+			wantErr:   true,
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.And(entql.FieldJSONHasKey("labels", "owner.name"), entql.EQ(entql.JSONValue("labels", "owner.name"), &entql.Value{V: "a8m"})),
+			wantQuery: `SELECT * FROM "users" WHERE "users"."labels"->'owner'->'name' IS NOT NULL AND "users"."labels"->'owner'->>'name' = $1`,
+			wantArgs:  []interface{}{"a8m"},
+		},
+		{
+			s:         sql.Dialect(dialect.MySQL).Select().From(sql.Table("users")),
+			p:         entql.And(entql.GT(entql.JSONValue("labels", "size"), &entql.Value{V: 1}), entql.FieldJSONContains("labels", "tags", "a")),
+			wantQuery: "SELECT * FROM `users` WHERE JSON_EXTRACT(`users`.`labels`, \"$.size\") > ? AND JSON_CONTAINS(`users`.`labels`, ?, \"$.tags\") = ?",
+			wantArgs:  []interface{}{1, `"a"`, 1},
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.EQ(entql.JSONValue("labels", "size"), entql.F("name")),
+			wantQuery: `SELECT * FROM "users"`,
+			wantErr:   true,
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.GT(entql.EdgeCount("pets"), &entql.Value{V: 1}),
+			wantQuery: `SELECT * FROM "users" WHERE (SELECT COUNT(*) FROM "pets" AS "e1" WHERE "e1"."owner_id" = "users"."uid") > $1`,
+			wantArgs:  []interface{}{1},
+		},
+		{
+			s: sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")).Where(sql.EQ("active", true)),
+			p: entql.Or(
+				entql.EQ(entql.EdgeCount("friends", entql.FieldHasPrefix("name", "a")), &entql.Value{V: 2}),
+				entql.LT(entql.EdgeCount("groups"), entql.EdgeCount("friends")),
+			),
+			wantQuery: `SELECT * FROM "users" WHERE "active" = $1 AND ((SELECT COUNT(*) FROM "users" AS "e1" JOIN "user_friends" AS "e2" ON "e2"."friend_id" = "e1"."uid" WHERE "e2"."user_id" = "users"."uid" AND "e1"."name" LIKE $2) = $3 OR (SELECT COUNT(*) FROM "groups" AS "e3" JOIN "user_groups" AS "e4" ON "e4"."group_id" = "e3"."gid" WHERE "e4"."user_id" = "users"."uid") < (SELECT COUNT(*) FROM "users" AS "e5" JOIN "user_friends" AS "e6" ON "e6"."friend_id" = "e5"."uid" WHERE "e6"."user_id" = "users"."uid"))`,
+			wantArgs:  []interface{}{true, "a%", 2},
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.EQ(entql.F("last"), entql.EdgeField("friends", "name")),
+			wantQuery: `SELECT * FROM "users" WHERE EXISTS (SELECT "e1"."uid" FROM "users" AS "e1" JOIN "user_friends" AS "e2" ON "e2"."friend_id" = "e1"."uid" WHERE "e2"."user_id" = "users"."uid" AND "users"."last" = "e1"."name")`,
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.HasEdgeWith("pets", entql.NEQ(entql.EdgeField("owner", "name"), entql.F("name"))),
+			wantQuery: `SELECT * FROM "users" WHERE "users"."uid" IN (SELECT "pets"."owner_id" FROM "pets" WHERE EXISTS (SELECT "e1"."uid" FROM "users" AS "e1" WHERE "e1"."uid" = "pets"."owner_id" AND "e1"."name" <> "pets"."name"))`,
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.EQ(entql.EdgeField("pets", "name"), entql.EdgeField("groups", "name")),
+			wantQuery: `SELECT * FROM "users"`,
+			wantErr:   true,
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.GT(entql.DateAdd(entql.F("created_at"), 24*time.Hour), entql.DateTrunc("day", entql.F("created_at"))),
+			wantQuery: `SELECT * FROM "users" WHERE "users"."created_at" + INTERVAL '86400 seconds' > date_trunc('day', "users"."created_at")`,
+		},
+		{
+			s:         sql.Dialect(dialect.MySQL).Select().From(sql.Table("users")),
+			p:         entql.GT(entql.DateAdd(entql.F("created_at"), -time.Hour), entql.DateTrunc("minute", entql.F("created_at"))),
+			wantQuery: "SELECT * FROM `users` WHERE DATE_ADD(`users`.`created_at`, INTERVAL -3600000000 MICROSECOND) > CAST(DATE_FORMAT(`users`.`created_at`, '%Y-%m-%d %H:%i:00') AS DATETIME)",
+		},
+		{
+			s:         sql.Dialect(dialect.SQLite).Select().From(sql.Table("users")),
+			p:         entql.EQ(entql.DateTrunc("month", entql.DateAdd(entql.F("created_at"), 90*time.Minute)), &entql.Value{V: "2021-01-01 00:00:00"}),
+			wantQuery: "SELECT * FROM `users` WHERE strftime('%Y-%m-01 00:00:00', datetime(`users`.`created_at`, '5400 seconds')) = ?",
+			wantArgs:  []interface{}{"2021-01-01 00:00:00"},
+		},
+		{
+			s:         sql.Dialect(dialect.Postgres).Select().From(sql.Table("users")),
+			p:         entql.EQ(entql.DateTrunc("week", entql.F("created_at")), entql.F("created_at")),
+			wantQuery: `SELECT * FROM "users"`,
+			wantErr:   true,
+		},
 	}
 	for i, tt := range tests {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -170,3 +264,185 @@ func TestGraph_EvalP(t *testing.T) {
 		})
 	}
 }
+
+func TestGraph_ParseP(t *testing.T) {
+	g := &Schema{
+		Nodes: []*Node{
+			{
+				Type: "user",
+				NodeSpec: NodeSpec{
+					Table: "users",
+					ID:    &FieldSpec{Column: "uid"},
+				},
+				Fields: map[string]*FieldSpec{
+					"name":     {Column: "name", Type: field.TypeString},
+					"password": {Column: "password", Type: field.TypeString},
+				},
+			},
+			{
+				Type: "pet",
+				NodeSpec: NodeSpec{
+					Table: "pets",
+					ID:    &FieldSpec{Column: "pid"},
+				},
+				Fields: map[string]*FieldSpec{
+					"name": {Column: "name", Type: field.TypeString},
+				},
+			},
+		},
+	}
+	g.MustAddE("pets", &EdgeSpec{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}}, "user", "pet")
+	g.MustAddE("owner", &EdgeSpec{Rel: M2O, Inverse: true, Table: "pets", Columns: []string{"owner_id"}}, "pet", "user")
+	allow := AllowList{
+		"user": {"uid", "name", "pets"},
+		"pet":  {"name"},
+	}
+
+	p, err := g.ParseP("user", `uid > 1 && has_edge(pets, has_prefix(name, "p"))`, allow)
+	require.NoError(t, err)
+	s := sql.Dialect(dialect.Postgres).Select().From(sql.Table("users"))
+	require.NoError(t, g.EvalP("user", p, s))
+	query, args := s.Query()
+	require.Equal(t, `SELECT * FROM "users" WHERE "users"."uid" > $1 AND "users"."uid" IN (SELECT "pets"."owner_id" FROM "pets" WHERE "pets"."name" LIKE $2)`, query)
+	require.Equal(t, []interface{}{1, "p%"}, args)
+
+	tests := []struct {
+		input   string
+		allow   AllowList
+		wantErr string
+	}{
+		{
+			input:   `password == "pass"`,
+			allow:   allow,
+			wantErr: `entql: parse error at position 0: field "password" is not allowed for node "user"`,
+		},
+		{
+			input:   `name == "a8m" && age > 30`,
+			wantErr: `entql: parse error at position 17: field "age" was not found for node "user"`,
+		},
+		{
+			input:   `has_edge(pets, has_edge(owner))`,
+			allow:   allow,
+			wantErr: `entql: parse error at position 24: edge "owner" is not allowed for node "pet"`,
+		},
+		{
+			input:   `has_edge(groups)`,
+			wantErr: `entql: parse error at position 9: edge "groups" was not found for node "user"`,
+		},
+		{
+			input: `password == "pass" && has_edge(pets, has_edge(owner))`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			_, err := g.ParseP("user", tt.input, tt.allow)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+	_, err = g.ParseP("group", `name == "a8m"`, nil)
+	require.Error(t, err)
+}
+
+func TestGraph_CheckQuery(t *testing.T) {
+	g := &Schema{
+		Nodes: []*Node{
+			{
+				Type:     "user",
+				NodeSpec: NodeSpec{Table: "users", ID: &FieldSpec{Column: "id"}},
+				Fields:   map[string]*FieldSpec{"name": {Column: "name", Type: field.TypeString}},
+			},
+			{
+				Type:     "pet",
+				NodeSpec: NodeSpec{Table: "pets", ID: &FieldSpec{Column: "id"}},
+				Fields:   map[string]*FieldSpec{"name": {Column: "name", Type: field.TypeString}},
+			},
+		},
+	}
+	g.MustAddE("pets", &EdgeSpec{Rel: O2M, Table: "pets", Columns: []string{"owner_id"}}, "user", "pet")
+	g.MustAddE("owner", &EdgeSpec{Rel: M2O, Inverse: true, Table: "pets", Columns: []string{"owner_id"}}, "pet", "user")
+	tests := []struct {
+		q       *entql.Query
+		allow   AllowList
+		wantErr string
+	}{
+		{q: nil},
+		{
+			q: &entql.Query{
+				Order:  []*entql.Order{entql.Desc("id"), entql.Asc("name")},
+				Select: []string{"name"},
+				With: map[string]*entql.Query{
+					"pets": {Select: []string{"name"}, With: map[string]*entql.Query{"owner": nil}},
+				},
+			},
+		},
+		{
+			q:       &entql.Query{Order: []*entql.Order{entql.Asc("age")}},
+			wantErr: `field "age" was not found for node "user"`,
+		},
+		{
+			q:       &entql.Query{Limit: -1},
+			wantErr: `invalid limit (-1) or offset (0) for node "user"`,
+		},
+		{
+			q:       &entql.Query{With: map[string]*entql.Query{"pets": {Select: []string{"age"}}}},
+			wantErr: `field "age" was not found for node "pet"`,
+		},
+		{
+			q:       &entql.Query{With: map[string]*entql.Query{"pets": {With: map[string]*entql.Query{"owner": nil}}}},
+			allow:   AllowList{"user": {"pets"}},
+			wantErr: `edge "owner" is not allowed for node "pet"`,
+		},
+		{
+			q:       &entql.Query{Select: []string{"name"}},
+			allow:   AllowList{"user": {"id"}},
+			wantErr: `field "name" is not allowed for node "user"`,
+		},
+		{
+			q:     &entql.Query{Where: entql.And(entql.FieldEQ("name", "a8m"), entql.HasEdgeWith("pets", entql.FieldHasPrefix("name", "p")))},
+			allow: AllowList{"user": {"name", "pets"}, "pet": {"name"}},
+		},
+		{
+			q:       &entql.Query{Where: entql.Or(entql.FieldEQ("id", 1), entql.Not(entql.FieldEQ("name", "a8m")))},
+			allow:   AllowList{"user": {"id"}},
+			wantErr: `field "name" is not allowed for node "user"`,
+		},
+		{
+			q:       &entql.Query{Where: entql.FieldEQ("age", 30)},
+			wantErr: `field "age" was not found for node "user"`,
+		},
+		{
+			q:       &entql.Query{Where: entql.HasEdge("pets")},
+			allow:   AllowList{"user": {"name"}},
+			wantErr: `edge "pets" is not allowed for node "user"`,
+		},
+		{
+			q:       &entql.Query{Where: entql.HasEdgeWith("pets", entql.FieldEQ("name", "pedro"))},
+			allow:   AllowList{"user": {"pets"}},
+			wantErr: `field "name" is not allowed for node "pet"`,
+		},
+		{
+			q:       &entql.Query{With: map[string]*entql.Query{"pets": {Where: entql.HasEdge("owner")}}},
+			allow:   AllowList{"user": {"pets"}},
+			wantErr: `edge "owner" is not allowed for node "pet"`,
+		},
+		{
+			q:       &entql.Query{Where: WrapFunc(func(*sql.Selector) {})},
+			allow:   AllowList{"user": {"name"}},
+			wantErr: `function func_selector is not allowed for node "user"`,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			err := g.CheckQuery("user", tt.q, tt.allow)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
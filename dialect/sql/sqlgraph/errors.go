@@ -46,3 +46,43 @@ func IsForeignKeyConstraintError(err error) bool {
 	}
 	return false
 }
+
+// IsSerializationError reports if the error resulted from a serialization failure
+// of a transaction. e.g. concurrent update in a serializable transaction.
+func IsSerializationError(err error) bool {
+	serializationErrors := []string{
+		"could not serialize access", // Postgres (40001)
+		"SQLSTATE 40001",             // Postgres (pgx)
+	}
+	for _, s := range serializationErrors {
+		if strings.Contains(err.Error(), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDeadlockError reports if the error resulted from a DB deadlock or a lock wait timeout.
+// Note that MySQL reports serialization failures as deadlocks.
+func IsDeadlockError(err error) bool {
+	deadlockErrors := []string{
+		"Error 1213",               // MySQL
+		"Error 1205",               // MySQL (lock wait timeout exceeded)
+		"deadlock detected",        // Postgres (40P01)
+		"SQLSTATE 40P01",           // Postgres (pgx)
+		"database is locked",       // SQLite (SQLITE_BUSY)
+		"database table is locked", // SQLite (SQLITE_LOCKED)
+	}
+	for _, s := range deadlockErrors {
+		if strings.Contains(err.Error(), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRetryableError reports if the error resulted from a transient conflict
+// between concurrent transactions, and the transaction can be safely retried.
+func IsRetryableError(err error) bool {
+	return err != nil && (IsSerializationError(err) || IsDeadlockError(err))
+}
@@ -349,6 +349,14 @@ type (
 		//	}
 		//
 		OnConflict []sql.ConflictOption
+
+		// Returning holds the columns whose values are assigned by the database
+		// (e.g. generated columns), and are read back after the node is inserted.
+		// They are read using the RETURNING clause in dialects that support it,
+		// and using an additional query otherwise (i.e. MySQL).
+		Returning  []string
+		ScanValues func(columns []string) ([]interface{}, error)
+		Assign     func(columns []string, values []interface{}) error
 	}
 
 	// BatchCreateSpec holds the information for creating
@@ -412,6 +420,9 @@ type (
 		Edges     EdgeMut
 		Fields    FieldMut
 		Predicate func(*sql.Selector)
+		// Modifiers allow setting custom expressions on the
+		// UPDATE statement. e.g. atomic JSON operations.
+		Modifiers []func(*sql.UpdateBuilder)
 
 		ScanValues func(columns []string) ([]interface{}, error)
 		Assign     func(columns []string, values []interface{}) error
@@ -834,6 +845,9 @@ func (u *updater) setTableColumns(update *sql.UpdateBuilder, addEdges, clearEdge
 	for _, fi := range u.Fields.Add {
 		update.Add(fi.Column, fi.Value)
 	}
+	for _, m := range u.Modifiers {
+		m(update)
+	}
 	return nil
 }
 
@@ -883,6 +897,11 @@ func (c *creator) node(ctx context.Context, drv dialect.Driver) error {
 		if err := c.insert(ctx, insert); err != nil {
 			return err
 		}
+		if len(c.Returning) > 0 && insert.Dialect() == dialect.MySQL {
+			if err := c.graph.selectReturning(ctx, c.CreateSpec); err != nil {
+				return err
+			}
+		}
 		if err := c.graph.addM2MEdges(ctx, []driver.Value{c.ID.Value}, edges[M2M]); err != nil {
 			return err
 		}
@@ -926,13 +945,105 @@ func (c *creator) insert(ctx context.Context, insert *sql.InsertBuilder) error {
 		// In case of "ON CONFLICT", the record may exists in the
 		// database, and we need to get back the database id field.
 		if len(c.CreateSpec.OnConflict) == 0 {
+			if c.returning(insert) {
+				return c.insertReturning(ctx, insert.Returning(c.Returning...), false)
+			}
 			query, args := insert.Query()
 			return c.tx.Exec(ctx, query, args, nil)
 		}
 	}
+	if c.returning(insert) {
+		return c.insertReturning(ctx, insert.Returning(append([]string{c.ID.Column}, c.Returning...)...), true)
+	}
 	return c.insertLastID(ctx, insert.Returning(c.ID.Column))
 }
 
+// returning reports if the database-assigned columns should be
+// read using the RETURNING clause of the given INSERT statement.
+func (c *creator) returning(insert *sql.InsertBuilder) bool {
+	return len(c.Returning) > 0 && insert.Dialect() != dialect.MySQL
+}
+
+// insertReturning executes the given INSERT statement, and scans the returned
+// columns into the node. The withID flag indicates that the first returned
+// column is the ID of the node.
+func (c *creator) insertReturning(ctx context.Context, insert *sql.InsertBuilder, withID bool) error {
+	query, args := insert.Query()
+	if err := insert.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	if err := c.tx.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return fmt.Errorf("sqlgraph: no rows returned from insert to table %q", c.Table)
+	}
+	if err := c.CreateSpec.scanReturning(rows, withID); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
+// scanReturning scans the current row into the ID (if withID is true) and the Returning columns of the node.
+func (c *CreateSpec) scanReturning(rows *sql.Rows, withID bool) error {
+	var (
+		id   sql.NullInt64
+		dest []interface{}
+	)
+	if withID {
+		switch _, ok := c.ID.Value.(field.ValueScanner); {
+		case ok:
+			dest = append(dest, c.ID.Value)
+		case c.ID.Type.Numeric():
+			// Normalize the type to int64 to make it
+			// looks like LastInsertId.
+			dest = append(dest, &id)
+		default:
+			dest = append(dest, &c.ID.Value)
+		}
+	}
+	values, err := c.ScanValues(c.Returning)
+	if err != nil {
+		return err
+	}
+	if err := rows.Scan(append(dest, values...)...); err != nil {
+		return fmt.Errorf("failed scanning rows: %w", err)
+	}
+	if id.Valid {
+		c.ID.Value = id.Int64
+	}
+	return c.Assign(c.Returning, values)
+}
+
+// selectReturning reads the Returning columns of the given node using
+// its ID. It is used in dialects that do not support the RETURNING clause.
+func (g *graph) selectReturning(ctx context.Context, node *CreateSpec) error {
+	rows := &sql.Rows{}
+	query, args := g.builder.Select(node.Returning...).
+		From(g.builder.Table(node.Table).Schema(node.Schema)).
+		Where(sql.EQ(node.ID.Column, node.ID.Value)).
+		Query()
+	if err := g.tx.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		return &NotFoundError{table: node.Table, id: node.ID.Value}
+	}
+	if err := node.scanReturning(rows, false); err != nil {
+		return err
+	}
+	return rows.Close()
+}
+
 // ensureLastInsertID ensures the LAST_INSERT_ID was added to the
 // 'ON DUPLICATE .. UPDATE' clause in it was not provided.
 func (c *creator) ensureLastInsertID(insert *sql.InsertBuilder) {
@@ -1023,7 +1134,18 @@ func (c *batchCreator) batchInsert(ctx context.Context, tx dialect.ExecQuerier,
 	if opts := c.BatchCreateSpec.OnConflict; len(opts) > 0 {
 		insert.OnConflict(opts...)
 	}
-	return c.insertLastIDs(ctx, tx, insert.Returning(c.Nodes[0].ID.Column))
+	if err := c.insertLastIDs(ctx, tx, insert.Returning(append([]string{c.Nodes[0].ID.Column}, c.Nodes[0].Returning...)...)); err != nil {
+		return err
+	}
+	// MySQL does not support the "RETURNING" clause.
+	if len(c.Nodes[0].Returning) > 0 && insert.Dialect() == dialect.MySQL {
+		for _, node := range c.Nodes {
+			if err := c.graph.selectReturning(ctx, node); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 // GroupRel groups edges by their relation type.
@@ -1347,6 +1469,12 @@ func (c *batchCreator) insertLastIDs(ctx context.Context, tx dialect.ExecQuerier
 		defer rows.Close()
 		for i := 0; rows.Next(); i++ {
 			node := c.Nodes[i]
+			if len(node.Returning) > 0 {
+				if err := node.scanReturning(rows, true); err != nil {
+					return err
+				}
+				continue
+			}
 			if node.ID.Type.Numeric() {
 				// Normalize the type to int64 to make it looks
 				// like LastInsertId.
@@ -1241,6 +1241,94 @@ func TestCreateNode(t *testing.T) {
 	}
 }
 
+func TestCreateNode_Returning(t *testing.T) {
+	type node struct {
+		fullName string
+		revision int64
+	}
+	spec := func(n *node, id interface{}) *CreateSpec {
+		return &CreateSpec{
+			Table: "users",
+			ID:    &FieldSpec{Column: "id", Type: field.TypeInt, Value: id},
+			Fields: []*FieldSpec{
+				{Column: "name", Type: field.TypeString, Value: "a8m"},
+			},
+			Returning: []string{"full_name", "revision"},
+			ScanValues: func(columns []string) ([]interface{}, error) {
+				return []interface{}{&sql.NullString{}, &sql.NullInt64{}}, nil
+			},
+			Assign: func(columns []string, values []interface{}) error {
+				require.Equal(t, []string{"full_name", "revision"}, columns)
+				n.fullName = values[0].(*sql.NullString).String
+				n.revision = values[1].(*sql.NullInt64).Int64
+				return nil
+			},
+		}
+	}
+
+	t.Run("MySQL", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectExec(escape("INSERT INTO `users` (`name`) VALUES (?)")).
+			WithArgs("a8m").
+			WillReturnResult(sqlmock.NewResult(10, 1))
+		mock.ExpectQuery(escape("SELECT `full_name`, `revision` FROM `users` WHERE `id` = ?")).
+			WithArgs(10).
+			WillReturnRows(sqlmock.NewRows([]string{"full_name", "revision"}).AddRow("Ariel Mashraki", 1))
+		n := &node{}
+		s := spec(n, nil)
+		require.NoError(t, CreateNode(context.Background(), sql.OpenDB(dialect.MySQL, db), s))
+		require.Equal(t, int64(10), s.ID.Value)
+		require.Equal(t, &node{fullName: "Ariel Mashraki", revision: 1}, n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Postgres", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1) RETURNING "id", "full_name", "revision"`)).
+			WithArgs("a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "revision"}).AddRow(10, "Ariel Mashraki", 1))
+		n := &node{}
+		s := spec(n, nil)
+		require.NoError(t, CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), s))
+		require.Equal(t, int64(10), s.ID.Value)
+		require.Equal(t, &node{fullName: "Ariel Mashraki", revision: 1}, n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Postgres/user-defined-id", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("name", "id") VALUES ($1, $2) RETURNING "full_name", "revision"`)).
+			WithArgs("a8m", 10).
+			WillReturnRows(sqlmock.NewRows([]string{"full_name", "revision"}).AddRow("Ariel Mashraki", 1))
+		n := &node{}
+		require.NoError(t, CreateNode(context.Background(), sql.OpenDB(dialect.Postgres, db), spec(n, 10)))
+		require.Equal(t, &node{fullName: "Ariel Mashraki", revision: 1}, n)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Postgres/batch", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		mock.ExpectBegin()
+		mock.ExpectQuery(escape(`INSERT INTO "users" ("name") VALUES ($1), ($2) RETURNING "id", "full_name", "revision"`)).
+			WithArgs("a8m", "a8m").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "full_name", "revision"}).
+				AddRow(10, "Ariel Mashraki", 1).
+				AddRow(11, "Ariel Mashraki", 2))
+		mock.ExpectCommit()
+		n1, n2 := &node{}, &node{}
+		s1, s2 := spec(n1, nil), spec(n2, nil)
+		require.NoError(t, BatchCreate(context.Background(), sql.OpenDB(dialect.Postgres, db), &BatchCreateSpec{Nodes: []*CreateSpec{s1, s2}}))
+		require.Equal(t, int64(10), s1.ID.Value)
+		require.Equal(t, int64(11), s2.ID.Value)
+		require.Equal(t, int64(2), n2.revision)
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestBatchCreate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1749,6 +1837,26 @@ func TestUpdateNodes(t *testing.T) {
 			},
 			wantAffected: 1,
 		},
+		{
+			name: "with modifiers",
+			spec: &UpdateSpec{
+				Node: &NodeSpec{
+					Table: "users",
+					ID:    &FieldSpec{Column: "id", Type: field.TypeInt},
+				},
+				Modifiers: []func(*sql.UpdateBuilder){
+					func(u *sql.UpdateBuilder) {
+						u.Set("tags", sql.Expr("JSON_ARRAY_APPEND(`tags`, '$', ?)", "a"))
+					},
+				},
+			},
+			prepare: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(escape("UPDATE `users` SET `tags` = JSON_ARRAY_APPEND(`tags`, '$', ?)")).
+					WithArgs("a").
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+			wantAffected: 2,
+		},
 		{
 			name: "own_fks/m2o_o2o_inverse",
 			spec: &UpdateSpec{
@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlgraph
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy configures how operations are retried by the Retry function.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times the operation is
+	// executed, including the first attempt. Defaults to 3.
+	MaxAttempts int
+	// Backoff returns the duration to wait before the given retry
+	// attempt (starting from 1). Defaults to ExponentialBackoff(10ms, 1s).
+	Backoff func(attempt int) time.Duration
+	// Retryable reports if the operation can be retried after it failed
+	// with the given error. Defaults to IsRetryableError.
+	Retryable func(error) bool
+}
+
+// RetryOption allows configuring the RetryPolicy using functional options.
+type RetryOption func(*RetryPolicy)
+
+// WithMaxAttempts sets the maximum number of attempts.
+func WithMaxAttempts(n int) RetryOption {
+	return func(p *RetryPolicy) {
+		p.MaxAttempts = n
+	}
+}
+
+// WithBackoff sets the backoff function used between attempts.
+func WithBackoff(f func(attempt int) time.Duration) RetryOption {
+	return func(p *RetryPolicy) {
+		p.Backoff = f
+	}
+}
+
+// WithRetryable sets the function used for classifying retryable errors.
+func WithRetryable(f func(error) bool) RetryOption {
+	return func(p *RetryPolicy) {
+		p.Retryable = f
+	}
+}
+
+// ExponentialBackoff returns a backoff function that doubles the wait
+// duration on each attempt, starting from base and capped at max. A random
+// jitter of up to 50% is added to avoid retrying in lockstep.
+func ExponentialBackoff(base, max time.Duration) func(int) time.Duration {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < max; i++ {
+			d *= 2
+		}
+		if d > max {
+			d = max
+		}
+		if half := int64(d / 2); half > 0 {
+			d = d/2 + time.Duration(rand.Int63n(half+1))
+		}
+		return d
+	}
+}
+
+// Retry executes fn and retries it as long as it fails with a retryable
+// error, and the maximum number of attempts was not exceeded. The last
+// error returned by fn is returned if all attempts failed, or the context
+// error if it was canceled while waiting between attempts.
+//
+//	err := sqlgraph.Retry(ctx, func(ctx context.Context) error {
+//		return transfer(ctx, client, from, to)
+//	}, sqlgraph.WithMaxAttempts(5))
+//
+func Retry(ctx context.Context, fn func(context.Context) error, opts ...RetryOption) error {
+	p := &RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     ExponentialBackoff(10*time.Millisecond, time.Second),
+		Retryable:   IsRetryableError,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= p.MaxAttempts || !p.Retryable(err) {
+			return err
+		}
+		t := time.NewTimer(p.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
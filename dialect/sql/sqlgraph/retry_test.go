@@ -0,0 +1,77 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sqlgraph
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	for _, msg := range []string{
+		"pq: could not serialize access due to concurrent update",
+		"pq: deadlock detected",
+		"Error 1213: Deadlock found when trying to get lock; try restarting transaction",
+		"Error 1205: Lock wait timeout exceeded; try restarting transaction",
+		"database is locked",
+	} {
+		require.True(t, IsRetryableError(errors.New(msg)), msg)
+	}
+	require.False(t, IsRetryableError(nil))
+	require.False(t, IsRetryableError(errors.New("Error 1062: Duplicate entry")))
+	require.True(t, IsSerializationError(errors.New("pq: could not serialize access due to read/write dependencies among transactions")))
+	require.False(t, IsSerializationError(errors.New("pq: deadlock detected")))
+}
+
+func TestRetry(t *testing.T) {
+	var (
+		calls   int
+		ctx     = context.Background()
+		backoff = WithBackoff(func(int) time.Duration { return 0 })
+	)
+	err := Retry(ctx, func(context.Context) error {
+		if calls++; calls < 3 {
+			return errors.New("pq: deadlock detected")
+		}
+		return nil
+	}, backoff)
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	calls = 0
+	err = Retry(ctx, func(context.Context) error {
+		calls++
+		return errors.New("pq: deadlock detected")
+	}, backoff, WithMaxAttempts(5))
+	require.EqualError(t, err, "pq: deadlock detected")
+	require.Equal(t, 5, calls)
+
+	calls = 0
+	err = Retry(ctx, func(context.Context) error {
+		calls++
+		return errors.New("Error 1062: Duplicate entry")
+	}, backoff)
+	require.Error(t, err)
+	require.Equal(t, 1, calls, "non-retryable errors should not be retried")
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = Retry(ctx, func(context.Context) error {
+		return errors.New("pq: deadlock detected")
+	}, WithBackoff(func(int) time.Duration { return time.Hour }))
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	f := ExponentialBackoff(10*time.Millisecond, 100*time.Millisecond)
+	for attempt, max := range []time.Duration{10, 20, 40, 80, 100, 100} {
+		d := f(attempt + 1)
+		require.True(t, d >= max*time.Millisecond/2 && d <= max*time.Millisecond, "attempt %d: %s", attempt+1, d)
+	}
+}
@@ -7,6 +7,7 @@ package sqljson
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"unicode"
 
@@ -290,6 +291,139 @@ func LenLTE(column string, size int, opts ...Option) *sql.Predicate {
 	})
 }
 
+// Append writes to the given UPDATE builder an atomic operation for
+// appending the given elements to a JSON array column. The elements
+// argument must be a slice, and NULL columns are treated as empty
+// arrays.
+//
+//	sqljson.Append(u, "tags", []string{"a", "b"})
+//
+// Note that calling u.Set(column) after Append(column) will erase the
+// previous call to Append from the builder.
+func Append(u *sql.UpdateBuilder, column string, elems interface{}) {
+	vs := values(elems)
+	if len(vs) == 0 {
+		return
+	}
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.MySQL:
+			b.WriteString("JSON_MERGE_PRESERVE").Nested(func(b *sql.Builder) {
+				b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+					b.Ident(column).Comma().WriteString("JSON_ARRAY()")
+				})
+				b.Comma().WriteString("CAST").Nested(func(b *sql.Builder) {
+					b.Arg(marshal(vs)).WriteString(" AS JSON")
+				})
+			})
+		case dialect.Postgres:
+			b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+				b.Ident(column).Comma().WriteString("'[]'::jsonb")
+			})
+			b.WriteString(" || ").Arg(marshal(vs)).WriteString("::jsonb")
+		default:
+			// SQLite appends elements using the special "#" array index.
+			b.WriteString("JSON_INSERT").Nested(func(b *sql.Builder) {
+				b.WriteString("COALESCE").Nested(func(b *sql.Builder) {
+					b.Ident(column).Comma().WriteString("'[]'")
+				})
+				for _, v := range vs {
+					b.Comma().WriteString(`'$[#]'`).Comma().WriteString("JSON").Nested(func(b *sql.Builder) {
+						b.Arg(marshal(v))
+					})
+				}
+			})
+		}
+	}))
+}
+
+// Remove writes to the given UPDATE builder an atomic operation for
+// removing all occurrences of the given elements from a JSON array
+// column. The elements argument must be a slice of scalar values.
+//
+//	sqljson.Remove(u, "tags", []string{"a", "b"})
+//
+// Note that calling u.Set(column) after Remove(column) will erase the
+// previous call to Remove from the builder.
+func Remove(u *sql.UpdateBuilder, column string, elems interface{}) {
+	vs := values(elems)
+	if len(vs) == 0 {
+		return
+	}
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		switch b.Dialect() {
+		case dialect.MySQL:
+			b.Nested(func(b *sql.Builder) {
+				b.WriteString("SELECT COALESCE(JSON_ARRAYAGG(`t`.`v`), JSON_ARRAY()) FROM JSON_TABLE").Nested(func(b *sql.Builder) {
+					b.Ident(column).WriteString(`, '$[*]' COLUMNS(`).Ident("v").WriteString(` JSON PATH '$')`)
+				})
+				b.WriteString(" AS `t` WHERE `t`.`v` NOT IN ").Nested(func(b *sql.Builder) {
+					for i, v := range vs {
+						if i > 0 {
+							b.Comma()
+						}
+						b.WriteString("CAST").Nested(func(b *sql.Builder) {
+							b.Arg(marshal(v)).WriteString(" AS JSON")
+						})
+					}
+				})
+			})
+		case dialect.Postgres:
+			b.Nested(func(b *sql.Builder) {
+				b.WriteString(`SELECT COALESCE(JSONB_AGG("e" ORDER BY "i"), '[]'::jsonb) FROM JSONB_ARRAY_ELEMENTS`).Nested(func(b *sql.Builder) {
+					b.Ident(column)
+				})
+				b.WriteString(` WITH ORDINALITY AS "t"("e", "i") WHERE "e" NOT IN `).Nested(func(b *sql.Builder) {
+					for i, v := range vs {
+						if i > 0 {
+							b.Comma()
+						}
+						b.Arg(marshal(v)).WriteString("::jsonb")
+					}
+				})
+			})
+		default:
+			b.Nested(func(b *sql.Builder) {
+				b.WriteString("SELECT JSON_GROUP_ARRAY(`value`) FROM JSON_EACH").Nested(func(b *sql.Builder) {
+					b.Ident(column)
+				})
+				b.WriteString(" WHERE `value` NOT IN ").Nested(func(b *sql.Builder) {
+					b.Args(vs...)
+				})
+			})
+		}
+	}))
+}
+
+// OrderValue returns a custom ordering function for ordering the
+// rows by the JSON value (returned by the path) in ascending order.
+//
+//	client.User.Query().
+//		Order(sqljson.OrderValue(user.FieldSettings, sqljson.Path("theme")))
+//
+func OrderValue(column string, opts ...Option) func(*sql.Selector) {
+	return orderValue(column, "", opts...)
+}
+
+// OrderValueDesc returns a custom ordering function for ordering the
+// rows by the JSON value (returned by the path) in descending order.
+//
+//	client.User.Query().
+//		Order(sqljson.OrderValueDesc(user.FieldSettings, sqljson.Path("theme")))
+//
+func OrderValueDesc(column string, opts ...Option) func(*sql.Selector) {
+	return orderValue(column, " DESC", opts...)
+}
+
+func orderValue(column, suffix string, opts ...Option) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprFunc(func(b *sql.Builder) {
+			ValuePath(b, s.C(column), opts...)
+			b.WriteString(suffix)
+		}))
+	}
+}
+
 // ValuePath writes to the given SQL builder the JSON path for
 // getting the value of a given JSON path.
 //
@@ -536,6 +670,19 @@ func isNumber(s string) bool {
 	return true
 }
 
+// values returns the elements of the given slice.
+func values(elems interface{}) []interface{} {
+	rv := reflect.ValueOf(elems)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []interface{}{elems}
+	}
+	vs := make([]interface{}, rv.Len())
+	for i := range vs {
+		vs[i] = rv.Index(i).Interface()
+	}
+	return vs
+}
+
 // marshal stringifies the given argument to a valid JSON document.
 func marshal(arg interface{}) interface{} {
 	if buf, err := json.Marshal(arg); err == nil {
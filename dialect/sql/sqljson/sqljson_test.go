@@ -327,6 +327,98 @@ func TestWritePath(t *testing.T) {
 	}
 }
 
+func TestAppendRemove(t *testing.T) {
+	tests := []struct {
+		input     func() sql.Querier
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("users")
+				sqljson.Append(u, "tags", []string{"a", "b"})
+				return u
+			},
+			wantQuery: "UPDATE `users` SET `tags` = JSON_MERGE_PRESERVE(COALESCE(`tags`, JSON_ARRAY()), CAST(? AS JSON))",
+			wantArgs:  []interface{}{`["a","b"]`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("users")
+				sqljson.Append(u, "tags", []int{1, 2})
+				return u
+			},
+			wantQuery: `UPDATE "users" SET "tags" = COALESCE("tags", '[]'::jsonb) || $1::jsonb`,
+			wantArgs:  []interface{}{`[1,2]`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("users")
+				sqljson.Append(u, "tags", []string{"a", "b"})
+				return u
+			},
+			wantQuery: "UPDATE `users` SET `tags` = JSON_INSERT(COALESCE(`tags`, '[]'), '$[#]', JSON(?), '$[#]', JSON(?))",
+			wantArgs:  []interface{}{`"a"`, `"b"`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("users").Set("name", "a8m")
+				sqljson.Append(u, "tags", []string{})
+				return u
+			},
+			wantQuery: "UPDATE `users` SET `name` = ?",
+			wantArgs:  []interface{}{"a8m"},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.MySQL).Update("users")
+				sqljson.Remove(u, "tags", []string{"a", "b"})
+				return u
+			},
+			wantQuery: "UPDATE `users` SET `tags` = (SELECT COALESCE(JSON_ARRAYAGG(`t`.`v`), JSON_ARRAY()) FROM JSON_TABLE(`tags`, '$[*]' COLUMNS(`v` JSON PATH '$')) AS `t` WHERE `t`.`v` NOT IN (CAST(? AS JSON), CAST(? AS JSON)))",
+			wantArgs:  []interface{}{`"a"`, `"b"`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.Postgres).Update("users")
+				sqljson.Remove(u, "tags", []int{1})
+				return u
+			},
+			wantQuery: `UPDATE "users" SET "tags" = (SELECT COALESCE(JSONB_AGG("e" ORDER BY "i"), '[]'::jsonb) FROM JSONB_ARRAY_ELEMENTS("tags") WITH ORDINALITY AS "t"("e", "i") WHERE "e" NOT IN ($1::jsonb))`,
+			wantArgs:  []interface{}{`1`},
+		},
+		{
+			input: func() sql.Querier {
+				u := sql.Dialect(dialect.SQLite).Update("users")
+				sqljson.Remove(u, "tags", []string{"a", "b"})
+				return u
+			},
+			wantQuery: "UPDATE `users` SET `tags` = (SELECT JSON_GROUP_ARRAY(`value`) FROM JSON_EACH(`tags`) WHERE `value` NOT IN (?, ?))",
+			wantArgs:  []interface{}{"a", "b"},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(strconv.Itoa(i), func(t *testing.T) {
+			query, args := tt.input().Query()
+			require.Equal(t, tt.wantQuery, query)
+			require.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestOrderValue(t *testing.T) {
+	s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table("users"))
+	sqljson.OrderValue("settings", sqljson.Path("theme"))(s)
+	sqljson.OrderValueDesc("settings", sqljson.Path("limits", "max"), sqljson.Unquote(true), sqljson.Cast("int"))(s)
+	query, _ := s.Query()
+	require.Equal(t, `SELECT * FROM "users" ORDER BY "users"."settings"->'theme', ("users"."settings"->'limits'->>'max')::int DESC`, query)
+
+	s = sql.Dialect(dialect.MySQL).Select("*").From(sql.Table("users"))
+	sqljson.OrderValueDesc("settings", sqljson.Path("theme"))(s)
+	query, _ = s.Query()
+	require.Equal(t, "SELECT * FROM `users` ORDER BY JSON_EXTRACT(`users`.`settings`, \"$.theme\") DESC", query)
+}
+
 func TestParsePath(t *testing.T) {
 	tests := []struct {
 		input    string
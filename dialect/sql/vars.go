@@ -0,0 +1,176 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"entgo.io/ent/dialect"
+)
+
+type (
+	// sessionVar is a session variable that was stored in the context using WithVar.
+	sessionVar struct{ name, value string }
+	// sessionVars is the list of session variables stored in the context.
+	sessionVars []sessionVar
+	varsCtxKey  struct{}
+)
+
+// WithVar returns a new context that holds the session variable with the given name and value.
+// The Driver sets the variables that are stored in the context at the start of transactions, and
+// before statements that introduce new or changed variables. Statements that are executed outside
+// of transactions are wrapped with a transaction for setting them. Variables are set using the
+// set_config function (similar to SET LOCAL), and therefore, they are scoped to the transaction
+// and never leak to other statements that share the same connection. For example:
+//
+//	ctx = sql.WithVar(ctx, "app.tenant_id", strconv.Itoa(tenant.ID))
+//	users, err := client.User.Query().All(ctx)
+//
+// Session variables are used by the row-level security policies of PostgreSQL (see
+// entsql.RowLevelSecurity), and they are ignored by the other dialects.
+func WithVar(ctx context.Context, name, value string) context.Context {
+	vars, _ := ctx.Value(varsCtxKey{}).(sessionVars)
+	next := make(sessionVars, 0, len(vars)+1)
+	for _, v := range vars {
+		if v.name != name {
+			next = append(next, v)
+		}
+	}
+	return context.WithValue(ctx, varsCtxKey{}, append(next, sessionVar{name: name, value: value}))
+}
+
+// VarFromContext returns the value of the session variable that is stored in the context.
+func VarFromContext(ctx context.Context, name string) (string, bool) {
+	vars, _ := ctx.Value(varsCtxKey{}).(sessionVars)
+	for _, v := range vars {
+		if v.name == name {
+			return v.value, true
+		}
+	}
+	return "", false
+}
+
+// Exec implements the dialect.Exec method. Statements that are executed with
+// session variables are wrapped with a transaction for setting them.
+func (d *Driver) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if !d.hasVars(ctx) {
+		return d.Conn.Exec(ctx, query, args, v)
+	}
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := tx.Exec(ctx, query, args, v); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+// Query implements the dialect.Query method. Statements that are executed with session
+// variables are wrapped with a transaction that is committed when the rows are closed.
+func (d *Driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	if !d.hasVars(ctx) {
+		return d.Conn.Query(ctx, query, args, v)
+	}
+	tx, err := d.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := tx.Query(ctx, query, args, v); err != nil {
+		return rollback(tx, err)
+	}
+	rows := v.(*Rows)
+	*rows = Rows{&txRows{ColumnScanner: rows.ColumnScanner, tx: tx}}
+	return nil
+}
+
+// hasVars reports if the statement needs to set session variables.
+func (d *Driver) hasVars(ctx context.Context) bool {
+	vars, _ := ctx.Value(varsCtxKey{}).(sessionVars)
+	return len(vars) > 0 && d.Dialect() == dialect.Postgres
+}
+
+// varsConn is a transaction connection that sets the session
+// variables that are stored in the context of its statements.
+type varsConn struct {
+	Conn
+	mu  sync.Mutex
+	set map[string]string
+}
+
+// Exec implements the dialect.Exec method.
+func (c *varsConn) Exec(ctx context.Context, query string, args, v interface{}) error {
+	if err := c.setVars(ctx); err != nil {
+		return err
+	}
+	return c.Conn.Exec(ctx, query, args, v)
+}
+
+// Query implements the dialect.Query method.
+func (c *varsConn) Query(ctx context.Context, query string, args, v interface{}) error {
+	if err := c.setVars(ctx); err != nil {
+		return err
+	}
+	return c.Conn.Query(ctx, query, args, v)
+}
+
+// setVars sets the session variables stored in the context that
+// were not set in the transaction, or were set with other values.
+func (c *varsConn) setVars(ctx context.Context) error {
+	vars, _ := ctx.Value(varsCtxKey{}).(sessionVars)
+	if len(vars) == 0 {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, v := range vars {
+		if value, ok := c.set[v.name]; ok && value == v.value {
+			continue
+		}
+		if _, err := c.ExecContext(ctx, "SELECT set_config($1, $2, true)", v.name, v.value); err != nil {
+			return fmt.Errorf("dialect/sql: set session variable %q: %w", v.name, err)
+		}
+		if c.set == nil {
+			c.set = make(map[string]string)
+		}
+		c.set[v.name] = v.value
+	}
+	return nil
+}
+
+// txRows wraps the rows of a statement that was wrapped with
+// a transaction, and ends the transaction when they are closed.
+type txRows struct {
+	ColumnScanner
+	tx   dialect.Tx
+	done bool
+}
+
+// Close closes the rows and commits the transaction. The transaction
+// is rolled back if the rows were closed because of an error.
+func (r *txRows) Close() error {
+	if r.done {
+		return r.ColumnScanner.Close()
+	}
+	r.done = true
+	if err := r.ColumnScanner.Close(); err != nil {
+		return rollback(r.tx, err)
+	}
+	if err := r.ColumnScanner.Err(); err != nil {
+		return rollback(r.tx, err)
+	}
+	return r.tx.Commit()
+}
+
+// rollback rolls back the transaction and returns the given error.
+func rollback(tx driver.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w: %v", err, rerr)
+	}
+	return err
+}
@@ -65,6 +65,9 @@ type (
 		Hooks() []Hook
 		// Policy returns the privacy policy of the schema.
 		Policy() Policy
+		// Validators returns an optional list of entity-level
+		// Validator to apply on mutations before save.
+		Validators() []Validator
 		// Annotations returns a list of schema annotations to be used by
 		// codegen extensions.
 		Annotations() []schema.Annotation
@@ -226,6 +229,9 @@ func (Schema) Hooks() []Hook { return nil }
 // Policy of the schema.
 func (Schema) Policy() Policy { return nil }
 
+// Validators of the schema.
+func (Schema) Validators() []Validator { return nil }
+
 // Annotations of the schema.
 func (Schema) Annotations() []schema.Annotation { return nil }
 
@@ -340,6 +346,33 @@ type (
 	//	}
 	//
 	Hook func(Mutator) Mutator
+
+	// Validator is the interface that wraps the Validate method. Unlike field
+	// validators, entity-level validators get the mutation, and therefore, can
+	// validate the relationship between fields or edges. For example:
+	//
+	//	func (Event) Validators() []ent.Validator {
+	//		return []ent.Validator{
+	//			ent.ValidateFunc(func(ctx context.Context, m ent.Mutation) error {
+	//				start, _ := m.Field("start")
+	//				end, _ := m.Field("end")
+	//				if start != nil && end != nil && !start.(time.Time).Before(end.(time.Time)) {
+	//					return errors.New("start must be before end")
+	//				}
+	//				return nil
+	//			}),
+	//		}
+	//	}
+	//
+	// Validators are executed by the builders before save, and get a ResolvedMutation.
+	Validator interface {
+		Validate(context.Context, Mutation) error
+	}
+
+	// The ValidateFunc type is an adapter to allow the use of ordinary
+	// function as validator. If f is a function with the appropriate signature,
+	// ValidateFunc(f) is a Validator that calls f.
+	ValidateFunc func(context.Context, Mutation) error
 )
 
 // Mutate calls f(ctx, m).
@@ -347,6 +380,53 @@ func (f MutateFunc) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	return f(ctx, m)
 }
 
+// Validate calls f(ctx, m).
+func (f ValidateFunc) Validate(ctx context.Context, m Mutation) error {
+	return f(ctx, m)
+}
+
+// ResolvedMutation wraps a Mutation and resolves the values of fields that were not
+// set by the mutation to their values in the database, if the mutation updates a
+// single entity (UpdateOne). Therefore, validators can check fields that were not
+// changed by the mutation (e.g. "start < end", when only "end" is updated).
+type ResolvedMutation struct {
+	Mutation
+	ctx context.Context
+	err error
+}
+
+// NewResolvedMutation returns a ResolvedMutation for the given mutation.
+func NewResolvedMutation(ctx context.Context, m Mutation) *ResolvedMutation {
+	return &ResolvedMutation{Mutation: m, ctx: ctx}
+}
+
+// Field returns the value of a field with the given name. If the field was not set
+// (or cleared) by an UpdateOne mutation, its old value is loaded from the database.
+func (m *ResolvedMutation) Field(name string) (Value, bool) {
+	v, ok := m.Mutation.Field(name)
+	if ok || !m.Op().Is(OpUpdateOne) || m.FieldCleared(name) {
+		return v, ok
+	}
+	v, err := m.OldField(m.ctx, name)
+	if err != nil {
+		if m.err == nil {
+			m.err = err
+		}
+		return nil, false
+	}
+	return v, true
+}
+
+// Err returns the first error that occurred while loading old values.
+func (m *ResolvedMutation) Err() error {
+	return m.err
+}
+
+// Unwrap returns the underlying mutation.
+func (m *ResolvedMutation) Unwrap() Mutation {
+	return m.Mutation
+}
+
 // An Op represents a mutation operation.
 type Op uint
 
@@ -26,9 +26,14 @@ var (
 		Name:        "entql",
 		Stage:       Experimental,
 		Default:     false,
-		Description: "EntQL provides a generic filtering capability at runtime",
+		Description: "EntQL provides a generic filtering capability and a dynamic client at runtime",
 		cleanup: func(c *Config) error {
-			return os.RemoveAll(filepath.Join(c.Target, "entql.go"))
+			for _, name := range []string{"entql.go", "dynamic.go"} {
+				if err := os.RemoveAll(filepath.Join(c.Target, name)); err != nil {
+					return err
+				}
+			}
+			return nil
 		},
 	}
 
@@ -92,6 +97,23 @@ var (
 		Description: "Allows users to configure the `ON CONFLICT`/`ON DUPLICATE KEY` clause for `INSERT` statements",
 	}
 
+	// FeatureOutbox provides a feature-flag for writing mutation events to a transactional outbox.
+	FeatureOutbox = Feature{
+		Name:        "sql/outbox",
+		Stage:       Experimental,
+		Default:     false,
+		Description: "Writes a change event for each mutated entity to an outbox table, within the transaction of the mutation",
+		GraphTemplates: []GraphTemplate{
+			{
+				Name:   "dialect/sql/outbox",
+				Format: "outbox.go",
+			},
+		},
+		cleanup: func(c *Config) error {
+			return os.RemoveAll(filepath.Join(c.Target, "outbox.go"))
+		},
+	}
+
 	// AllFeatures holds a list of all feature-flags.
 	AllFeatures = []Feature{
 		FeaturePrivacy,
@@ -101,6 +123,7 @@ var (
 		FeatureLock,
 		FeatureModifier,
 		FeatureUpsert,
+		FeatureOutbox,
 	}
 )
 
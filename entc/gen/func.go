@@ -76,6 +76,14 @@ var (
 // fieldOps returns all predicate operations for a given field.
 func fieldOps(f *Field) (ops []Op) {
 	switch t := f.Type.Type; {
+	case f.Encrypted():
+		// Only deterministically encrypted values can be compared.
+		if f.DeterministicEncryption() {
+			ops = []Op{EQ, NEQ, In, NotIn}
+		}
+	case f.PGElemType() != "":
+		// Arrays and ranges have their own predicates.
+		ops = []Op{EQ, NEQ}
 	case f.HasGoType() && !f.ConvertedToBasic() && !f.Type.Valuer():
 	case t == field.TypeJSON:
 	case t == field.TypeBool:
@@ -17,6 +17,7 @@ import (
 	"strings"
 	"text/template/parse"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
@@ -440,6 +441,17 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 		table := schema.NewTable(n.Table()).
 			AddPrimary(n.ID.PK()).
 			SetAnnotation(n.EntSQL())
+		if checks := n.CheckValidators(); len(checks) > 0 {
+			if table.Annotation == nil {
+				table.Annotation = &entsql.Annotation{}
+			}
+			if table.Annotation.Checks == nil {
+				table.Annotation.Checks = make(map[string]string, len(checks))
+			}
+			for _, v := range checks {
+				table.Annotation.Checks[v.Name] = v.Check
+			}
+		}
 		for _, f := range n.Fields {
 			if !f.IsEdgeField() {
 				table.AddColumn(f.Column())
@@ -518,6 +530,12 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 			}
 		}
 	}
+	// Reorder columns after all columns were added, as fields can be positioned after relation columns.
+	for _, n := range g.Nodes {
+		if err := positionColumns(tables[n.Table()], n); err != nil {
+			return nil, err
+		}
+	}
 	// Append indexes to tables after all columns were added (including relation columns).
 	for _, n := range g.Nodes {
 		table := tables[n.Table()]
@@ -531,6 +549,91 @@ func (g *Graph) Tables() (all []*schema.Table, err error) {
 	return
 }
 
+// positionColumns reorders the columns of the node table based on the entsql.Position
+// annotations of its fields, and sets the resolved position on the positioned columns.
+func positionColumns(t *schema.Table, n *Type) error {
+	var (
+		pending []*schema.Column
+		columns = make(map[string]string)
+		pos     = make(map[string]*entsql.Position)
+	)
+	for _, f := range n.Fields {
+		columns[f.Name] = f.StorageKey()
+		if ant := f.EntSQL(); ant != nil && ant.Position != nil {
+			pos[f.StorageKey()] = ant.Position
+		}
+	}
+	if len(pos) == 0 {
+		return nil
+	}
+	first := make([]*schema.Column, 0, len(pos))
+	rest := make([]*schema.Column, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		switch p, ok := pos[c.Name]; {
+		case !ok:
+			rest = append(rest, c)
+		case p.First && p.After != "":
+			return fmt.Errorf("column %q of table %q cannot be positioned both first and after %q", c.Name, t.Name, p.After)
+		case p.First:
+			first = append(first, c)
+		case p.After == "":
+			return fmt.Errorf("missing position for column %q of table %q", c.Name, t.Name)
+		default:
+			pending = append(pending, c)
+		}
+	}
+	rest = append(first, rest...)
+	// Columns that are positioned after the same column keep their definition order.
+	last := make(map[string]string)
+	for len(pending) > 0 {
+		var next []*schema.Column
+		for _, c := range pending {
+			after := pos[c.Name].After
+			if name, ok := columns[after]; ok {
+				after = name
+			}
+			anchor := after
+			if name, ok := last[after]; ok {
+				anchor = name
+			}
+			i := columnIndex(rest, anchor)
+			// The anchor column may be positioned itself.
+			if i == -1 {
+				next = append(next, c)
+				continue
+			}
+			rest = append(rest[:i+1], append([]*schema.Column{c}, rest[i+1:]...)...)
+			last[after] = c.Name
+		}
+		if len(next) == len(pending) {
+			return fmt.Errorf("column %q of table %q cannot be positioned after unknown column %q", next[0].Name, t.Name, pos[next[0].Name].After)
+		}
+		pending = next
+	}
+	for i, c := range rest {
+		if _, ok := pos[c.Name]; !ok {
+			continue
+		}
+		if i == 0 {
+			c.Position = &entsql.Position{First: true}
+		} else {
+			c.Position = &entsql.Position{After: rest[i-1].Name}
+		}
+	}
+	t.Columns = rest
+	return nil
+}
+
+// columnIndex returns the index of the column with the given name, or -1 if it was not found.
+func columnIndex(columns []*schema.Column, name string) int {
+	for i, c := range columns {
+		if c.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 // mayAddColumn adds the given column if it doesn't already exist in the table.
 func mayAddColumn(t *schema.Table, c *schema.Column) {
 	if !t.HasColumn(c.Name) {
@@ -11,6 +11,7 @@ import (
 	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
 
@@ -297,6 +298,108 @@ func TestFKColumns(t *testing.T) {
 	}
 }
 
+func TestGraph_TablesPosition(t *testing.T) {
+	position := func(p entsql.Position) map[string]interface{} {
+		return map[string]interface{}{"EntSQL": entsql.Annotation{Position: &p}}
+	}
+	user := &load.Schema{
+		Name: "User",
+		Fields: []*load.Field{
+			{Name: "created_at", Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: position(entsql.Position{After: "user_pet"})},
+			{Name: "updated_at", Info: &field.TypeInfo{Type: field.TypeTime}, Annotations: position(entsql.Position{After: "user_pet"})},
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nickname", Info: &field.TypeInfo{Type: field.TypeString}, Annotations: position(entsql.Position{After: "name"})},
+			{Name: "age", Info: &field.TypeInfo{Type: field.TypeInt}},
+			{Name: "tenant", Info: &field.TypeInfo{Type: field.TypeString}, StorageKey: "tenant_id", Annotations: position(entsql.Position{First: true})},
+		},
+		Edges: []*load.Edge{
+			{Name: "pet", Type: "Pet", Unique: true},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, &load.Schema{Name: "Pet"})
+	require.NoError(t, err)
+	tables, err := graph.Tables()
+	require.NoError(t, err)
+	var columns []string
+	for _, c := range tables[0].Columns {
+		columns = append(columns, c.Name)
+	}
+	require.Equal(t, []string{"tenant_id", "id", "name", "nickname", "age", "user_pet", "created_at", "updated_at"}, columns)
+	require.Equal(t, &entsql.Position{First: true}, tables[0].Columns[0].Position)
+	require.Nil(t, tables[0].Columns[1].Position)
+	require.Equal(t, &entsql.Position{After: "name"}, tables[0].Columns[3].Position)
+	require.Equal(t, &entsql.Position{After: "user_pet"}, tables[0].Columns[6].Position)
+	require.Equal(t, &entsql.Position{After: "created_at"}, tables[0].Columns[7].Position)
+
+	user.Fields[3].Annotations = position(entsql.Position{After: "unknown"})
+	graph, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, user, &load.Schema{Name: "Pet"})
+	require.NoError(t, err)
+	_, err = graph.Tables()
+	require.EqualError(t, err, `column "nickname" of table "users" cannot be positioned after unknown column "unknown"`)
+}
+
+func TestGraph_TablesValidators(t *testing.T) {
+	event := &load.Schema{
+		Name: "Event",
+		Fields: []*load.Field{
+			{Name: "starts_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+			{Name: "ends_at", Info: &field.TypeInfo{Type: field.TypeTime}},
+		},
+		Validators: []*load.Validator{{}, {Name: "valid_range", Check: "starts_at < ends_at"}},
+		Annotations: map[string]interface{}{
+			"EntSQL": entsql.Annotation{Checks: map[string]string{"positive_id": "id > 0"}},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, event)
+	require.NoError(t, err)
+	require.Len(t, graph.Nodes[0].EntityValidators(), 2)
+	require.True(t, graph.Nodes[0].HasUpdateCheckers())
+	tables, err := graph.Tables()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"positive_id": "id > 0", "valid_range": "starts_at < ends_at"}, tables[0].Annotation.Checks)
+
+	event.Validators[1].Name = "positive_id"
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, event)
+	require.EqualError(t, err, `entc/gen: create type Event: duplicate check constraint "positive_id" in schema "Event"`)
+	event.Validators[1].Name = ""
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, event)
+	require.EqualError(t, err, `entc/gen: create type Event: missing name for check validator "starts_at < ends_at" in schema "Event"`)
+}
+
+func TestGraph_TablesRowLevelSecurity(t *testing.T) {
+	rls := &entsql.RowLevelSecurity{
+		Force: true,
+		Policies: []*entsql.RowPolicy{
+			{Name: "tenant_isolation", Using: "tenant_id = " + entsql.CurrentSetting("app.tenant_id") + "::bigint"},
+			{Name: "tenant_insert", Command: "INSERT", Check: "tenant_id > 0"},
+		},
+	}
+	doc := &load.Schema{
+		Name: "Doc",
+		Fields: []*load.Field{
+			{Name: "tenant_id", Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+		Annotations: map[string]interface{}{
+			"EntSQL": entsql.Annotation{RowLevelSecurity: rls},
+		},
+	}
+	graph, err := NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, doc)
+	require.NoError(t, err)
+	tables, err := graph.Tables()
+	require.NoError(t, err)
+	require.Equal(t, rls, tables[0].Annotation.RowLevelSecurity)
+
+	rls.Policies[1].Using = "true"
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, doc)
+	require.EqualError(t, err, `entc/gen: create type Doc: row-level security policy "tenant_insert" for INSERT cannot have a USING expression in schema "Doc"`)
+	rls.Policies[1] = &entsql.RowPolicy{Name: "tenant_isolation", Check: "true"}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, doc)
+	require.EqualError(t, err, `entc/gen: create type Doc: duplicate row-level security policy "tenant_isolation" in schema "Doc"`)
+	rls.Policies[1] = &entsql.RowPolicy{Name: "reader", Command: "READ", Using: "true"}
+	_, err = NewGraph(&Config{Package: "entc/gen", Storage: drivers[0]}, doc)
+	require.EqualError(t, err, `entc/gen: create type Doc: invalid command "READ" for row-level security policy "reader" in schema "Doc"`)
+}
+
 func TestGraph_Gen(t *testing.T) {
 	require := require.New(t)
 	target := filepath.Join(os.TempDir(), "ent")
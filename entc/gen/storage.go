@@ -53,7 +53,10 @@ var drivers = []*Storage{
 		Dialects:  []string{"dialect.SQLite", "dialect.MySQL", "dialect.Postgres"},
 		Imports: []string{
 			"entgo.io/ent/dialect/sql",
+			"entgo.io/ent/dialect/sql/pgtype",
+			"entgo.io/ent/dialect/sql/sqlfts",
 			"entgo.io/ent/dialect/sql/sqlgraph",
+			"entgo.io/ent/dialect/sql/sqljson",
 			"entgo.io/ent/schema/field",
 		},
 		SchemaMode: Unique | Indexes | Cascade | Migrate,
@@ -77,6 +80,7 @@ var drivers = []*Storage{
 			"entgo.io/ent/dialect/gremlin/graph/dsl/g",
 			"entgo.io/ent/dialect/gremlin/graph/dsl/p",
 			"entgo.io/ent/dialect/gremlin/encoding/graphson",
+			"entgo.io/ent/schema/field",
 		},
 		SchemaMode: Unique,
 		OpCode:     opCodes(gremlinCode[:]),
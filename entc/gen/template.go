@@ -154,6 +154,13 @@ var (
 				return !g.featureEnabled(FeatureEntQL)
 			},
 		},
+		{
+			Name:   "dynamic",
+			Format: "dynamic.go",
+			Skip: func(g *Graph) bool {
+				return !g.featureEnabled(FeatureEntQL)
+			},
+		},
 		{
 			Name:   "runtime/ent",
 			Format: "runtime.go",
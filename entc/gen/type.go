@@ -185,6 +185,17 @@ type (
 		// Value in the schema.
 		Value string
 	}
+
+	// JSONPath holds the information of a scalar value in a JSON field
+	// with a struct type. Used for generating typed JSON predicates.
+	JSONPath struct {
+		// Name is the Go name of the path. e.g. "Theme" or "LimitsMax".
+		Name string
+		// Path holds the JSON keys of the path. e.g. ["limits", "max"].
+		Path []string
+		// Type of the value.
+		Type *field.RType
+	}
 )
 
 // NewType creates a new type and its fields from the given schema.
@@ -243,9 +254,64 @@ func NewType(c *Config, schema *load.Schema) (*Type, error) {
 			typ.fields[f.Name] = tf
 		}
 	}
+	if err := typ.checkValidators(); err != nil {
+		return nil, err
+	}
+	if err := typ.checkRowLevelSecurity(); err != nil {
+		return nil, err
+	}
 	return typ, nil
 }
 
+// checkValidators checks the entity-level validators of the type.
+func (t *Type) checkValidators() error {
+	names := make(map[string]struct{})
+	if ant := t.EntSQL(); ant != nil {
+		for name := range ant.Checks {
+			names[name] = struct{}{}
+		}
+	}
+	for _, v := range t.CheckValidators() {
+		if v.Name == "" {
+			return fmt.Errorf("missing name for check validator %q in schema %q", v.Check, t.Name)
+		}
+		if _, ok := names[v.Name]; ok {
+			return fmt.Errorf("duplicate check constraint %q in schema %q", v.Name, t.Name)
+		}
+		names[v.Name] = struct{}{}
+	}
+	return nil
+}
+
+// checkRowLevelSecurity checks the row-level security policies of the type.
+func (t *Type) checkRowLevelSecurity() error {
+	ant := t.EntSQL()
+	if ant == nil || ant.RowLevelSecurity == nil {
+		return nil
+	}
+	names := make(map[string]struct{})
+	for _, p := range ant.RowLevelSecurity.Policies {
+		if p.Name == "" {
+			return fmt.Errorf("missing name for row-level security policy in schema %q", t.Name)
+		}
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("duplicate row-level security policy %q in schema %q", p.Name, t.Name)
+		}
+		names[p.Name] = struct{}{}
+		switch cmd := strings.ToUpper(p.Command); {
+		case cmd != "" && cmd != "ALL" && cmd != "SELECT" && cmd != "INSERT" && cmd != "UPDATE" && cmd != "DELETE":
+			return fmt.Errorf("invalid command %q for row-level security policy %q in schema %q", p.Command, p.Name, t.Name)
+		case p.Using == "" && p.Check == "":
+			return fmt.Errorf("missing expression for row-level security policy %q in schema %q", p.Name, t.Name)
+		case cmd == "INSERT" && p.Using != "":
+			return fmt.Errorf("row-level security policy %q for INSERT cannot have a USING expression in schema %q", p.Name, t.Name)
+		case (cmd == "SELECT" || cmd == "DELETE") && p.Check != "":
+			return fmt.Errorf("row-level security policy %q for %s cannot have a CHECK expression in schema %q", p.Name, cmd, t.Name)
+		}
+	}
+	return nil
+}
+
 // Label returns Gremlin label name of the node/type.
 func (t Type) Label() string {
 	return snake(t.Name)
@@ -317,10 +383,63 @@ func (t Type) HasDefault() bool {
 	return false
 }
 
+// HasCreateDefault reports if any of this type's fields has a default value that is set
+// by the create builders. Deprecated fields are skipped, as their columns may be dropped.
+func (t Type) HasCreateDefault() bool {
+	fields := t.Fields
+	if t.ID.UserDefined {
+		fields = append(fields, t.ID)
+	}
+	for _, f := range fields {
+		if f.Default && !f.IsDeprecated() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasDeprecated reports if any of this type's fields is deprecated.
+func (t Type) HasDeprecated() bool {
+	for _, f := range t.Fields {
+		if f.IsDeprecated() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEncrypted reports if any of this type's fields is encrypted.
+func (t Type) HasEncrypted() bool {
+	for _, f := range t.Fields {
+		if f.Encrypted() {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldPolicies returns the fields of this type that have field-level privacy policies.
+func (t Type) FieldPolicies() []*Field {
+	var fields []*Field
+	for _, f := range t.Fields {
+		if f.HasPolicy() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// CheckContext reports if the generated check method of the builders gets
+// the context. i.e. the type has entity validators or field policies.
+func (t Type) CheckContext() bool {
+	return len(t.EntityValidators()) > 0 || len(t.FieldPolicies()) > 0
+}
+
 // HasUpdateDefault reports if any of this type's fields has default value on update.
+// Deprecated fields are skipped, as their columns may be dropped.
 func (t Type) HasUpdateDefault() bool {
 	for _, f := range t.Fields {
-		if f.UpdateDefault {
+		if f.UpdateDefault && !f.IsDeprecated() {
 			return true
 		}
 	}
@@ -359,7 +478,7 @@ func (t Type) HasUpdateCheckers() bool {
 			return true
 		}
 	}
-	return false
+	return len(t.EntityValidators()) > 0 || len(t.FieldPolicies()) > 0
 }
 
 // FKEdges returns all edges that reside on the type table as foreign-keys.
@@ -386,7 +505,7 @@ func (t Type) MixedInFields() []int {
 		fields = append(fields, t.ID)
 	}
 	for _, f := range fields {
-		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0) {
+		if f.Position != nil && f.Position.MixedIn && (f.Default || f.UpdateDefault || f.Validators > 0 || f.Encrypted()) {
 			idx[f.Position.MixinIndex] = struct{}{}
 		}
 	}
@@ -452,7 +571,18 @@ func (t Type) NumConstraint() int {
 func (t Type) MutableFields() []*Field {
 	fields := make([]*Field, 0, len(t.Fields))
 	for _, f := range t.Fields {
-		if !f.Immutable {
+		if !f.Immutable && !f.Generated() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GeneratedFields returns all type fields that are generated by the database.
+func (t Type) GeneratedFields() []*Field {
+	fields := make([]*Field, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		if f.Generated() {
 			fields = append(fields, f)
 		}
 	}
@@ -788,6 +918,26 @@ func (t Type) PolicyPositions() []*load.Position {
 	return nil
 }
 
+// EntityValidators returns the entity-level validators declared in the type schema.
+func (t Type) EntityValidators() []*load.Validator {
+	if t.schema != nil {
+		return t.schema.Validators
+	}
+	return nil
+}
+
+// CheckValidators returns the entity-level validators
+// that are translated to CHECK constraints.
+func (t Type) CheckValidators() []*load.Validator {
+	var checks []*load.Validator
+	for _, v := range t.EntityValidators() {
+		if v.Check != "" {
+			checks = append(checks, v)
+		}
+	}
+	return checks
+}
+
 // RelatedTypes returns all the types (nodes) that
 // are related (with edges) to this type.
 func (t Type) RelatedTypes() []*Type {
@@ -837,6 +987,8 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		err = fmt.Errorf("field %q redeclared for type %q", f.Name, t.Name)
 	case f.Sensitive && f.Tag != "":
 		err = fmt.Errorf("sensitive field %q cannot have struct tags", f.Name)
+	case f.Deprecated && !f.Optional && !f.Default:
+		err = fmt.Errorf("deprecated field %q must be optional or have a default value", f.Name)
 	case f.Info.Type == field.TypeEnum:
 		if tf.Enums, err = tf.enums(f); err == nil && !tf.HasGoType() {
 			// Enum types should be named as follows: typepkg.Field.
@@ -844,6 +996,26 @@ func (t *Type) checkField(tf *Field, f *load.Field) (err error) {
 		}
 	case tf.Validators > 0 && !tf.ConvertedToBasic():
 		err = fmt.Errorf("GoType %q for field %q must be converted to the basic %q type for validators", tf.Type, f.Name, tf.Type.Type)
+	case f.Encrypted && tf.HasGoType():
+		err = fmt.Errorf("encrypted field %q cannot have a custom GoType", f.Name)
+	case f.Encrypted && f.Unique && !f.Deterministic:
+		err = fmt.Errorf("unique field %q must be encrypted deterministically", f.Name)
+	case f.Encrypted && tf.cfg != nil && tf.cfg.Storage != nil && tf.cfg.Storage.Name != "sql":
+		err = fmt.Errorf("encrypted field %q is not supported by the %s storage", f.Name, tf.cfg.Storage.Name)
+	case tf.FullText() && f.Info.Type != field.TypeString:
+		err = fmt.Errorf("full-text search is supported only by string fields, but %q is %s", f.Name, f.Info.Type)
+	case tf.FullText() && f.Encrypted:
+		err = fmt.Errorf("encrypted field %q cannot be full-text searchable", f.Name)
+	case tf.FullText() && tf.cfg != nil && tf.cfg.Storage != nil && tf.cfg.Storage.Name != "sql":
+		err = fmt.Errorf("full-text search field %q is not supported by the %s storage", f.Name, tf.cfg.Storage.Name)
+	case tf.HasPolicy() && f.Name == "id":
+		err = fmt.Errorf("id field cannot have a field policy")
+	case tf.HasPolicy() && tf.cfg != nil && tf.cfg.Storage != nil && tf.cfg.Storage.Name != "sql":
+		err = fmt.Errorf("field policy of %q is not supported by the %s storage", f.Name, tf.cfg.Storage.Name)
+	case tf.Generated() && (f.Default || f.UpdateDefault):
+		err = fmt.Errorf("generated field %q cannot have default values", f.Name)
+	case tf.Generated() && (tf.EntSQL().Generated.Expr != "" || len(tf.EntSQL().Generated.Exprs) > 0) && tf.EntSQL().Default != "":
+		err = fmt.Errorf("generated field %q cannot have both a generation expression and a default value", f.Name)
 	}
 	return err
 }
@@ -930,6 +1102,35 @@ func (f Field) EntSQL() *entsql.Annotation {
 	return entsqlAnnotate(f.Annotations)
 }
 
+// Generated reports if the field value is generated by the database.
+// i.e. it is computed by an expression, or maintained by the database.
+func (f Field) Generated() bool {
+	ant := f.EntSQL()
+	return ant != nil && ant.Generated != nil
+}
+
+// FullText reports if the field is full-text searchable.
+func (f Field) FullText() bool {
+	ant := f.EntSQL()
+	return ant != nil && ant.FullText != nil
+}
+
+// Encrypted reports if the field value is encrypted at rest.
+func (f Field) Encrypted() bool { return f.def != nil && f.def.Encrypted }
+
+// DeterministicEncryption reports if the field value is encrypted
+// deterministically, and therefore, can be compared using equality predicates.
+func (f Field) DeterministicEncryption() bool { return f.Encrypted() && f.def.Deterministic }
+
+// HasPolicy reports if the field has a field-level privacy policy (privacy.FieldPolicy).
+func (f Field) HasPolicy() bool {
+	_, ok := f.Annotations["FieldPolicy"]
+	return ok
+}
+
+// Encrypter returns the variable name of the encrypter of this field.
+func (f Field) Encrypter() string { return pascal(f.Name) + "Encrypter" }
+
 // mutMethods returns the method names of mutation interface.
 var mutMethods = func() map[string]struct{} {
 	t := reflect.TypeOf(new(ent.Mutation)).Elem()
@@ -1036,7 +1237,20 @@ func (f Field) Edge() (*Edge, error) {
 }
 
 // Sensitive returns true if the field is a sensitive field.
-func (f Field) Sensitive() bool { return f.def != nil && f.def.Sensitive }
+// Encrypted fields are always sensitive.
+func (f Field) Sensitive() bool { return f.def != nil && (f.def.Sensitive || f.def.Encrypted) }
+
+// IsDeprecated reports if the field is deprecated. Deprecated fields are
+// not selected by default, and their generated accessors are deprecated.
+func (f Field) IsDeprecated() bool { return f.def != nil && f.def.Deprecated }
+
+// DeprecationReason returns the reason the field was deprecated for.
+func (f Field) DeprecationReason() string {
+	if !f.IsDeprecated() {
+		return ""
+	}
+	return f.def.DeprecatedReason
+}
 
 // Comment returns the comment of the field,
 func (f Field) Comment() string {
@@ -1054,6 +1268,9 @@ func (f Field) NillableValue() bool {
 
 // ScanType returns the Go type that is used for `rows.Scan`.
 func (f Field) ScanType() string {
+	if f.Encrypted() {
+		return "[]byte"
+	}
 	if f.Type.ValueScanner() {
 		if f.Nillable && !f.standardNullType() {
 			return "sql.NullScanner"
@@ -1063,7 +1280,7 @@ func (f Field) ScanType() string {
 	switch f.Type.Type {
 	case field.TypeJSON, field.TypeBytes:
 		return "[]byte"
-	case field.TypeString, field.TypeEnum:
+	case field.TypeString, field.TypeEnum, field.TypeDecimal:
 		return "sql.NullString"
 	case field.TypeBool:
 		return "sql.NullBool"
@@ -1082,6 +1299,9 @@ func (f Field) ScanType() string {
 // to be used by the `rows.Scan` method. An sql.Scanner or a
 // nillable-type supported by the SQL driver (e.g. []byte).
 func (f Field) NewScanType() string {
+	if f.Encrypted() {
+		return "new([]byte)"
+	}
 	if f.Type.ValueScanner() {
 		expr := fmt.Sprintf("new(%s)", f.Type.RType.String())
 		if f.Nillable && !f.standardNullType() {
@@ -1093,7 +1313,7 @@ func (f Field) NewScanType() string {
 	switch f.Type.Type {
 	case field.TypeJSON, field.TypeBytes:
 		expr = "[]byte"
-	case field.TypeString, field.TypeEnum:
+	case field.TypeString, field.TypeEnum, field.TypeDecimal:
 		expr = "sql.NullString"
 	case field.TypeBool:
 		expr = "sql.NullBool"
@@ -1126,6 +1346,8 @@ func (f Field) ScanTypeField(rec string) string {
 		expr = fmt.Sprintf("%s(%s.String)", f.Type, rec)
 	case field.TypeString, field.TypeBool, field.TypeInt64, field.TypeFloat64:
 		expr = f.goType(fmt.Sprintf("%s.%s", rec, strings.Title(f.Type.Type.String())))
+	case field.TypeDecimal:
+		expr = f.goType(fmt.Sprintf("%s.String", rec))
 	case field.TypeTime:
 		expr = fmt.Sprintf("%s.Time", rec)
 	case field.TypeFloat32:
@@ -1172,6 +1394,11 @@ func (f Field) Column() *schema.Column {
 		Enums:    f.EnumValues(),
 	}
 	switch {
+	case f.Default && f.Type.Type == field.TypeDecimal:
+		// Decimal defaults are kept as strings for not losing precision.
+		if s, ok := f.DefaultValue().(string); ok {
+			c.Default = strconv.Quote(s)
+		}
 	case f.Default && (f.Type.Numeric() || f.Type.Type == field.TypeBool):
 		c.Default = f.DefaultValue()
 	case f.Default && (f.IsString() || f.IsEnum()):
@@ -1191,6 +1418,26 @@ func (f Field) Column() *schema.Column {
 	}
 	if f.def != nil {
 		c.SchemaType = f.def.SchemaType
+		c.Precision, c.Scale = f.def.Precision, f.def.Scale
+	}
+	if ant := f.EntSQL(); ant != nil && ant.Generated != nil {
+		c.Generated = ant.Generated
+	}
+	if ant := f.EntSQL(); ant != nil && ant.FullText != nil {
+		c.FullText = ant.FullText
+	}
+	// Encrypted values are stored as binary ciphertexts. The columns of unique
+	// fields are bounded in size, because they cannot be indexed otherwise.
+	if f.Encrypted() {
+		c.Type, c.Size, c.Default = field.TypeBytes, 0, nil
+		if c.Unique {
+			c.Size = f.encryptedSize()
+		}
+	}
+	// Deprecated fields are not set on creation. Hence, their
+	// columns accept NULL values, unless they have a default.
+	if c.Deprecated = f.IsDeprecated(); c.Deprecated && c.Default == nil {
+		c.Nullable = true
 	}
 	return c
 }
@@ -1204,6 +1451,19 @@ func (f Field) incremental(def bool) bool {
 	return def
 }
 
+// encryptedOverhead is the column size that is reserved for the metadata
+// of ciphertexts (e.g. key identifier, nonce and authentication tag).
+const encryptedOverhead = 512
+
+// encryptedSize returns the column size of unique encrypted fields.
+func (f Field) encryptedSize() int64 {
+	size := f.size()
+	if size == 0 {
+		size = schema.DefaultStringLen
+	}
+	return size + encryptedOverhead
+}
+
 // size returns the field size defined in the schema.
 func (f Field) size() int64 {
 	if ant := f.EntSQL(); ant != nil && ant.Size != 0 {
@@ -1289,12 +1549,107 @@ func (f Field) SignedType() (*field.TypeInfo, error) {
 
 // SupportsMutationAdd reports if the field supports the mutation "Add(T) T" interface.
 func (f Field) SupportsMutationAdd() bool {
-	if !f.Type.Numeric() || f.IsEdgeField() {
+	switch {
+	case !f.Type.Numeric() || f.IsEdgeField():
 		return false
+	case f.Type.Type == field.TypeDecimal:
+		// Decimal values are represented as strings by
+		// default, and therefore, require a custom adder.
+		return f.implementsAdder()
 	}
 	return f.ConvertedToBasic() || f.implementsAdder()
 }
 
+// SupportsMutationAppend reports if the field supports the mutation append operation.
+// i.e. the field is a JSON array (an unnamed slice type like []string), and values can
+// be appended to it atomically in the storage. Currently, only SQL dialects support it.
+func (f Field) SupportsMutationAppend() bool {
+	return f.IsJSON() && f.Type.RType != nil && f.Type.RType.Kind == reflect.Slice && f.Type.RType.Name == "" &&
+		f.cfg != nil && f.cfg.Storage != nil && f.cfg.Storage.Name == "sql"
+}
+
+// SupportsMutationRemove reports if the field supports the mutation remove operation.
+// i.e. the field is a JSON array of basic values (e.g. strings or numbers) that can be
+// compared both in Go and in the database.
+func (f Field) SupportsMutationRemove() bool {
+	if !f.SupportsMutationAppend() {
+		return false
+	}
+	_, ok := jsonBasicTypes[strings.TrimPrefix(f.Type.RType.Ident, "[]")]
+	return ok
+}
+
+// jsonBasicTypes holds the Go types of scalar JSON values
+// that are supported by the typed JSON operations.
+var jsonBasicTypes = map[string]struct{}{
+	"bool": {}, "string": {}, "float32": {}, "float64": {},
+	"int": {}, "int8": {}, "int16": {}, "int32": {}, "int64": {},
+	"uint": {}, "uint8": {}, "uint16": {}, "uint32": {}, "uint64": {},
+}
+
+// JSONPaths returns the typed paths of the basic values (e.g. strings or numbers) that are
+// stored in a JSON field with a struct type, including the values of its nested structs.
+func (f Field) JSONPaths() []*JSONPath {
+	if !f.IsJSON() || f.Type.RType == nil {
+		return nil
+	}
+	return jsonPaths(f.Type.RType.Fields, "", nil)
+}
+
+func jsonPaths(fields []*field.RStructField, prefix string, path []string) []*JSONPath {
+	var paths []*JSONPath
+	for _, sf := range fields {
+		name, p := prefix+sf.Name, append(append([]string(nil), path...), sf.Key)
+		switch _, ok := jsonBasicTypes[sf.Type.Ident]; {
+		case ok && sf.Type.PkgPath == "":
+			paths = append(paths, &JSONPath{Name: name, Path: p, Type: sf.Type})
+		case sf.Type.Kind == reflect.Struct:
+			paths = append(paths, jsonPaths(sf.Type.Fields, name, p)...)
+		}
+	}
+	return paths
+}
+
+// IsString reports if the JSON value is a string.
+func (p JSONPath) IsString() bool {
+	return p.Type.Kind == reflect.String
+}
+
+// Comparable reports if the JSON value can be compared
+// using the ordering operators (e.g. GT and LT).
+func (p JSONPath) Comparable() bool {
+	return p.Type.Kind != reflect.Bool
+}
+
+// pgtypeElems maps the PostgreSQL array and range types of the
+// dialect/sql/pgtype package to the Go types of their elements.
+var pgtypeElems = map[string]string{
+	"StringArray":  "string",
+	"Int64Array":   "int64",
+	"Float64Array": "float64",
+	"Int64Range":   "int64",
+	"TimeRange":    "time.Time",
+}
+
+// PGElemType returns the Go type of the elements of PostgreSQL array and range fields
+// (e.g. pgtype.StringArray or pgtype.TimeRange), or an empty string for other fields.
+func (f Field) PGElemType() string {
+	if f.Type == nil || f.Type.Type != field.TypeOther || f.Type.RType == nil || f.Type.RType.PkgPath != "entgo.io/ent/dialect/sql/pgtype" {
+		return ""
+	}
+	return pgtypeElems[f.Type.RType.Name]
+}
+
+// IsPGArray reports if the field is a PostgreSQL array field.
+func (f Field) IsPGArray() bool {
+	return f.PGElemType() != "" && strings.HasSuffix(f.Type.RType.Name, "Array")
+}
+
+// IsPGRange reports if the field is a PostgreSQL range field.
+func (f Field) IsPGRange() bool {
+	return f.PGElemType() != "" && strings.HasSuffix(f.Type.RType.Name, "Range")
+}
+
 // MutationAddAssignExpr returns the expression for summing to identifiers and assigning to the mutation field.
 //
 //	MutationAddAssignExpr(a, b) => *m.a += b		// Basic Go type.
@@ -1377,6 +1732,13 @@ func (f Field) BasicType(ident string) (expr string) {
 		case rt.Kind == reflect.Struct:
 			expr = fmt.Sprintf("time.Time(%s)", ident)
 		}
+	case field.TypeDecimal:
+		switch {
+		case rt.Kind == reflect.String:
+			expr = fmt.Sprintf("string(%s)", ident)
+		case t.Stringer():
+			expr = fmt.Sprintf("%s.String()", ident)
+		}
 	case field.TypeString:
 		switch {
 		case rt.Kind == reflect.String:
@@ -1433,7 +1795,7 @@ func (f Field) enums(lf *load.Field) ([]Enum, error) {
 // Ops returns all predicate operations of the field.
 func (f *Field) Ops() []Op {
 	ops := fieldOps(f)
-	if f.Name != "id" && f.cfg != nil && f.cfg.Storage.Ops != nil {
+	if f.Name != "id" && !f.Encrypted() && f.cfg != nil && f.cfg.Storage.Ops != nil {
 		ops = append(ops, f.cfg.Storage.Ops(f)...)
 	}
 	return ops
@@ -1764,11 +2126,14 @@ var (
 		"Debug",
 		"Desc",
 		"Driver",
+		"Dynamic",
+		"DynamicQuery",
 		"Hook",
 		"Log",
 		"MutateFunc",
 		"Mutation",
 		"Mutator",
+		"nodeValues",
 		"Op",
 		"Option",
 		"OrderFunc",
@@ -1778,6 +2143,8 @@ var (
 		"Sum",
 		"Policy",
 		"Query",
+		"setValues",
+		"ValidationErrors",
 		"Value",
 	)
 	// private fields used by the different builders.
@@ -5,8 +5,11 @@
 package gen
 
 import (
+	"reflect"
 	"testing"
 
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/entc/load"
 	"entgo.io/ent/schema/field"
 
@@ -76,6 +79,10 @@ func TestType(t *testing.T) {
 	require.EqualError(err, "schema lowercase name conflicts with Go predeclared identifier \"int\"")
 	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "Value"})
 	require.EqualError(err, "schema name conflicts with ent predeclared identifier \"Value\"")
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "Dynamic"})
+	require.EqualError(err, "schema name conflicts with ent predeclared identifier \"Dynamic\"")
+	_, err = NewType(&Config{Package: "entc/gen"}, &load.Schema{Name: "ValidationErrors"})
+	require.EqualError(err, "schema name conflicts with ent predeclared identifier \"ValidationErrors\"")
 }
 
 func TestType_Label(t *testing.T) {
@@ -288,6 +295,221 @@ func TestField_incremental(t *testing.T) {
 	}
 }
 
+func TestField_Generated(t *testing.T) {
+	f := &Field{Name: "full_name", Type: &field.TypeInfo{Type: field.TypeString}}
+	require.False(t, f.Generated())
+	f.Annotations = dict("EntSQL", dict("generated", dict("Expr", "first_name || last_name")))
+	require.True(t, f.Generated())
+	typ := &Type{Name: "User", Fields: []*Field{{Name: "first_name"}, f}}
+	require.Equal(t, []*Field{f}, typ.GeneratedFields())
+	require.Equal(t, []*Field{typ.Fields[0]}, typ.MutableFields())
+
+	_, err := NewType(&Config{Package: "entc/gen"}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "revision", Default: true, Info: &field.TypeInfo{Type: field.TypeInt}, Annotations: dict("EntSQL", dict("generated", dict()))},
+		},
+	})
+	require.EqualError(t, err, `generated field "revision" cannot have default values`)
+}
+
+func TestField_Encrypted(t *testing.T) {
+	sql := &Config{Storage: drivers[0]}
+	f := &Field{cfg: sql, Name: "ssn", Type: &field.TypeInfo{Type: field.TypeString}, def: &load.Field{Encrypted: true}}
+	require.True(t, f.Encrypted())
+	require.False(t, f.DeterministicEncryption())
+	require.Equal(t, "SsnEncrypter", f.Encrypter())
+	require.Equal(t, "[]byte", f.ScanType())
+	require.Equal(t, field.TypeBytes, f.Column().Type)
+	require.Empty(t, f.Ops())
+	f.def.Deterministic = true
+	require.True(t, f.DeterministicEncryption())
+	f.Unique = true
+	require.Equal(t, schema.DefaultStringLen+encryptedOverhead, f.Column().Size, "unique columns are bounded")
+	f.def.Size = func(i int64) *int64 { return &i }(100)
+	require.Equal(t, int64(100+encryptedOverhead), f.Column().Size)
+	f.Unique, f.def.Size = false, nil
+	require.Equal(t, []Op{EQ, NEQ, In, NotIn}, f.Ops())
+	f.Optional = true
+	require.Equal(t, []Op{EQ, NEQ, In, NotIn, IsNil, NotNil}, f.Ops())
+
+	_, err := NewType(sql, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "ssn", Unique: true, Encrypted: true, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.EqualError(t, err, `unique field "ssn" must be encrypted deterministically`)
+	_, err = NewType(&Config{Storage: drivers[1]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "ssn", Encrypted: true, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.EqualError(t, err, `encrypted field "ssn" is not supported by the gremlin storage`)
+}
+
+func TestField_Deprecated(t *testing.T) {
+	typ, err := NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "nickname", Optional: true, Deprecated: true, DeprecatedReason: "use name", Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, typ.HasDeprecated())
+	require.False(t, typ.Fields[0].IsDeprecated())
+	require.Empty(t, typ.Fields[0].DeprecationReason())
+	require.True(t, typ.Fields[1].IsDeprecated())
+	require.Equal(t, "use name", typ.Fields[1].DeprecationReason())
+	require.True(t, typ.Fields[1].Column().Deprecated)
+
+	typ, err = NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "rank", Default: true, DefaultValue: 0, Deprecated: true, Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, typ.HasDefault())
+	require.False(t, typ.HasCreateDefault(), "defaults of deprecated fields are not set")
+	require.False(t, typ.Fields[0].Column().Nullable, "column has a database default")
+
+	typ, err = NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "created_at", Default: true, DefaultKind: reflect.Func, Deprecated: true, Info: &field.TypeInfo{Type: field.TypeTime}},
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, typ.Fields[0].Column().Nullable, "column has no database default")
+
+	_, err = NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "nickname", Deprecated: true, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.EqualError(t, err, `deprecated field "nickname" must be optional or have a default value`)
+}
+
+func TestField_FullText(t *testing.T) {
+	ant := map[string]interface{}{
+		entsql.Annotation{}.Name(): entsql.Annotation{FullText: &entsql.FullText{Config: "simple"}},
+	}
+	typ, err := NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "bio", Annotations: ant, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, typ.Fields[0].FullText())
+	require.Nil(t, typ.Fields[0].Column().FullText)
+	require.True(t, typ.Fields[1].FullText())
+	require.Equal(t, "simple", typ.Fields[1].Column().FullText.Config)
+
+	_, err = NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "age", Annotations: ant, Info: &field.TypeInfo{Type: field.TypeInt}},
+		},
+	})
+	require.EqualError(t, err, `full-text search is supported only by string fields, but "age" is int`)
+
+	_, err = NewType(&Config{Storage: drivers[1]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "bio", Annotations: ant, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.EqualError(t, err, `full-text search field "bio" is not supported by the gremlin storage`)
+}
+
+func TestType_FieldPolicies(t *testing.T) {
+	ant := map[string]interface{}{"FieldPolicy": map[string]interface{}{}}
+	typ, err := NewType(&Config{Storage: drivers[0]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "name", Info: &field.TypeInfo{Type: field.TypeString}},
+			{Name: "ssn", Annotations: ant, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.NoError(t, err)
+	require.False(t, typ.Fields[0].HasPolicy())
+	require.True(t, typ.Fields[1].HasPolicy())
+	require.Equal(t, []*Field{typ.Fields[1]}, typ.FieldPolicies())
+	require.True(t, typ.CheckContext())
+	require.True(t, typ.HasUpdateCheckers())
+
+	_, err = NewType(&Config{Storage: drivers[1]}, &load.Schema{
+		Name: "T",
+		Fields: []*load.Field{
+			{Name: "ssn", Annotations: ant, Info: &field.TypeInfo{Type: field.TypeString}},
+		},
+	})
+	require.EqualError(t, err, `field policy of "ssn" is not supported by the gremlin storage`)
+}
+
+func TestField_JSON(t *testing.T) {
+	sql := &Config{Storage: drivers[0]}
+	f := &Field{cfg: sql, Name: "strings", Type: &field.TypeInfo{Type: field.TypeJSON, RType: &field.RType{Ident: "[]string", Kind: reflect.Slice}}}
+	require.True(t, f.SupportsMutationAppend())
+	require.True(t, f.SupportsMutationRemove())
+	f.Type.RType.Ident = "[]http.Dir"
+	require.True(t, f.SupportsMutationAppend())
+	require.False(t, f.SupportsMutationRemove())
+	f.Type.RType = &field.RType{Name: "RawMessage", Ident: "json.RawMessage", Kind: reflect.Slice}
+	require.False(t, f.SupportsMutationAppend())
+	f.cfg = &Config{Storage: drivers[1]}
+	f.Type.RType = &field.RType{Ident: "[]string", Kind: reflect.Slice}
+	require.False(t, f.SupportsMutationAppend())
+
+	f = &Field{Name: "settings", Type: &field.TypeInfo{Type: field.TypeJSON, RType: &field.RType{
+		Kind: reflect.Struct,
+		Fields: []*field.RStructField{
+			{Name: "Theme", Key: "theme", Type: &field.RType{Ident: "string", Kind: reflect.String}},
+			{Name: "Dir", Key: "dir", Type: &field.RType{Name: "Dir", Ident: "http.Dir", Kind: reflect.String, PkgPath: "net/http"}},
+			{Name: "Tags", Key: "tags", Type: &field.RType{Ident: "[]string", Kind: reflect.Slice}},
+			{Name: "Limits", Key: "limits", Type: &field.RType{Kind: reflect.Struct, Fields: []*field.RStructField{
+				{Name: "Max", Key: "max", Type: &field.RType{Ident: "int", Kind: reflect.Int}},
+				{Name: "Enabled", Key: "enabled", Type: &field.RType{Ident: "bool", Kind: reflect.Bool}},
+			}}},
+		},
+	}}}
+	paths := f.JSONPaths()
+	require.Len(t, paths, 3)
+	require.Equal(t, "Theme", paths[0].Name)
+	require.Equal(t, []string{"theme"}, paths[0].Path)
+	require.True(t, paths[0].IsString())
+	require.Equal(t, "LimitsMax", paths[1].Name)
+	require.Equal(t, []string{"limits", "max"}, paths[1].Path)
+	require.True(t, paths[1].Comparable())
+	require.Equal(t, "LimitsEnabled", paths[2].Name)
+	require.False(t, paths[2].Comparable())
+}
+
+func TestField_PGType(t *testing.T) {
+	f := &Field{Name: "tags", Type: &field.TypeInfo{Type: field.TypeOther, RType: &field.RType{
+		Name: "StringArray", Ident: "pgtype.StringArray", Kind: reflect.Slice, PkgPath: "entgo.io/ent/dialect/sql/pgtype",
+	}}}
+	require.Equal(t, "string", f.PGElemType())
+	require.True(t, f.IsPGArray())
+	require.False(t, f.IsPGRange())
+	require.Equal(t, []Op{EQ, NEQ}, f.Ops())
+
+	f.Type.RType = &field.RType{Name: "TimeRange", Ident: "*pgtype.TimeRange", Kind: reflect.Ptr, PkgPath: "entgo.io/ent/dialect/sql/pgtype"}
+	require.Equal(t, "time.Time", f.PGElemType())
+	require.False(t, f.IsPGArray())
+	require.True(t, f.IsPGRange())
+
+	f.Type.RType = &field.RType{Name: "StringArray", Ident: "other.StringArray", Kind: reflect.Slice, PkgPath: "example.com/other"}
+	require.Empty(t, f.PGElemType())
+	require.False(t, f.IsPGArray())
+}
+
 func TestBuilderField(t *testing.T) {
 	tests := []struct {
 		name  string
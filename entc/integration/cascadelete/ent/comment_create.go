@@ -110,17 +110,19 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cc *CommentCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Comment.text"`)}
+		errs = append(errs, newValidationError("text", "Required", errors.New(`ent: missing required field "Comment.text"`)))
 	}
 	if _, ok := cc.mutation.PostID(); !ok {
-		return &ValidationError{Name: "post_id", err: errors.New(`ent: missing required field "Comment.post_id"`)}
+		errs = append(errs, newValidationError("post_id", "Required", errors.New(`ent: missing required field "Comment.post_id"`)))
 	}
 	if _, ok := cc.mutation.PostID(); !ok {
-		return &ValidationError{Name: "post", err: errors.New(`ent: missing required edge "Comment.post"`)}
+		errs = append(errs, newValidationError("post", "Required", errors.New(`ent: missing required edge "Comment.post"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CommentCreate) sqlSave(ctx context.Context) (*Comment, error) {
@@ -189,6 +191,9 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Comment, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
@@ -198,13 +203,20 @@ func (ccb *CommentCreateBulk) Save(ctx context.Context) ([]*Comment, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
@@ -121,11 +121,13 @@ func (cu *CommentUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cu *CommentUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := cu.mutation.PostID(); cu.mutation.PostCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
+		errs = append(errs, newValidationError("post", "Required", errors.New(`ent: clearing a required unique edge "Comment.post"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -303,11 +305,13 @@ func (cuo *CommentUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cuo *CommentUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := cuo.mutation.PostID(); cuo.mutation.PostCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Comment.post"`)
+		errs = append(errs, newValidationError("post", "Required", errors.New(`ent: clearing a required unique edge "Comment.post"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err error) {
@@ -9,12 +9,14 @@ package ent
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/entc/integration/cascadelete/ent/comment"
 	"entgo.io/ent/entc/integration/cascadelete/ent/post"
 	"entgo.io/ent/entc/integration/cascadelete/ent/user"
+	"entgo.io/ent/schema/field"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -151,8 +153,11 @@ func Sum(field string) AggregateFunc {
 
 // ValidationError returns when validating a field or edge fails.
 type ValidationError struct {
-	Name string // Field or edge name.
-	err  error
+	Name   string        // Field or edge name.
+	Rule   string        // Validation rule. e.g. Required, Enum, MinLen or Validate.
+	Params []interface{} // Parameters of the validation rule. e.g. the length in MinLen.
+	Path   string        // Path of the field or edge. e.g. "name", or "[1].name" in bulk creation.
+	err    error
 }
 
 // Error implements the error interface.
@@ -165,6 +170,67 @@ func (e *ValidationError) Unwrap() error {
 	return e.err
 }
 
+// newValidationError returns a new ValidationError for the given field or edge.
+func newValidationError(name, rule string, err error, params ...interface{}) *ValidationError {
+	return &ValidationError{Name: name, Path: name, Rule: rule, Params: params, err: err}
+}
+
+// validatorError returns a new ValidationError for a failed field validator. The rule
+// and its parameters are extracted from the error returned by the built-in validators.
+func validatorError(name string, err error) *ValidationError {
+	verr := newValidationError(name, "Validate", err)
+	var ferr *field.ValidatorError
+	if errors.As(err, &ferr) {
+		verr.Rule, verr.Params = ferr.Rule, ferr.Params
+	}
+	return verr
+}
+
+// ValidationErrors is returned by the builders when one or more of the field,
+// edge or entity validations fail. Use errors.As to extract it from the error:
+//
+//	var verrs ent.ValidationErrors
+//	if errors.As(err, &verrs) {
+//		for _, verr := range verrs {
+//			fmt.Println(verr.Path, verr.Rule, verr.Params)
+//		}
+//	}
+//
+type ValidationErrors []*ValidationError
+
+// Error implements the error interface.
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i := range e {
+		msgs[i] = e[i].Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the first validation error. It allows extracting
+// it using errors.As, or checking it using IsValidationError.
+func (e ValidationErrors) Unwrap() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e[0]
+}
+
+// err returns the errors as an error, or nil if there are no errors.
+func (e ValidationErrors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// prefix adds the given index to the path of the errors.
+func (e ValidationErrors) prefix(i int) {
+	for _, verr := range e {
+		verr.Path = fmt.Sprintf("[%d].%s", i, verr.Path)
+	}
+}
+
 // IsValidationError returns a boolean indicating whether the error is a validation error.
 func IsValidationError(err error) bool {
 	if err == nil {
@@ -151,11 +151,13 @@ func (pc *PostCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (pc *PostCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := pc.mutation.Text(); !ok {
-		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Post.text"`)}
+		errs = append(errs, newValidationError("text", "Required", errors.New(`ent: missing required field "Post.text"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PostCreate) sqlSave(ctx context.Context) (*Post, error) {
@@ -243,6 +245,9 @@ func (pcb *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
 	nodes := make([]*Post, len(pcb.builders))
 	mutators := make([]Mutator, len(pcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range pcb.builders {
 		func(i int, root context.Context) {
 			builder := pcb.builders[i]
@@ -253,13 +258,20 @@ func (pcb *PostCreateBulk) Save(ctx context.Context) ([]*Post, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
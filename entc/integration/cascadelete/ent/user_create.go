@@ -131,11 +131,13 @@ func (uc *UserCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "User.name"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -203,6 +205,9 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
@@ -213,13 +218,20 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
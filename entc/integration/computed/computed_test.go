@@ -0,0 +1,61 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package computed
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/computed/ent"
+	"entgo.io/ent/entc/integration/computed/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputed(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+	// The revision column is maintained by a trigger.
+	_, err = drv.DB().ExecContext(ctx, `CREATE TRIGGER users_revision AFTER UPDATE OF first_name, last_name ON users
+BEGIN
+	UPDATE users SET revision = revision + 1 WHERE id = NEW.id;
+END`)
+	require.NoError(t, err)
+
+	// Generated fields are read back after creation.
+	a8m := client.User.Create().SetFirstName("Ariel").SetLastName("Mashraki").SaveX(ctx)
+	require.Equal(t, "Ariel Mashraki", a8m.FullName)
+	require.Equal(t, 5, a8m.NameLength)
+	require.Equal(t, 1, a8m.Revision)
+
+	// And after update.
+	a8m = a8m.Update().SetFirstName("a8m").SaveX(ctx)
+	require.Equal(t, "a8m Mashraki", a8m.FullName)
+	require.Equal(t, 3, a8m.NameLength)
+	require.Equal(t, 2, a8m.Revision)
+
+	users := client.User.CreateBulk(
+		client.User.Create().SetFirstName("Nati").SetLastName("M"),
+		client.User.Create().SetFirstName("Alex").SetLastName("S"),
+	).SaveX(ctx)
+	require.Len(t, users, 2)
+	require.Equal(t, "Nati M", users[0].FullName)
+	require.Equal(t, "Alex S", users[1].FullName)
+	require.Equal(t, 1, users[1].Revision)
+
+	n := client.User.Update().Where(user.LastName("S")).SetLastName("Smith").SaveX(ctx)
+	require.Equal(t, 1, n)
+	alex := client.User.GetX(ctx, users[1].ID)
+	require.Equal(t, "Alex Smith", alex.FullName)
+	require.Equal(t, 2, alex.Revision)
+	require.Equal(t, 1, client.User.Query().Where(user.FullNameHasSuffix(" M"), user.NameLengthGT(3)).CountX(ctx))
+}
@@ -0,0 +1,50 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("first_name"),
+		field.String("last_name"),
+		// A stored column that is computed by the database.
+		field.String("full_name").
+			Annotations(entsql.Annotation{
+				Generated: &entsql.Generated{
+					Expr: "first_name || ' ' || last_name",
+					Exprs: map[string]string{
+						dialect.MySQL: "CONCAT(first_name, ' ', last_name)",
+					},
+					Stored: true,
+				},
+			}),
+		// A virtual column that is computed on read.
+		field.Int("name_length").
+			Annotations(entsql.Annotation{
+				Generated: &entsql.Generated{
+					Expr: "length(first_name)",
+				},
+			}),
+		// A column that is assigned by the database default
+		// expression, and may be modified by triggers.
+		field.Int("revision").
+			Annotations(entsql.Annotation{
+				Default:   "1",
+				Generated: &entsql.Generated{},
+			}),
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync/atomic"
 
 	"entgo.io/ent/entc/integration/config/ent/migrate"
 
@@ -17,6 +18,7 @@ import (
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // Client is the client that holds all ent builders.
@@ -60,9 +62,12 @@ func Open(driverName, dataSourceName string, options ...Option) (*Client, error)
 
 // Tx returns a new transactional client. The provided context
 // is used until the transaction is committed or rolled back.
+//
+// If the client is already bound to a transaction, a nested transaction
+// is started within it. See Tx.Commit for more info.
 func (c *Client) Tx(ctx context.Context) (*Tx, error) {
-	if _, ok := c.driver.(*txDriver); ok {
-		return nil, fmt.Errorf("ent: cannot start a transaction within a transaction")
+	if txd, ok := c.driver.(*txDriver); ok {
+		return c.nestedTx(ctx, txd)
 	}
 	tx, err := newTx(ctx, c.driver)
 	if err != nil {
@@ -70,11 +75,31 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}
 	cfg := c.config
 	cfg.driver = tx
-	return &Tx{
+	return tx.bind(&Tx{
+		ctx:    ctx,
+		config: cfg,
+		User:   NewUserClient(cfg),
+	}), nil
+}
+
+// nestedTx starts a nested transaction within the transaction of the given driver
+// using an SQL SAVEPOINT. The nested transaction can be committed or rolled back
+// independently, but its hooks are passed to the outer transaction on commit.
+func (c *Client) nestedTx(ctx context.Context, parent *txDriver) (*Tx, error) {
+	n := atomic.AddInt64(parent.savepoints, 1)
+	tx, err := sql.Savepoint(ctx, parent.tx, fmt.Sprintf("ent_savepoint_%d", n))
+	if err != nil {
+		return nil, fmt.Errorf("ent: starting a nested transaction: %w", err)
+	}
+	txd := &txDriver{tx: tx, drv: parent.drv, savepoints: parent.savepoints}
+	cfg := c.config
+	cfg.driver = txd
+	return txd.bind(&Tx{
 		ctx:    ctx,
 		config: cfg,
+		parent: parent.owner,
 		User:   NewUserClient(cfg),
-	}, nil
+	}), nil
 }
 
 // BeginTx returns a transactional client with specified options.
@@ -88,12 +113,46 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	if err != nil {
 		return nil, fmt.Errorf("ent: starting a transaction: %w", err)
 	}
+	txd := &txDriver{tx: tx, drv: c.driver}
 	cfg := c.config
-	cfg.driver = &txDriver{tx: tx, drv: c.driver}
-	return &Tx{
+	cfg.driver = txd
+	return txd.bind(&Tx{
 		config: cfg,
 		User:   NewUserClient(cfg),
-	}, nil
+	}), nil
+}
+
+// WithTx runs fn in a new transaction, and commits it if fn returns nil, or
+// rolls it back otherwise. If fn or the commit fail with a retryable error,
+// like a serialization failure or a deadlock, the transaction is rolled back
+// and fn is executed again in a new transaction. By default, it is attempted
+// up to 3 times with an exponential backoff between the attempts.
+//
+//	err := client.WithTx(ctx, func(tx *ent.Tx) error {
+//		// ...
+//		return nil
+//	}, sqlgraph.WithMaxAttempts(5))
+//
+func (c *Client) WithTx(ctx context.Context, fn func(*Tx) error, opts ...sqlgraph.RetryOption) error {
+	return sqlgraph.Retry(ctx, func(ctx context.Context) error {
+		tx, err := c.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if v := recover(); v != nil {
+				tx.Rollback()
+				panic(v)
+			}
+		}()
+		if err := fn(tx); err != nil {
+			if rerr := tx.Rollback(); rerr != nil {
+				err = fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+			}
+			return err
+		}
+		return tx.Commit()
+	}, opts...)
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
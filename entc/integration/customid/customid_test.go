@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/entc/integration/customid/ent/doc"
 	"entgo.io/ent/entc/integration/customid/ent/pet"
 	"entgo.io/ent/entc/integration/customid/ent/user"
+	"entgo.io/ent/entql"
 	"entgo.io/ent/schema/field"
 	"github.com/go-sql-driver/mysql"
 
@@ -170,6 +171,12 @@ func CustomID(t *testing.T, client *ent.Client) {
 	cdoc := client.Doc.Create().SetText("child").SetParent(pdoc).SaveX(ctx)
 	require.NotEmpty(t, cdoc.QueryParent().OnlyIDX(ctx))
 
+	t.Run("Dynamic", func(t *testing.T) {
+		ms, err := client.Dynamic().Query("User").Select(user.FieldID).Order(entql.Asc(user.FieldID)).Maps(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []map[string]interface{}{{user.FieldID: nat.ID}, {user.FieldID: a8m.ID}}, ms)
+	})
+
 	t.Run("Upsert", func(t *testing.T) {
 		id := uuid.New()
 		client.Blob.Create().
@@ -173,14 +173,16 @@ func (bc *BlobCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (bc *BlobCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := bc.mutation.UUID(); !ok {
-		return &ValidationError{Name: "uuid", err: errors.New(`ent: missing required field "Blob.uuid"`)}
+		errs = append(errs, newValidationError("uuid", "Required", errors.New(`ent: missing required field "Blob.uuid"`)))
 	}
 	if _, ok := bc.mutation.Count(); !ok {
-		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "Blob.count"`)}
+		errs = append(errs, newValidationError("count", "Required", errors.New(`ent: missing required field "Blob.count"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (bc *BlobCreate) sqlSave(ctx context.Context) (*Blob, error) {
@@ -491,6 +493,9 @@ func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
 	nodes := make([]*Blob, len(bcb.builders))
 	mutators := make([]Mutator, len(bcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range bcb.builders {
 		func(i int, root context.Context) {
 			builder := bcb.builders[i]
@@ -501,13 +506,20 @@ func (bcb *BlobCreateBulk) Save(ctx context.Context) ([]*Blob, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = bcb.conflict
@@ -154,26 +154,28 @@ func (cc *CarCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cc *CarCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := cc.mutation.BeforeID(); ok {
 		if err := car.BeforeIDValidator(v); err != nil {
-			return &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+			errs = append(errs, validatorError("before_id", fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)))
 		}
 	}
 	if v, ok := cc.mutation.AfterID(); ok {
 		if err := car.AfterIDValidator(v); err != nil {
-			return &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+			errs = append(errs, validatorError("after_id", fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)))
 		}
 	}
 	if _, ok := cc.mutation.Model(); !ok {
-		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "Car.model"`)}
+		errs = append(errs, newValidationError("model", "Required", errors.New(`ent: missing required field "Car.model"`)))
 	}
 	if v, ok := cc.mutation.ID(); ok {
 		if err := car.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Car.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Car.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CarCreate) sqlSave(ctx context.Context) (*Car, error) {
@@ -307,6 +309,11 @@ type (
 
 // SetBeforeID sets the "before_id" field.
 func (u *CarUpsert) SetBeforeID(v float64) *CarUpsert {
+	if err := car.BeforeIDValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("before_id", fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)))
+		return u.addError(car.FieldBeforeID, errs.err())
+	}
 	u.Set(car.FieldBeforeID, v)
 	return u
 }
@@ -331,6 +338,11 @@ func (u *CarUpsert) ClearBeforeID() *CarUpsert {
 
 // SetAfterID sets the "after_id" field.
 func (u *CarUpsert) SetAfterID(v float64) *CarUpsert {
+	if err := car.AfterIDValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("after_id", fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)))
+		return u.addError(car.FieldAfterID, errs.err())
+	}
 	u.Set(car.FieldAfterID, v)
 	return u
 }
@@ -365,6 +377,15 @@ func (u *CarUpsert) UpdateModel() *CarUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *CarUpsert) addError(column string, err error) *CarUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -530,6 +551,9 @@ func (ccb *CarCreateBulk) Save(ctx context.Context) ([]*Car, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Car, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
@@ -539,13 +563,20 @@ func (ccb *CarCreateBulk) Save(ctx context.Context) ([]*Car, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = ccb.conflict
@@ -183,18 +183,20 @@ func (cu *CarUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cu *CarUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := cu.mutation.BeforeID(); ok {
 		if err := car.BeforeIDValidator(v); err != nil {
-			return &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+			errs = append(errs, validatorError("before_id", fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)))
 		}
 	}
 	if v, ok := cu.mutation.AfterID(); ok {
 		if err := car.AfterIDValidator(v); err != nil {
-			return &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+			errs = append(errs, validatorError("after_id", fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CarUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -474,18 +476,20 @@ func (cuo *CarUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cuo *CarUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := cuo.mutation.BeforeID(); ok {
 		if err := car.BeforeIDValidator(v); err != nil {
-			return &ValidationError{Name: "before_id", err: fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)}
+			errs = append(errs, validatorError("before_id", fmt.Errorf(`ent: validator failed for field "Car.before_id": %w`, err)))
 		}
 	}
 	if v, ok := cuo.mutation.AfterID(); ok {
 		if err := car.AfterIDValidator(v); err != nil {
-			return &ValidationError{Name: "after_id", err: fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)}
+			errs = append(errs, validatorError("after_id", fmt.Errorf(`ent: validator failed for field "Car.after_id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CarUpdateOne) sqlSave(ctx context.Context) (_node *Car, err error) {
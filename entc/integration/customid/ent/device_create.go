@@ -146,13 +146,15 @@ func (dc *DeviceCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (dc *DeviceCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := dc.mutation.ID(); ok {
 		if err := device.IDValidator(v[:]); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Device.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Device.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (dc *DeviceCreate) sqlSave(ctx context.Context) (*Device, error) {
@@ -376,6 +378,9 @@ func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
 	nodes := make([]*Device, len(dcb.builders))
 	mutators := make([]Mutator, len(dcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range dcb.builders {
 		func(i int, root context.Context) {
 			builder := dcb.builders[i]
@@ -386,13 +391,20 @@ func (dcb *DeviceCreateBulk) Save(ctx context.Context) ([]*Device, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = dcb.conflict
@@ -167,13 +167,15 @@ func (dc *DocCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (dc *DocCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := dc.mutation.ID(); ok {
 		if err := doc.IDValidator(string(v)); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Doc.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Doc.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (dc *DocCreate) sqlSave(ctx context.Context) (*Doc, error) {
@@ -450,6 +452,9 @@ func (dcb *DocCreateBulk) Save(ctx context.Context) ([]*Doc, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
 	nodes := make([]*Doc, len(dcb.builders))
 	mutators := make([]Mutator, len(dcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range dcb.builders {
 		func(i int, root context.Context) {
 			builder := dcb.builders[i]
@@ -460,13 +465,20 @@ func (dcb *DocCreateBulk) Save(ctx context.Context) ([]*Doc, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = dcb.conflict
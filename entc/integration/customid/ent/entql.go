@@ -406,7 +406,52 @@ func (bq *BlobQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the BlobQuery builder.
 func (bq *BlobQuery) Filter() *BlobFilter {
-	return &BlobFilter{bq}
+	return &BlobFilter{predicateAdder: bq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// BlobQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (bq *BlobQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[0].Type, q, allow); err != nil {
+		return err
+	}
+	bq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the BlobQuery builder.
+func (bq *BlobQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		bq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			bq.Order(Desc(o.Field))
+		} else {
+			bq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		bq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		bq.Offset(q.Offset)
+	}
+	bq.fields = append(bq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case blob.EdgeParent:
+			bq.WithParent(func(query *BlobQuery) { query.applyQuery(wq) })
+		case blob.EdgeLinks:
+			bq.WithLinks(func(query *BlobQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -416,16 +461,26 @@ func (m *BlobMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the BlobMutation builder.
 func (m *BlobMutation) Filter() *BlobFilter {
-	return &BlobFilter{m}
+	return &BlobFilter{predicateAdder: m}
 }
 
 // BlobFilter provides a generic filtering capability at runtime for BlobQuery.
 type BlobFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *BlobFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *BlobFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
 			s.AddError(err)
@@ -433,6 +488,19 @@ func (f *BlobFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *BlobFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[0].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql [16]byte predicate on the id field.
 func (f *BlobFilter) WhereID(p entql.ValueP) {
 	f.Where(p.Field(blob.FieldID))
@@ -483,7 +551,50 @@ func (cq *CarQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the CarQuery builder.
 func (cq *CarQuery) Filter() *CarFilter {
-	return &CarFilter{cq}
+	return &CarFilter{predicateAdder: cq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// CarQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (cq *CarQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[1].Type, q, allow); err != nil {
+		return err
+	}
+	cq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the CarQuery builder.
+func (cq *CarQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		cq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			cq.Order(Desc(o.Field))
+		} else {
+			cq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		cq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		cq.Offset(q.Offset)
+	}
+	cq.fields = append(cq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case car.EdgeOwner:
+			cq.WithOwner(func(query *PetQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -493,16 +604,26 @@ func (m *CarMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the CarMutation builder.
 func (m *CarMutation) Filter() *CarFilter {
-	return &CarFilter{m}
+	return &CarFilter{predicateAdder: m}
 }
 
 // CarFilter provides a generic filtering capability at runtime for CarQuery.
 type CarFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *CarFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *CarFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
@@ -510,6 +631,19 @@ func (f *CarFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *CarFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[1].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *CarFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(car.FieldID))
@@ -551,7 +685,52 @@ func (dq *DeviceQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the DeviceQuery builder.
 func (dq *DeviceQuery) Filter() *DeviceFilter {
-	return &DeviceFilter{dq}
+	return &DeviceFilter{predicateAdder: dq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// DeviceQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (dq *DeviceQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[2].Type, q, allow); err != nil {
+		return err
+	}
+	dq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the DeviceQuery builder.
+func (dq *DeviceQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		dq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			dq.Order(Desc(o.Field))
+		} else {
+			dq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		dq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		dq.Offset(q.Offset)
+	}
+	dq.fields = append(dq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case device.EdgeActiveSession:
+			dq.WithActiveSession(func(query *SessionQuery) { query.applyQuery(wq) })
+		case device.EdgeSessions:
+			dq.WithSessions(func(query *SessionQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -561,16 +740,26 @@ func (m *DeviceMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the DeviceMutation builder.
 func (m *DeviceMutation) Filter() *DeviceFilter {
-	return &DeviceFilter{m}
+	return &DeviceFilter{predicateAdder: m}
 }
 
 // DeviceFilter provides a generic filtering capability at runtime for DeviceQuery.
 type DeviceFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *DeviceFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *DeviceFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
@@ -578,6 +767,19 @@ func (f *DeviceFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *DeviceFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[2].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql []byte predicate on the id field.
 func (f *DeviceFilter) WhereID(p entql.BytesP) {
 	f.Where(p.Field(device.FieldID))
@@ -618,7 +820,52 @@ func (dq *DocQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the DocQuery builder.
 func (dq *DocQuery) Filter() *DocFilter {
-	return &DocFilter{dq}
+	return &DocFilter{predicateAdder: dq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// DocQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (dq *DocQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[3].Type, q, allow); err != nil {
+		return err
+	}
+	dq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the DocQuery builder.
+func (dq *DocQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		dq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			dq.Order(Desc(o.Field))
+		} else {
+			dq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		dq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		dq.Offset(q.Offset)
+	}
+	dq.fields = append(dq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case doc.EdgeParent:
+			dq.WithParent(func(query *DocQuery) { query.applyQuery(wq) })
+		case doc.EdgeChildren:
+			dq.WithChildren(func(query *DocQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -628,16 +875,26 @@ func (m *DocMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the DocMutation builder.
 func (m *DocMutation) Filter() *DocFilter {
-	return &DocFilter{m}
+	return &DocFilter{predicateAdder: m}
 }
 
 // DocFilter provides a generic filtering capability at runtime for DocQuery.
 type DocFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *DocFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *DocFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
@@ -645,6 +902,19 @@ func (f *DocFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *DocFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[3].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql string predicate on the id field.
 func (f *DocFilter) WhereID(p entql.StringP) {
 	f.Where(p.Field(doc.FieldID))
@@ -690,7 +960,50 @@ func (gq *GroupQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the GroupQuery builder.
 func (gq *GroupQuery) Filter() *GroupFilter {
-	return &GroupFilter{gq}
+	return &GroupFilter{predicateAdder: gq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// GroupQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (gq *GroupQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[4].Type, q, allow); err != nil {
+		return err
+	}
+	gq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the GroupQuery builder.
+func (gq *GroupQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		gq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		gq.Offset(q.Offset)
+	}
+	gq.fields = append(gq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case group.EdgeUsers:
+			gq.WithUsers(func(query *UserQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -700,16 +1013,26 @@ func (m *GroupMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the GroupMutation builder.
 func (m *GroupMutation) Filter() *GroupFilter {
-	return &GroupFilter{m}
+	return &GroupFilter{predicateAdder: m}
 }
 
 // GroupFilter provides a generic filtering capability at runtime for GroupQuery.
 type GroupFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *GroupFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *GroupFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
@@ -717,6 +1040,19 @@ func (f *GroupFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *GroupFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[4].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *GroupFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(group.FieldID))
@@ -743,7 +1079,43 @@ func (miq *MixinIDQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the MixinIDQuery builder.
 func (miq *MixinIDQuery) Filter() *MixinIDFilter {
-	return &MixinIDFilter{miq}
+	return &MixinIDFilter{predicateAdder: miq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// MixinIDQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (miq *MixinIDQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[5].Type, q, allow); err != nil {
+		return err
+	}
+	miq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the MixinIDQuery builder.
+func (miq *MixinIDQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		miq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			miq.Order(Desc(o.Field))
+		} else {
+			miq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		miq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		miq.Offset(q.Offset)
+	}
+	miq.fields = append(miq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -753,16 +1125,26 @@ func (m *MixinIDMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the MixinIDMutation builder.
 func (m *MixinIDMutation) Filter() *MixinIDFilter {
-	return &MixinIDFilter{m}
+	return &MixinIDFilter{predicateAdder: m}
 }
 
 // MixinIDFilter provides a generic filtering capability at runtime for MixinIDQuery.
 type MixinIDFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *MixinIDFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *MixinIDFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
@@ -770,6 +1152,19 @@ func (f *MixinIDFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *MixinIDFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[5].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql [16]byte predicate on the id field.
 func (f *MixinIDFilter) WhereID(p entql.ValueP) {
 	f.Where(p.Field(mixinid.FieldID))
@@ -792,7 +1187,52 @@ func (nq *NoteQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the NoteQuery builder.
 func (nq *NoteQuery) Filter() *NoteFilter {
-	return &NoteFilter{nq}
+	return &NoteFilter{predicateAdder: nq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// NoteQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (nq *NoteQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[6].Type, q, allow); err != nil {
+		return err
+	}
+	nq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the NoteQuery builder.
+func (nq *NoteQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		nq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			nq.Order(Desc(o.Field))
+		} else {
+			nq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		nq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		nq.Offset(q.Offset)
+	}
+	nq.fields = append(nq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case note.EdgeParent:
+			nq.WithParent(func(query *NoteQuery) { query.applyQuery(wq) })
+		case note.EdgeChildren:
+			nq.WithChildren(func(query *NoteQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -802,16 +1242,26 @@ func (m *NoteMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the NoteMutation builder.
 func (m *NoteMutation) Filter() *NoteFilter {
-	return &NoteFilter{m}
+	return &NoteFilter{predicateAdder: m}
 }
 
 // NoteFilter provides a generic filtering capability at runtime for NoteQuery.
 type NoteFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *NoteFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *NoteFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
@@ -819,6 +1269,19 @@ func (f *NoteFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *NoteFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[6].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql string predicate on the id field.
 func (f *NoteFilter) WhereID(p entql.StringP) {
 	f.Where(p.Field(note.FieldID))
@@ -864,7 +1327,56 @@ func (pq *PetQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the PetQuery builder.
 func (pq *PetQuery) Filter() *PetFilter {
-	return &PetFilter{pq}
+	return &PetFilter{predicateAdder: pq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// PetQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (pq *PetQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[7].Type, q, allow); err != nil {
+		return err
+	}
+	pq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the PetQuery builder.
+func (pq *PetQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		pq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			pq.Order(Desc(o.Field))
+		} else {
+			pq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		pq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		pq.Offset(q.Offset)
+	}
+	pq.fields = append(pq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case pet.EdgeOwner:
+			pq.WithOwner(func(query *UserQuery) { query.applyQuery(wq) })
+		case pet.EdgeCars:
+			pq.WithCars(func(query *CarQuery) { query.applyQuery(wq) })
+		case pet.EdgeFriends:
+			pq.WithFriends(func(query *PetQuery) { query.applyQuery(wq) })
+		case pet.EdgeBestFriend:
+			pq.WithBestFriend(func(query *PetQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -874,16 +1386,26 @@ func (m *PetMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the PetMutation builder.
 func (m *PetMutation) Filter() *PetFilter {
-	return &PetFilter{m}
+	return &PetFilter{predicateAdder: m}
 }
 
 // PetFilter provides a generic filtering capability at runtime for PetQuery.
 type PetFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *PetFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *PetFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
@@ -891,6 +1413,19 @@ func (f *PetFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *PetFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[7].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql string predicate on the id field.
 func (f *PetFilter) WhereID(p entql.StringP) {
 	f.Where(p.Field(pet.FieldID))
@@ -959,7 +1494,50 @@ func (sq *SessionQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the SessionQuery builder.
 func (sq *SessionQuery) Filter() *SessionFilter {
-	return &SessionFilter{sq}
+	return &SessionFilter{predicateAdder: sq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// SessionQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (sq *SessionQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[8].Type, q, allow); err != nil {
+		return err
+	}
+	sq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the SessionQuery builder.
+func (sq *SessionQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		sq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			sq.Order(Desc(o.Field))
+		} else {
+			sq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		sq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		sq.Offset(q.Offset)
+	}
+	sq.fields = append(sq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case session.EdgeDevice:
+			sq.WithDevice(func(query *DeviceQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -969,16 +1547,26 @@ func (m *SessionMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the SessionMutation builder.
 func (m *SessionMutation) Filter() *SessionFilter {
-	return &SessionFilter{m}
+	return &SessionFilter{predicateAdder: m}
 }
 
 // SessionFilter provides a generic filtering capability at runtime for SessionQuery.
 type SessionFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *SessionFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *SessionFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
@@ -986,6 +1574,19 @@ func (f *SessionFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *SessionFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[8].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql []byte predicate on the id field.
 func (f *SessionFilter) WhereID(p entql.BytesP) {
 	f.Where(p.Field(session.FieldID))
@@ -1012,7 +1613,56 @@ func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the UserQuery builder.
 func (uq *UserQuery) Filter() *UserFilter {
-	return &UserFilter{uq}
+	return &UserFilter{predicateAdder: uq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// UserQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (uq *UserQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[9].Type, q, allow); err != nil {
+		return err
+	}
+	uq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the UserQuery builder.
+func (uq *UserQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		uq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		uq.Offset(q.Offset)
+	}
+	uq.fields = append(uq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case user.EdgeGroups:
+			uq.WithGroups(func(query *GroupQuery) { query.applyQuery(wq) })
+		case user.EdgeParent:
+			uq.WithParent(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgeChildren:
+			uq.WithChildren(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgePets:
+			uq.WithPets(func(query *PetQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1022,16 +1672,26 @@ func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
 func (m *UserMutation) Filter() *UserFilter {
-	return &UserFilter{m}
+	return &UserFilter{predicateAdder: m}
 }
 
 // UserFilter provides a generic filtering capability at runtime for UserQuery.
 type UserFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *UserFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1039,6 +1699,19 @@ func (f *UserFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *UserFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[9].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *UserFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(user.FieldID))
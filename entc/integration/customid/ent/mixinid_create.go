@@ -123,14 +123,16 @@ func (mic *MixinIDCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (mic *MixinIDCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := mic.mutation.SomeField(); !ok {
-		return &ValidationError{Name: "some_field", err: errors.New(`ent: missing required field "MixinID.some_field"`)}
+		errs = append(errs, newValidationError("some_field", "Required", errors.New(`ent: missing required field "MixinID.some_field"`)))
 	}
 	if _, ok := mic.mutation.MixinField(); !ok {
-		return &ValidationError{Name: "mixin_field", err: errors.New(`ent: missing required field "MixinID.mixin_field"`)}
+		errs = append(errs, newValidationError("mixin_field", "Required", errors.New(`ent: missing required field "MixinID.mixin_field"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (mic *MixinIDCreate) sqlSave(ctx context.Context) (*MixinID, error) {
@@ -389,6 +391,9 @@ func (micb *MixinIDCreateBulk) Save(ctx context.Context) ([]*MixinID, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(micb.builders))
 	nodes := make([]*MixinID, len(micb.builders))
 	mutators := make([]Mutator, len(micb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range micb.builders {
 		func(i int, root context.Context) {
 			builder := micb.builders[i]
@@ -399,13 +404,20 @@ func (micb *MixinIDCreateBulk) Save(ctx context.Context) ([]*MixinID, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, micb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = micb.conflict
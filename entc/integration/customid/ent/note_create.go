@@ -167,13 +167,15 @@ func (nc *NoteCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (nc *NoteCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := nc.mutation.ID(); ok {
 		if err := note.IDValidator(string(v)); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Note.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Note.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (nc *NoteCreate) sqlSave(ctx context.Context) (*Note, error) {
@@ -450,6 +452,9 @@ func (ncb *NoteCreateBulk) Save(ctx context.Context) ([]*Note, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
 	nodes := make([]*Note, len(ncb.builders))
 	mutators := make([]Mutator, len(ncb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ncb.builders {
 		func(i int, root context.Context) {
 			builder := ncb.builders[i]
@@ -460,13 +465,20 @@ func (ncb *NoteCreateBulk) Save(ctx context.Context) ([]*Note, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = ncb.conflict
@@ -131,13 +131,15 @@ func (sc *SessionCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (sc *SessionCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := sc.mutation.ID(); ok {
 		if err := session.IDValidator(v[:]); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Session.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Session.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (sc *SessionCreate) sqlSave(ctx context.Context) (*Session, error) {
@@ -342,6 +344,9 @@ func (scb *SessionCreateBulk) Save(ctx context.Context) ([]*Session, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
 	nodes := make([]*Session, len(scb.builders))
 	mutators := make([]Mutator, len(scb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
@@ -352,13 +357,20 @@ func (scb *SessionCreateBulk) Save(ctx context.Context) ([]*Session, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = scb.conflict
@@ -49,6 +49,9 @@ type Tx struct {
 	// ctx lives for the life of the transaction. It is
 	// the same context used by the underlying connection.
 	ctx context.Context
+
+	// parent holds the outer transaction of nested transactions.
+	parent *Tx
 }
 
 type (
@@ -85,7 +88,14 @@ func (f CommitFunc) Commit(ctx context.Context, tx *Tx) error {
 }
 
 // Commit commits the transaction.
+//
+// Committing a nested transaction releases its savepoint, and its commit and rollback
+// hooks are passed to the outer transaction. i.e. they are executed only when the
+// outermost transaction is committed or rolled back.
 func (tx *Tx) Commit() error {
+	if tx.parent != nil {
+		return tx.release()
+	}
 	txDriver := tx.config.driver.(*txDriver)
 	var fn Committer = CommitFunc(func(context.Context, *Tx) error {
 		return txDriver.tx.Commit()
@@ -161,6 +171,21 @@ func (tx *Tx) OnRollback(f RollbackHook) {
 	tx.onRollback = append(tx.onRollback, f)
 }
 
+// release commits the nested transaction and passes its hooks to the outer transaction.
+func (tx *Tx) release() error {
+	if err := tx.config.driver.(*txDriver).tx.Commit(); err != nil {
+		return err
+	}
+	tx.mu.Lock()
+	onCommit, onRollback := tx.onCommit, tx.onRollback
+	tx.mu.Unlock()
+	tx.parent.mu.Lock()
+	defer tx.parent.mu.Unlock()
+	tx.parent.onCommit = append(tx.parent.onCommit, onCommit...)
+	tx.parent.onRollback = append(tx.parent.onRollback, onRollback...)
+	return nil
+}
+
 // Client returns a Client that binds to current transaction.
 func (tx *Tx) Client() *Client {
 	tx.clientOnce.Do(func() {
@@ -199,6 +224,12 @@ type txDriver struct {
 	drv dialect.Driver
 	// tx is the underlying transaction.
 	tx dialect.Tx
+	// owner is the transactional client that was created with this driver.
+	owner *Tx
+	// savepoints counts the nested transactions started within the
+	// outermost transaction. It is shared with all nested transactions,
+	// and must be accessed atomically.
+	savepoints *int64
 }
 
 // newTx creates a new transactional driver.
@@ -210,6 +241,17 @@ func newTx(ctx context.Context, drv dialect.Driver) (*txDriver, error) {
 	return &txDriver{tx: tx, drv: drv}, nil
 }
 
+// bind sets the owner of the driver and returns it. The savepoints
+// counter is allocated here for outermost transactions, before the
+// driver is shared with other goroutines.
+func (tx *txDriver) bind(owner *Tx) *Tx {
+	tx.owner = owner
+	if tx.savepoints == nil {
+		tx.savepoints = new(int64)
+	}
+	return owner
+}
+
 // Tx returns the transaction wrapper (txDriver) to avoid Commit or Rollback calls
 // from the internal builders. Should be called only by the internal builders.
 func (tx *txDriver) Tx(context.Context) (dialect.Tx, error) { return tx, nil }
@@ -0,0 +1,105 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package deprecation
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/deprecation/ent"
+	"entgo.io/ent/entc/integration/deprecation/ent/hook"
+	"entgo.io/ent/entc/integration/deprecation/ent/migrate"
+	"entgo.io/ent/entc/integration/deprecation/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeprecation(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	// Deprecated fields can still be written (e.g. during rolling deploys).
+	a8m := client.User.Create().SetName("a8m").SetNickname("ariel").SetRank(1).SaveX(ctx)
+	require.Equal(t, "ariel", a8m.Nickname)
+	nati := client.User.Create().SetName("nati").SaveX(ctx)
+	require.Empty(t, nati.Nickname)
+	// Required deprecated fields with defaults that are not set on creation are stored as NULL.
+	createdAt := client.User.Query().Where(user.ID(nati.ID)).Select(user.FieldCreatedAt).OnlyX(ctx).CreatedAt
+	require.True(t, createdAt.IsZero())
+	require.Equal(t, 2, client.User.Query().Where(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(user.FieldCreatedAt)))
+	}).CountX(ctx))
+
+	// But they are not selected by default.
+	a8m = client.User.GetX(ctx, a8m.ID)
+	require.Equal(t, "a8m", a8m.Name)
+	require.Empty(t, a8m.Nickname)
+	require.Zero(t, a8m.Rank)
+	a8m = client.User.UpdateOne(a8m).SetName("Ariel").SaveX(ctx)
+	require.Empty(t, a8m.Nickname)
+
+	// Unless they are selected explicitly.
+	a8m = client.User.Query().Where(user.ID(a8m.ID)).Select(user.FieldName, user.FieldNickname, user.FieldRank).OnlyX(ctx)
+	require.Equal(t, "Ariel", a8m.Name)
+	require.Equal(t, "ariel", a8m.Nickname)
+	require.Equal(t, 1, a8m.Rank)
+	nicknames := client.User.Query().Where(user.NicknameNotNil()).Select(user.FieldNickname).StringsX(ctx)
+	require.Equal(t, []string{"ariel"}, nicknames)
+	require.Equal(t, nati.ID, client.User.Query().Where(user.NicknameIsNil()).OnlyIDX(ctx))
+
+	// Old values of deprecated fields are loaded by mutations.
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			if _, ok := m.Nickname(); ok && m.Op().Is(ent.OpUpdateOne) {
+				old, err := m.OldNickname(ctx)
+				require.NoError(t, err)
+				require.Equal(t, "ariel", old)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	client.User.UpdateOneID(a8m.ID).SetNickname("a8m").ExecX(ctx)
+	require.Equal(t, "a8m", client.User.Query().Where(user.ID(a8m.ID)).Select(user.FieldNickname).StringX(ctx))
+}
+
+func TestDropDeprecated(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:drop?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx, migrate.WithDropDeprecated(true)))
+	// SQLite does not support dropping columns in migration. Hence, the
+	// columns are dropped manually, as done by WithDropDeprecated.
+	for _, c := range []string{user.FieldNickname, user.FieldRank, user.FieldCreatedAt} {
+		_, err := drv.DB().ExecContext(ctx, "ALTER TABLE `users` DROP COLUMN `"+c+"`")
+		require.NoError(t, err)
+	}
+
+	// Defaults of deprecated fields are not set on creation.
+	a8m := client.User.Create().SetName("a8m").SaveX(ctx)
+	client.User.CreateBulk(client.User.Create().SetName("nati")).SaveX(ctx)
+	require.Equal(t, 2, client.User.Query().CountX(ctx))
+
+	// Old values are loaded without the deprecated columns.
+	client.User.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.UserFunc(func(ctx context.Context, m *ent.UserMutation) (ent.Value, error) {
+			old, err := m.OldName(ctx)
+			require.NoError(t, err)
+			require.Equal(t, "a8m", old)
+			return next.Mutate(ctx, m)
+		})
+	})
+	a8m = client.User.UpdateOne(a8m).SetName("Ariel").SaveX(ctx)
+	require.Equal(t, "Ariel", a8m.Name)
+	require.Equal(t, "Ariel", client.User.GetX(ctx, a8m.ID).Name)
+}
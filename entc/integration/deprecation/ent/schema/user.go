@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("nickname").
+			Optional().
+			Deprecated("use the name field instead"),
+		field.Int("rank").
+			Default(0).
+			Deprecated(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Deprecated("use the audit log instead"),
+	}
+}
@@ -125,11 +125,13 @@ func (ic *InfoCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ic *InfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ic.mutation.Content(); !ok {
-		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "Info.content"`)}
+		errs = append(errs, newValidationError("content", "Required", errors.New(`ent: missing required field "Info.content"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (ic *InfoCreate) sqlSave(ctx context.Context) (*Info, error) {
@@ -204,6 +206,9 @@ func (icb *InfoCreateBulk) Save(ctx context.Context) ([]*Info, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
 	nodes := make([]*Info, len(icb.builders))
 	mutators := make([]Mutator, len(icb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range icb.builders {
 		func(i int, root context.Context) {
 			builder := icb.builders[i]
@@ -213,13 +218,20 @@ func (icb *InfoCreateBulk) Save(ctx context.Context) ([]*Info, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
@@ -141,23 +141,25 @@ func (rc *RentalCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (rc *RentalCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := rc.mutation.Date(); !ok {
-		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Rental.date"`)}
+		errs = append(errs, newValidationError("date", "Required", errors.New(`ent: missing required field "Rental.date"`)))
 	}
 	if _, ok := rc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Rental.user_id"`)}
+		errs = append(errs, newValidationError("user_id", "Required", errors.New(`ent: missing required field "Rental.user_id"`)))
 	}
 	if _, ok := rc.mutation.CarID(); !ok {
-		return &ValidationError{Name: "car_id", err: errors.New(`ent: missing required field "Rental.car_id"`)}
+		errs = append(errs, newValidationError("car_id", "Required", errors.New(`ent: missing required field "Rental.car_id"`)))
 	}
 	if _, ok := rc.mutation.UserID(); !ok {
-		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Rental.user"`)}
+		errs = append(errs, newValidationError("user", "Required", errors.New(`ent: missing required edge "Rental.user"`)))
 	}
 	if _, ok := rc.mutation.CarID(); !ok {
-		return &ValidationError{Name: "car", err: errors.New(`ent: missing required edge "Rental.car"`)}
+		errs = append(errs, newValidationError("car", "Required", errors.New(`ent: missing required edge "Rental.car"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (rc *RentalCreate) sqlSave(ctx context.Context) (*Rental, error) {
@@ -246,6 +248,9 @@ func (rcb *RentalCreateBulk) Save(ctx context.Context) ([]*Rental, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
 	nodes := make([]*Rental, len(rcb.builders))
 	mutators := make([]Mutator, len(rcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range rcb.builders {
 		func(i int, root context.Context) {
 			builder := rcb.builders[i]
@@ -256,13 +261,20 @@ func (rcb *RentalCreateBulk) Save(ctx context.Context) ([]*Rental, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
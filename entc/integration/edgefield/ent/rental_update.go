@@ -149,14 +149,16 @@ func (ru *RentalUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ru *RentalUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := ru.mutation.UserID(); ru.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.user"`)
+		errs = append(errs, newValidationError("user", "Required", errors.New(`ent: clearing a required unique edge "Rental.user"`)))
 	}
 	if _, ok := ru.mutation.CarID(); ru.mutation.CarCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.car"`)
+		errs = append(errs, newValidationError("car", "Required", errors.New(`ent: clearing a required unique edge "Rental.car"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (ru *RentalUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -394,14 +396,16 @@ func (ruo *RentalUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ruo *RentalUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := ruo.mutation.UserID(); ruo.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.user"`)
+		errs = append(errs, newValidationError("user", "Required", errors.New(`ent: clearing a required unique edge "Rental.user"`)))
 	}
 	if _, ok := ruo.mutation.CarID(); ruo.mutation.CarCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Rental.car"`)
+		errs = append(errs, newValidationError("car", "Required", errors.New(`ent: clearing a required unique edge "Rental.car"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (ruo *RentalUpdateOne) sqlSave(ctx context.Context) (_node *Rental, err error) {
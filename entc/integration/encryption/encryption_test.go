@@ -0,0 +1,98 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package encryption
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/encryption/ent"
+	"entgo.io/ent/entc/integration/encryption/ent/schema"
+	"entgo.io/ent/entc/integration/encryption/ent/user"
+	"entgo.io/ent/schema/field/encrypt"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryption(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	a8m := client.User.Create().
+		SetName("a8m").
+		SetSsn("123-45-6789").
+		SetPhone("555-0100").
+		SetNotes([]byte("notes")).
+		SaveX(ctx)
+	require.Equal(t, "123-45-6789", a8m.Ssn)
+	require.Equal(t, "555-0100", *a8m.Phone)
+
+	// Values are stored encrypted.
+	var ssn, phone, notes []byte
+	require.NoError(t, drv.DB().QueryRowContext(ctx, "SELECT ssn, phone, notes FROM users WHERE id = ?", a8m.ID).Scan(&ssn, &phone, &notes))
+	require.NotContains(t, string(ssn), "123-45-6789")
+	require.NotContains(t, string(phone), "555-0100")
+	require.NotContains(t, string(notes), "notes")
+
+	// And decrypted on scan.
+	a8m = client.User.GetX(ctx, a8m.ID)
+	require.Equal(t, "123-45-6789", a8m.Ssn)
+	require.Equal(t, "555-0100", *a8m.Phone)
+	require.Equal(t, []byte("notes"), a8m.Notes)
+
+	// Deterministic fields support equality predicates.
+	nati := client.User.Create().SetName("nati").SetSsn("987-65-4321").SaveX(ctx)
+	require.Nil(t, nati.Phone)
+	require.Equal(t, a8m.ID, client.User.Query().Where(user.Ssn("123-45-6789")).OnlyIDX(ctx))
+	require.Equal(t, nati.ID, client.User.Query().Where(user.SsnNEQ("123-45-6789")).OnlyIDX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.SsnIn("123-45-6789", "987-65-4321")).CountX(ctx))
+	require.Equal(t, nati.ID, client.User.Query().Where(user.PhoneIsNil()).OnlyIDX(ctx))
+	_, err = client.User.Create().SetName("dup").SetSsn("987-65-4321").Save(ctx)
+	require.True(t, ent.IsConstraintError(err))
+
+	// Encrypted fields are sensitive, and cannot be scanned, grouped or ordered by their ciphertexts.
+	require.NotContains(t, a8m.String(), "123-45-6789")
+	buf, err := json.Marshal(a8m)
+	require.NoError(t, err)
+	require.NotContains(t, string(buf), "123-45-6789")
+	_, err = client.User.Query().Select(user.FieldSsn).Strings(ctx)
+	require.True(t, ent.IsValidationError(err))
+	_, err = client.User.Query().GroupBy(user.FieldPhone).Strings(ctx)
+	require.True(t, ent.IsValidationError(err))
+	_, err = client.User.Query().Order(ent.Asc(user.FieldSsn)).All(ctx)
+	require.EqualError(t, err, `ent: encrypted column "ssn" for table "users" cannot be ordered or aggregated`)
+	names := client.User.Query().Order(ent.Asc(user.FieldName)).Select(user.FieldName).StringsX(ctx)
+	require.Equal(t, []string{"a8m", "nati"}, names)
+	users := client.User.Query().Order(ent.Asc(user.FieldName)).Select(user.FieldSsn).AllX(ctx)
+	require.Equal(t, "123-45-6789", users[0].Ssn)
+
+	// Updates are encrypted as well.
+	n := client.User.Update().Where(user.Ssn("987-65-4321")).SetPhone("555-0101").SaveX(ctx)
+	require.Equal(t, 1, n)
+	require.Equal(t, "555-0101", *client.User.GetX(ctx, nati.ID).Phone)
+	nati = nati.Update().ClearPhone().SetNotes([]byte("updated")).SaveX(ctx)
+	require.Nil(t, nati.Phone)
+	require.Equal(t, []byte("updated"), client.User.GetX(ctx, nati.ID).Notes)
+
+	// Rotate the current key. Old values are still decrypted
+	// using their keys, and new values use the current one.
+	schema.Keys.Current = "k2"
+	defer func() { schema.Keys.Current = "k1" }()
+	require.Equal(t, "555-0100", *client.User.GetX(ctx, a8m.ID).Phone)
+	a8m = a8m.Update().SetSsn("123-45-6789").SaveX(ctx)
+	require.NoError(t, drv.DB().QueryRowContext(ctx, "SELECT ssn FROM users WHERE id = ?", a8m.ID).Scan(&ssn))
+	id, err := encrypt.KeyID(ssn)
+	require.NoError(t, err)
+	require.Equal(t, "k2", id)
+	require.Equal(t, a8m.ID, client.User.Query().Where(user.Ssn("123-45-6789")).OnlyIDX(ctx))
+}
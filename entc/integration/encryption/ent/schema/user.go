@@ -0,0 +1,45 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/field/encrypt"
+)
+
+// Keys holds the encryption keys of the schema. It is
+// exported for allowing tests to rotate the current key.
+var Keys = &encrypt.Keys{
+	Current: "k1",
+	Keys: map[string][]byte{
+		"k1": []byte("0123456789abcdef0123456789abcdef"),
+		"k2": []byte("fedcba9876543210fedcba9876543210"),
+	},
+}
+
+// User holds the schema definition for the User entity.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		// Deterministic encryption allows querying the field
+		// using equality predicates and unique constraints.
+		field.String("ssn").
+			Unique().
+			Encrypted(encrypt.DeterministicAES(Keys)),
+		field.String("phone").
+			Optional().
+			Nillable().
+			Encrypted(encrypt.AES(Keys)),
+		field.Bytes("notes").
+			Optional().
+			Encrypted(encrypt.AES(Keys)),
+	}
+}
@@ -210,30 +210,32 @@ func (cc *CardCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cc *CardCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.CreateTime(); !ok {
-		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Card.create_time"`)}
+		errs = append(errs, newValidationError("create_time", "Required", errors.New(`ent: missing required field "Card.create_time"`)))
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
-		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Card.update_time"`)}
+		errs = append(errs, newValidationError("update_time", "Required", errors.New(`ent: missing required field "Card.update_time"`)))
 	}
 	if _, ok := cc.mutation.Balance(); !ok {
-		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Card.balance"`)}
+		errs = append(errs, newValidationError("balance", "Required", errors.New(`ent: missing required field "Card.balance"`)))
 	}
 	if _, ok := cc.mutation.Number(); !ok {
-		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Card.number"`)}
+		errs = append(errs, newValidationError("number", "Required", errors.New(`ent: missing required field "Card.number"`)))
 	}
 	if v, ok := cc.mutation.Number(); ok {
 		if err := card.NumberValidator(v); err != nil {
-			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+			errs = append(errs, validatorError("number", fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)))
 		}
 	}
 	if v, ok := cc.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CardCreate) sqlSave(ctx context.Context) (*Card, error) {
@@ -438,6 +440,11 @@ func (u *CardUpsert) AddBalance(v float64) *CardUpsert {
 
 // SetNumber sets the "number" field.
 func (u *CardUpsert) SetNumber(v string) *CardUpsert {
+	if err := card.NumberValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("number", fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)))
+		return u.addError(card.FieldNumber, errs.err())
+	}
 	u.Set(card.FieldNumber, v)
 	return u
 }
@@ -450,6 +457,11 @@ func (u *CardUpsert) UpdateNumber() *CardUpsert {
 
 // SetName sets the "name" field.
 func (u *CardUpsert) SetName(v string) *CardUpsert {
+	if err := card.NameValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
+		return u.addError(card.FieldName, errs.err())
+	}
 	u.Set(card.FieldName, v)
 	return u
 }
@@ -466,6 +478,15 @@ func (u *CardUpsert) ClearName() *CardUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *CardUpsert) addError(column string, err error) *CardUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -645,6 +666,9 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
 	nodes := make([]*Card, len(ccb.builders))
 	mutators := make([]Mutator, len(ccb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ccb.builders {
 		func(i int, root context.Context) {
 			builder := ccb.builders[i]
@@ -655,13 +679,20 @@ func (ccb *CardCreateBulk) Save(ctx context.Context) ([]*Card, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = ccb.conflict
@@ -217,13 +217,15 @@ func (cu *CardUpdate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cu *CardUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := cu.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -576,13 +578,15 @@ func (cuo *CardUpdateOne) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cuo *CardUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := cuo.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error) {
@@ -131,6 +131,8 @@ var schemaGraph = func() *sqlgraph.Schema {
 			fieldtype.FieldSchemaFloat:           {Type: field.TypeFloat64, Column: fieldtype.FieldSchemaFloat},
 			fieldtype.FieldSchemaFloat32:         {Type: field.TypeFloat32, Column: fieldtype.FieldSchemaFloat32},
 			fieldtype.FieldNullFloat:             {Type: field.TypeFloat64, Column: fieldtype.FieldNullFloat},
+			fieldtype.FieldAmount:                {Type: field.TypeDecimal, Column: fieldtype.FieldAmount},
+			fieldtype.FieldBalance:               {Type: field.TypeDecimal, Column: fieldtype.FieldBalance},
 			fieldtype.FieldRole:                  {Type: field.TypeEnum, Column: fieldtype.FieldRole},
 			fieldtype.FieldPriority:              {Type: field.TypeEnum, Column: fieldtype.FieldPriority},
 			fieldtype.FieldUUID:                  {Type: field.TypeUUID, Column: fieldtype.FieldUUID},
@@ -658,7 +660,52 @@ func (cq *CardQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the CardQuery builder.
 func (cq *CardQuery) Filter() *CardFilter {
-	return &CardFilter{cq}
+	return &CardFilter{predicateAdder: cq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// CardQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (cq *CardQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[0].Type, q, allow); err != nil {
+		return err
+	}
+	cq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the CardQuery builder.
+func (cq *CardQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		cq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			cq.Order(Desc(o.Field))
+		} else {
+			cq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		cq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		cq.Offset(q.Offset)
+	}
+	cq.fields = append(cq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case card.EdgeOwner:
+			cq.WithOwner(func(query *UserQuery) { query.applyQuery(wq) })
+		case card.EdgeSpec:
+			cq.WithSpec(func(query *SpecQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -668,16 +715,26 @@ func (m *CardMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the CardMutation builder.
 func (m *CardMutation) Filter() *CardFilter {
-	return &CardFilter{m}
+	return &CardFilter{predicateAdder: m}
 }
 
 // CardFilter provides a generic filtering capability at runtime for CardQuery.
 type CardFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *CardFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *CardFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
 			s.AddError(err)
@@ -685,6 +742,19 @@ func (f *CardFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *CardFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[0].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *CardFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(card.FieldID))
@@ -750,7 +820,43 @@ func (cq *CommentQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the CommentQuery builder.
 func (cq *CommentQuery) Filter() *CommentFilter {
-	return &CommentFilter{cq}
+	return &CommentFilter{predicateAdder: cq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// CommentQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (cq *CommentQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[1].Type, q, allow); err != nil {
+		return err
+	}
+	cq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the CommentQuery builder.
+func (cq *CommentQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		cq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			cq.Order(Desc(o.Field))
+		} else {
+			cq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		cq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		cq.Offset(q.Offset)
+	}
+	cq.fields = append(cq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -760,16 +866,26 @@ func (m *CommentMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the CommentMutation builder.
 func (m *CommentMutation) Filter() *CommentFilter {
-	return &CommentFilter{m}
+	return &CommentFilter{predicateAdder: m}
 }
 
 // CommentFilter provides a generic filtering capability at runtime for CommentQuery.
 type CommentFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *CommentFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *CommentFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
@@ -777,6 +893,19 @@ func (f *CommentFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *CommentFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[1].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *CommentFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(comment.FieldID))
@@ -804,7 +933,43 @@ func (ftq *FieldTypeQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the FieldTypeQuery builder.
 func (ftq *FieldTypeQuery) Filter() *FieldTypeFilter {
-	return &FieldTypeFilter{ftq}
+	return &FieldTypeFilter{predicateAdder: ftq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// FieldTypeQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (ftq *FieldTypeQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[2].Type, q, allow); err != nil {
+		return err
+	}
+	ftq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the FieldTypeQuery builder.
+func (ftq *FieldTypeQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		ftq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			ftq.Order(Desc(o.Field))
+		} else {
+			ftq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		ftq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		ftq.Offset(q.Offset)
+	}
+	ftq.fields = append(ftq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -814,16 +979,26 @@ func (m *FieldTypeMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the FieldTypeMutation builder.
 func (m *FieldTypeMutation) Filter() *FieldTypeFilter {
-	return &FieldTypeFilter{m}
+	return &FieldTypeFilter{predicateAdder: m}
 }
 
 // FieldTypeFilter provides a generic filtering capability at runtime for FieldTypeQuery.
 type FieldTypeFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *FieldTypeFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *FieldTypeFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
@@ -831,6 +1006,19 @@ func (f *FieldTypeFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *FieldTypeFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[2].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *FieldTypeFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(fieldtype.FieldID))
@@ -1106,6 +1294,16 @@ func (f *FieldTypeFilter) WhereNullFloat(p entql.Float64P) {
 	f.Where(p.Field(fieldtype.FieldNullFloat))
 }
 
+// WhereAmount applies the entql string predicate on the amount field.
+func (f *FieldTypeFilter) WhereAmount(p entql.StringP) {
+	f.Where(p.Field(fieldtype.FieldAmount))
+}
+
+// WhereBalance applies the entql string predicate on the balance field.
+func (f *FieldTypeFilter) WhereBalance(p entql.StringP) {
+	f.Where(p.Field(fieldtype.FieldBalance))
+}
+
 // WhereRole applies the entql string predicate on the role field.
 func (f *FieldTypeFilter) WhereRole(p entql.StringP) {
 	f.Where(p.Field(fieldtype.FieldRole))
@@ -1168,7 +1366,54 @@ func (fq *FileQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the FileQuery builder.
 func (fq *FileQuery) Filter() *FileFilter {
-	return &FileFilter{fq}
+	return &FileFilter{predicateAdder: fq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// FileQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (fq *FileQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[3].Type, q, allow); err != nil {
+		return err
+	}
+	fq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the FileQuery builder.
+func (fq *FileQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		fq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			fq.Order(Desc(o.Field))
+		} else {
+			fq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		fq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		fq.Offset(q.Offset)
+	}
+	fq.fields = append(fq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case file.EdgeOwner:
+			fq.WithOwner(func(query *UserQuery) { query.applyQuery(wq) })
+		case file.EdgeType:
+			fq.WithType(func(query *FileTypeQuery) { query.applyQuery(wq) })
+		case file.EdgeField:
+			fq.WithField(func(query *FieldTypeQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1178,16 +1423,26 @@ func (m *FileMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the FileMutation builder.
 func (m *FileMutation) Filter() *FileFilter {
-	return &FileFilter{m}
+	return &FileFilter{predicateAdder: m}
 }
 
 // FileFilter provides a generic filtering capability at runtime for FileQuery.
 type FileFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *FileFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *FileFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1195,6 +1450,19 @@ func (f *FileFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *FileFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[3].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *FileFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(file.FieldID))
@@ -1274,7 +1542,50 @@ func (ftq *FileTypeQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the FileTypeQuery builder.
 func (ftq *FileTypeQuery) Filter() *FileTypeFilter {
-	return &FileTypeFilter{ftq}
+	return &FileTypeFilter{predicateAdder: ftq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// FileTypeQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (ftq *FileTypeQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[4].Type, q, allow); err != nil {
+		return err
+	}
+	ftq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the FileTypeQuery builder.
+func (ftq *FileTypeQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		ftq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			ftq.Order(Desc(o.Field))
+		} else {
+			ftq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		ftq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		ftq.Offset(q.Offset)
+	}
+	ftq.fields = append(ftq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case filetype.EdgeFiles:
+			ftq.WithFiles(func(query *FileQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1284,16 +1595,26 @@ func (m *FileTypeMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the FileTypeMutation builder.
 func (m *FileTypeMutation) Filter() *FileTypeFilter {
-	return &FileTypeFilter{m}
+	return &FileTypeFilter{predicateAdder: m}
 }
 
 // FileTypeFilter provides a generic filtering capability at runtime for FileTypeQuery.
 type FileTypeFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *FileTypeFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *FileTypeFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1301,6 +1622,19 @@ func (f *FileTypeFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *FileTypeFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[4].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *FileTypeFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(filetype.FieldID))
@@ -1342,7 +1676,43 @@ func (gq *GoodsQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the GoodsQuery builder.
 func (gq *GoodsQuery) Filter() *GoodsFilter {
-	return &GoodsFilter{gq}
+	return &GoodsFilter{predicateAdder: gq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// GoodsQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (gq *GoodsQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[5].Type, q, allow); err != nil {
+		return err
+	}
+	gq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the GoodsQuery builder.
+func (gq *GoodsQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		gq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		gq.Offset(q.Offset)
+	}
+	gq.fields = append(gq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1352,16 +1722,26 @@ func (m *GoodsMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the GoodsMutation builder.
 func (m *GoodsMutation) Filter() *GoodsFilter {
-	return &GoodsFilter{m}
+	return &GoodsFilter{predicateAdder: m}
 }
 
 // GoodsFilter provides a generic filtering capability at runtime for GoodsQuery.
 type GoodsFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *GoodsFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *GoodsFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1369,6 +1749,19 @@ func (f *GoodsFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *GoodsFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[5].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *GoodsFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(goods.FieldID))
@@ -1381,7 +1774,56 @@ func (gq *GroupQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the GroupQuery builder.
 func (gq *GroupQuery) Filter() *GroupFilter {
-	return &GroupFilter{gq}
+	return &GroupFilter{predicateAdder: gq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// GroupQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (gq *GroupQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[6].Type, q, allow); err != nil {
+		return err
+	}
+	gq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the GroupQuery builder.
+func (gq *GroupQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		gq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		gq.Offset(q.Offset)
+	}
+	gq.fields = append(gq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case group.EdgeFiles:
+			gq.WithFiles(func(query *FileQuery) { query.applyQuery(wq) })
+		case group.EdgeBlocked:
+			gq.WithBlocked(func(query *UserQuery) { query.applyQuery(wq) })
+		case group.EdgeUsers:
+			gq.WithUsers(func(query *UserQuery) { query.applyQuery(wq) })
+		case group.EdgeInfo:
+			gq.WithInfo(func(query *GroupInfoQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1391,16 +1833,26 @@ func (m *GroupMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the GroupMutation builder.
 func (m *GroupMutation) Filter() *GroupFilter {
-	return &GroupFilter{m}
+	return &GroupFilter{predicateAdder: m}
 }
 
 // GroupFilter provides a generic filtering capability at runtime for GroupQuery.
 type GroupFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *GroupFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *GroupFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1408,6 +1860,19 @@ func (f *GroupFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *GroupFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[6].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *GroupFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(group.FieldID))
@@ -1501,7 +1966,50 @@ func (giq *GroupInfoQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the GroupInfoQuery builder.
 func (giq *GroupInfoQuery) Filter() *GroupInfoFilter {
-	return &GroupInfoFilter{giq}
+	return &GroupInfoFilter{predicateAdder: giq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// GroupInfoQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (giq *GroupInfoQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[7].Type, q, allow); err != nil {
+		return err
+	}
+	giq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the GroupInfoQuery builder.
+func (giq *GroupInfoQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		giq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			giq.Order(Desc(o.Field))
+		} else {
+			giq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		giq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		giq.Offset(q.Offset)
+	}
+	giq.fields = append(giq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case groupinfo.EdgeGroups:
+			giq.WithGroups(func(query *GroupQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1511,16 +2019,26 @@ func (m *GroupInfoMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the GroupInfoMutation builder.
 func (m *GroupInfoMutation) Filter() *GroupInfoFilter {
-	return &GroupInfoFilter{m}
+	return &GroupInfoFilter{predicateAdder: m}
 }
 
 // GroupInfoFilter provides a generic filtering capability at runtime for GroupInfoQuery.
 type GroupInfoFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *GroupInfoFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *GroupInfoFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1528,6 +2046,19 @@ func (f *GroupInfoFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *GroupInfoFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[7].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *GroupInfoFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(groupinfo.FieldID))
@@ -1564,7 +2095,43 @@ func (iq *ItemQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the ItemQuery builder.
 func (iq *ItemQuery) Filter() *ItemFilter {
-	return &ItemFilter{iq}
+	return &ItemFilter{predicateAdder: iq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// ItemQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (iq *ItemQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[8].Type, q, allow); err != nil {
+		return err
+	}
+	iq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the ItemQuery builder.
+func (iq *ItemQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		iq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			iq.Order(Desc(o.Field))
+		} else {
+			iq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		iq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		iq.Offset(q.Offset)
+	}
+	iq.fields = append(iq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1574,16 +2141,26 @@ func (m *ItemMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the ItemMutation builder.
 func (m *ItemMutation) Filter() *ItemFilter {
-	return &ItemFilter{m}
+	return &ItemFilter{predicateAdder: m}
 }
 
 // ItemFilter provides a generic filtering capability at runtime for ItemQuery.
 type ItemFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *ItemFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *ItemFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1591,6 +2168,19 @@ func (f *ItemFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *ItemFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[8].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql string predicate on the id field.
 func (f *ItemFilter) WhereID(p entql.StringP) {
 	f.Where(p.Field(item.FieldID))
@@ -1608,7 +2198,52 @@ func (nq *NodeQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the NodeQuery builder.
 func (nq *NodeQuery) Filter() *NodeFilter {
-	return &NodeFilter{nq}
+	return &NodeFilter{predicateAdder: nq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// NodeQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (nq *NodeQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[9].Type, q, allow); err != nil {
+		return err
+	}
+	nq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the NodeQuery builder.
+func (nq *NodeQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		nq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			nq.Order(Desc(o.Field))
+		} else {
+			nq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		nq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		nq.Offset(q.Offset)
+	}
+	nq.fields = append(nq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case node.EdgePrev:
+			nq.WithPrev(func(query *NodeQuery) { query.applyQuery(wq) })
+		case node.EdgeNext:
+			nq.WithNext(func(query *NodeQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1618,16 +2253,26 @@ func (m *NodeMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the NodeMutation builder.
 func (m *NodeMutation) Filter() *NodeFilter {
-	return &NodeFilter{m}
+	return &NodeFilter{predicateAdder: m}
 }
 
 // NodeFilter provides a generic filtering capability at runtime for NodeQuery.
 type NodeFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *NodeFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *NodeFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1635,6 +2280,19 @@ func (f *NodeFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *NodeFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[9].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *NodeFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(node.FieldID))
@@ -1680,7 +2338,52 @@ func (pq *PetQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the PetQuery builder.
 func (pq *PetQuery) Filter() *PetFilter {
-	return &PetFilter{pq}
+	return &PetFilter{predicateAdder: pq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// PetQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (pq *PetQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[10].Type, q, allow); err != nil {
+		return err
+	}
+	pq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the PetQuery builder.
+func (pq *PetQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		pq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			pq.Order(Desc(o.Field))
+		} else {
+			pq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		pq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		pq.Offset(q.Offset)
+	}
+	pq.fields = append(pq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case pet.EdgeTeam:
+			pq.WithTeam(func(query *UserQuery) { query.applyQuery(wq) })
+		case pet.EdgeOwner:
+			pq.WithOwner(func(query *UserQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1690,16 +2393,26 @@ func (m *PetMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the PetMutation builder.
 func (m *PetMutation) Filter() *PetFilter {
-	return &PetFilter{m}
+	return &PetFilter{predicateAdder: m}
 }
 
 // PetFilter provides a generic filtering capability at runtime for PetQuery.
 type PetFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *PetFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *PetFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1707,6 +2420,19 @@ func (f *PetFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *PetFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[10].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *PetFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(pet.FieldID))
@@ -1767,7 +2493,50 @@ func (sq *SpecQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the SpecQuery builder.
 func (sq *SpecQuery) Filter() *SpecFilter {
-	return &SpecFilter{sq}
+	return &SpecFilter{predicateAdder: sq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// SpecQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (sq *SpecQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[11].Type, q, allow); err != nil {
+		return err
+	}
+	sq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the SpecQuery builder.
+func (sq *SpecQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		sq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			sq.Order(Desc(o.Field))
+		} else {
+			sq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		sq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		sq.Offset(q.Offset)
+	}
+	sq.fields = append(sq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case spec.EdgeCard:
+			sq.WithCard(func(query *CardQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1777,16 +2546,26 @@ func (m *SpecMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the SpecMutation builder.
 func (m *SpecMutation) Filter() *SpecFilter {
-	return &SpecFilter{m}
+	return &SpecFilter{predicateAdder: m}
 }
 
 // SpecFilter provides a generic filtering capability at runtime for SpecQuery.
 type SpecFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *SpecFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *SpecFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1794,6 +2573,19 @@ func (f *SpecFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *SpecFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[11].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *SpecFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(spec.FieldID))
@@ -1820,7 +2612,43 @@ func (tq *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the TaskQuery builder.
 func (tq *TaskQuery) Filter() *TaskFilter {
-	return &TaskFilter{tq}
+	return &TaskFilter{predicateAdder: tq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// TaskQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (tq *TaskQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[12].Type, q, allow); err != nil {
+		return err
+	}
+	tq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the TaskQuery builder.
+func (tq *TaskQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		tq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		tq.Offset(q.Offset)
+	}
+	tq.fields = append(tq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1830,16 +2658,26 @@ func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the TaskMutation builder.
 func (m *TaskMutation) Filter() *TaskFilter {
-	return &TaskFilter{m}
+	return &TaskFilter{predicateAdder: m}
 }
 
 // TaskFilter provides a generic filtering capability at runtime for TaskQuery.
 type TaskFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *TaskFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *TaskFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1847,6 +2685,19 @@ func (f *TaskFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *TaskFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[12].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *TaskFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(task.FieldID))
@@ -1864,7 +2715,70 @@ func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the UserQuery builder.
 func (uq *UserQuery) Filter() *UserFilter {
-	return &UserFilter{uq}
+	return &UserFilter{predicateAdder: uq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// UserQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (uq *UserQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[13].Type, q, allow); err != nil {
+		return err
+	}
+	uq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the UserQuery builder.
+func (uq *UserQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		uq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		uq.Offset(q.Offset)
+	}
+	uq.fields = append(uq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case user.EdgeCard:
+			uq.WithCard(func(query *CardQuery) { query.applyQuery(wq) })
+		case user.EdgePets:
+			uq.WithPets(func(query *PetQuery) { query.applyQuery(wq) })
+		case user.EdgeFiles:
+			uq.WithFiles(func(query *FileQuery) { query.applyQuery(wq) })
+		case user.EdgeGroups:
+			uq.WithGroups(func(query *GroupQuery) { query.applyQuery(wq) })
+		case user.EdgeFriends:
+			uq.WithFriends(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgeFollowers:
+			uq.WithFollowers(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgeFollowing:
+			uq.WithFollowing(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgeTeam:
+			uq.WithTeam(func(query *PetQuery) { query.applyQuery(wq) })
+		case user.EdgeSpouse:
+			uq.WithSpouse(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgeChildren:
+			uq.WithChildren(func(query *UserQuery) { query.applyQuery(wq) })
+		case user.EdgeParent:
+			uq.WithParent(func(query *UserQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -1874,16 +2788,26 @@ func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
 func (m *UserMutation) Filter() *UserFilter {
-	return &UserFilter{m}
+	return &UserFilter{predicateAdder: m}
 }
 
 // UserFilter provides a generic filtering capability at runtime for UserQuery.
 type UserFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *UserFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
@@ -1891,6 +2815,19 @@ func (f *UserFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *UserFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[13].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *UserFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(user.FieldID))
@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/entc/integration/ent/fieldtype"
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
+	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
 
@@ -134,6 +135,10 @@ type FieldType struct {
 	SchemaFloat32 schema.Float32 `json:"schema_float32,omitempty"`
 	// NullFloat holds the value of the "null_float" field.
 	NullFloat *sql.NullFloat64 `json:"null_float,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance *field.Rat `json:"balance,omitempty"`
 	// Role holds the value of the "role" field.
 	Role role.Role `json:"role,omitempty"`
 	// Priority holds the value of the "priority" field.
@@ -164,6 +169,8 @@ func (*FieldType) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case fieldtype.FieldBalance:
+			values[i] = &sql.NullScanner{S: new(field.Rat)}
 		case fieldtype.FieldNullLink:
 			values[i] = &sql.NullScanner{S: new(schema.Link)}
 		case fieldtype.FieldNilPair:
@@ -198,7 +205,7 @@ func (*FieldType) scanValues(columns []string) ([]interface{}, error) {
 			values[i] = new(sql.NullFloat64)
 		case fieldtype.FieldID, fieldtype.FieldInt, fieldtype.FieldInt8, fieldtype.FieldInt16, fieldtype.FieldInt32, fieldtype.FieldInt64, fieldtype.FieldOptionalInt, fieldtype.FieldOptionalInt8, fieldtype.FieldOptionalInt16, fieldtype.FieldOptionalInt32, fieldtype.FieldOptionalInt64, fieldtype.FieldNillableInt, fieldtype.FieldNillableInt8, fieldtype.FieldNillableInt16, fieldtype.FieldNillableInt32, fieldtype.FieldNillableInt64, fieldtype.FieldValidateOptionalInt32, fieldtype.FieldOptionalUint, fieldtype.FieldOptionalUint8, fieldtype.FieldOptionalUint16, fieldtype.FieldOptionalUint32, fieldtype.FieldOptionalUint64, fieldtype.FieldDuration, fieldtype.FieldNullInt64, fieldtype.FieldSchemaInt, fieldtype.FieldSchemaInt8, fieldtype.FieldSchemaInt64:
 			values[i] = new(sql.NullInt64)
-		case fieldtype.FieldState, fieldtype.FieldText, fieldtype.FieldPassword, fieldtype.FieldDir, fieldtype.FieldNdir, fieldtype.FieldStr, fieldtype.FieldNullStr, fieldtype.FieldRole:
+		case fieldtype.FieldState, fieldtype.FieldText, fieldtype.FieldPassword, fieldtype.FieldDir, fieldtype.FieldNdir, fieldtype.FieldStr, fieldtype.FieldNullStr, fieldtype.FieldAmount, fieldtype.FieldRole:
 			values[i] = new(sql.NullString)
 		case fieldtype.FieldDatetime, fieldtype.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -559,6 +566,19 @@ func (ft *FieldType) assignValues(columns []string, values []interface{}) error
 			} else if value.Valid {
 				ft.NullFloat = value
 			}
+		case fieldtype.FieldAmount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				ft.Amount = value.String
+			}
+		case fieldtype.FieldBalance:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field balance", values[i])
+			} else if value.Valid {
+				ft.Balance = new(field.Rat)
+				*ft.Balance = *value.S.(*field.Rat)
+			}
 		case fieldtype.FieldRole:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field role", values[i])
@@ -791,6 +811,12 @@ func (ft *FieldType) String() string {
 	builder.WriteString(fmt.Sprintf("%v", ft.SchemaFloat32))
 	builder.WriteString(", null_float=")
 	builder.WriteString(fmt.Sprintf("%v", ft.NullFloat))
+	builder.WriteString(", amount=")
+	builder.WriteString(fmt.Sprintf("%v", ft.Amount))
+	if v := ft.Balance; v != nil {
+		builder.WriteString(", balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", role=")
 	builder.WriteString(fmt.Sprintf("%v", ft.Role))
 	builder.WriteString(", priority=")
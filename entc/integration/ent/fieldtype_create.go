@@ -651,6 +651,34 @@ func (ftc *FieldTypeCreate) SetNullFloat(sf *sql.NullFloat64) *FieldTypeCreate {
 	return ftc
 }
 
+// SetAmount sets the "amount" field.
+func (ftc *FieldTypeCreate) SetAmount(s string) *FieldTypeCreate {
+	ftc.mutation.SetAmount(s)
+	return ftc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableAmount(s *string) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetAmount(*s)
+	}
+	return ftc
+}
+
+// SetBalance sets the "balance" field.
+func (ftc *FieldTypeCreate) SetBalance(f field.Rat) *FieldTypeCreate {
+	ftc.mutation.SetBalance(f)
+	return ftc
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableBalance(f *field.Rat) *FieldTypeCreate {
+	if f != nil {
+		ftc.SetBalance(*f)
+	}
+	return ftc
+}
+
 // SetRole sets the "role" field.
 func (ftc *FieldTypeCreate) SetRole(r role.Role) *FieldTypeCreate {
 	ftc.mutation.SetRole(r)
@@ -868,6 +896,10 @@ func (ftc *FieldTypeCreate) defaults() {
 		v := fieldtype.DefaultIP()
 		ftc.mutation.SetIP(v)
 	}
+	if _, ok := ftc.mutation.Amount(); !ok {
+		v := fieldtype.DefaultAmount
+		ftc.mutation.SetAmount(v)
+	}
 	if _, ok := ftc.mutation.Role(); !ok {
 		v := fieldtype.DefaultRole
 		ftc.mutation.SetRole(v)
@@ -887,83 +919,85 @@ func (ftc *FieldTypeCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftc *FieldTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Int(); !ok {
-		return &ValidationError{Name: "int", err: errors.New(`ent: missing required field "FieldType.int"`)}
+		errs = append(errs, newValidationError("int", "Required", errors.New(`ent: missing required field "FieldType.int"`)))
 	}
 	if _, ok := ftc.mutation.Int8(); !ok {
-		return &ValidationError{Name: "int8", err: errors.New(`ent: missing required field "FieldType.int8"`)}
+		errs = append(errs, newValidationError("int8", "Required", errors.New(`ent: missing required field "FieldType.int8"`)))
 	}
 	if _, ok := ftc.mutation.Int16(); !ok {
-		return &ValidationError{Name: "int16", err: errors.New(`ent: missing required field "FieldType.int16"`)}
+		errs = append(errs, newValidationError("int16", "Required", errors.New(`ent: missing required field "FieldType.int16"`)))
 	}
 	if _, ok := ftc.mutation.Int32(); !ok {
-		return &ValidationError{Name: "int32", err: errors.New(`ent: missing required field "FieldType.int32"`)}
+		errs = append(errs, newValidationError("int32", "Required", errors.New(`ent: missing required field "FieldType.int32"`)))
 	}
 	if _, ok := ftc.mutation.Int64(); !ok {
-		return &ValidationError{Name: "int64", err: errors.New(`ent: missing required field "FieldType.int64"`)}
+		errs = append(errs, newValidationError("int64", "Required", errors.New(`ent: missing required field "FieldType.int64"`)))
 	}
 	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := ftc.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
 		}
 	}
 	if _, ok := ftc.mutation.Dir(); !ok {
-		return &ValidationError{Name: "dir", err: errors.New(`ent: missing required field "FieldType.dir"`)}
+		errs = append(errs, newValidationError("dir", "Required", errors.New(`ent: missing required field "FieldType.dir"`)))
 	}
 	if v, ok := ftc.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
 		}
 	}
 	if _, ok := ftc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "FieldType.role"`)}
+		errs = append(errs, newValidationError("role", "Required", errors.New(`ent: missing required field "FieldType.role"`)))
 	}
 	if v, ok := ftc.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
 		}
 	}
 	if v, ok := ftc.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
 		}
 	}
 	if _, ok := ftc.mutation.Pair(); !ok {
-		return &ValidationError{Name: "pair", err: errors.New(`ent: missing required field "FieldType.pair"`)}
+		errs = append(errs, newValidationError("pair", "Required", errors.New(`ent: missing required field "FieldType.pair"`)))
 	}
 	if _, ok := ftc.mutation.Vstring(); !ok {
-		return &ValidationError{Name: "vstring", err: errors.New(`ent: missing required field "FieldType.vstring"`)}
+		errs = append(errs, newValidationError("vstring", "Required", errors.New(`ent: missing required field "FieldType.vstring"`)))
 	}
 	if _, ok := ftc.mutation.Triple(); !ok {
-		return &ValidationError{Name: "triple", err: errors.New(`ent: missing required field "FieldType.triple"`)}
+		errs = append(errs, newValidationError("triple", "Required", errors.New(`ent: missing required field "FieldType.triple"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FieldTypeCreate) sqlSave(ctx context.Context) (*FieldType, error) {
@@ -1423,6 +1457,22 @@ func (ftc *FieldTypeCreate) createSpec() (*FieldType, *sqlgraph.CreateSpec) {
 		})
 		_node.NullFloat = value
 	}
+	if value, ok := ftc.mutation.Amount(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldAmount,
+		})
+		_node.Amount = value
+	}
+	if value, ok := ftc.mutation.Balance(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldBalance,
+		})
+		_node.Balance = &value
+	}
 	if value, ok := ftc.mutation.Role(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
 			Type:   field.TypeEnum,
@@ -1897,6 +1947,11 @@ func (u *FieldTypeUpsert) ClearNillableInt64() *FieldTypeUpsert {
 
 // SetValidateOptionalInt32 sets the "validate_optional_int32" field.
 func (u *FieldTypeUpsert) SetValidateOptionalInt32(v int32) *FieldTypeUpsert {
+	if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
+		return u.addError(fieldtype.FieldValidateOptionalInt32, errs.err())
+	}
 	u.Set(fieldtype.FieldValidateOptionalInt32, v)
 	return u
 }
@@ -2041,6 +2096,11 @@ func (u *FieldTypeUpsert) ClearOptionalUint64() *FieldTypeUpsert {
 
 // SetState sets the "state" field.
 func (u *FieldTypeUpsert) SetState(v fieldtype.State) *FieldTypeUpsert {
+	if err := fieldtype.StateValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
+		return u.addError(fieldtype.FieldState, errs.err())
+	}
 	u.Set(fieldtype.FieldState, v)
 	return u
 }
@@ -2203,6 +2263,11 @@ func (u *FieldTypeUpsert) ClearLinkOtherFunc() *FieldTypeUpsert {
 
 // SetMAC sets the "mac" field.
 func (u *FieldTypeUpsert) SetMAC(v schema.MAC) *FieldTypeUpsert {
+	if err := fieldtype.MACValidator(v.String()); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
+		return u.addError(fieldtype.FieldMAC, errs.err())
+	}
 	u.Set(fieldtype.FieldMAC, v)
 	return u
 }
@@ -2311,6 +2376,11 @@ func (u *FieldTypeUpsert) UpdateDir() *FieldTypeUpsert {
 
 // SetNdir sets the "ndir" field.
 func (u *FieldTypeUpsert) SetNdir(v http.Dir) *FieldTypeUpsert {
+	if err := fieldtype.NdirValidator(string(v)); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
+		return u.addError(fieldtype.FieldNdir, errs.err())
+	}
 	u.Set(fieldtype.FieldNdir, v)
 	return u
 }
@@ -2365,6 +2435,11 @@ func (u *FieldTypeUpsert) ClearNullStr() *FieldTypeUpsert {
 
 // SetLink sets the "link" field.
 func (u *FieldTypeUpsert) SetLink(v schema.Link) *FieldTypeUpsert {
+	if err := fieldtype.LinkValidator(v.String()); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
+		return u.addError(fieldtype.FieldLink, errs.err())
+	}
 	u.Set(fieldtype.FieldLink, v)
 	return u
 }
@@ -2473,6 +2548,11 @@ func (u *FieldTypeUpsert) ClearDeletedAt() *FieldTypeUpsert {
 
 // SetRawData sets the "raw_data" field.
 func (u *FieldTypeUpsert) SetRawData(v []byte) *FieldTypeUpsert {
+	if err := fieldtype.RawDataValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
+		return u.addError(fieldtype.FieldRawData, errs.err())
+	}
 	u.Set(fieldtype.FieldRawData, v)
 	return u
 }
@@ -2509,6 +2589,11 @@ func (u *FieldTypeUpsert) ClearSensitive() *FieldTypeUpsert {
 
 // SetIP sets the "ip" field.
 func (u *FieldTypeUpsert) SetIP(v net.IP) *FieldTypeUpsert {
+	if err := fieldtype.IPValidator([]byte(v)); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
+		return u.addError(fieldtype.FieldIP, errs.err())
+	}
 	u.Set(fieldtype.FieldIP, v)
 	return u
 }
@@ -2681,8 +2766,55 @@ func (u *FieldTypeUpsert) ClearNullFloat() *FieldTypeUpsert {
 	return u
 }
 
+// SetAmount sets the "amount" field.
+func (u *FieldTypeUpsert) SetAmount(v string) *FieldTypeUpsert {
+	u.Set(fieldtype.FieldAmount, v)
+	return u
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *FieldTypeUpsert) UpdateAmount() *FieldTypeUpsert {
+	u.SetExcluded(fieldtype.FieldAmount)
+	return u
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *FieldTypeUpsert) ClearAmount() *FieldTypeUpsert {
+	u.SetNull(fieldtype.FieldAmount)
+	return u
+}
+
+// SetBalance sets the "balance" field.
+func (u *FieldTypeUpsert) SetBalance(v field.Rat) *FieldTypeUpsert {
+	u.Set(fieldtype.FieldBalance, v)
+	return u
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *FieldTypeUpsert) UpdateBalance() *FieldTypeUpsert {
+	u.SetExcluded(fieldtype.FieldBalance)
+	return u
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *FieldTypeUpsert) AddBalance(v field.Rat) *FieldTypeUpsert {
+	u.Add(fieldtype.FieldBalance, v)
+	return u
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *FieldTypeUpsert) ClearBalance() *FieldTypeUpsert {
+	u.SetNull(fieldtype.FieldBalance)
+	return u
+}
+
 // SetRole sets the "role" field.
 func (u *FieldTypeUpsert) SetRole(v role.Role) *FieldTypeUpsert {
+	if err := fieldtype.RoleValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
+		return u.addError(fieldtype.FieldRole, errs.err())
+	}
 	u.Set(fieldtype.FieldRole, v)
 	return u
 }
@@ -2695,6 +2827,11 @@ func (u *FieldTypeUpsert) UpdateRole() *FieldTypeUpsert {
 
 // SetPriority sets the "priority" field.
 func (u *FieldTypeUpsert) SetPriority(v role.Priority) *FieldTypeUpsert {
+	if err := fieldtype.PriorityValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
+		return u.addError(fieldtype.FieldPriority, errs.err())
+	}
 	u.Set(fieldtype.FieldPriority, v)
 	return u
 }
@@ -2861,6 +2998,15 @@ func (u *FieldTypeUpsert) ClearPasswordOther() *FieldTypeUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *FieldTypeUpsert) addError(column string, err error) *FieldTypeUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -4205,6 +4351,55 @@ func (u *FieldTypeUpsertOne) ClearNullFloat() *FieldTypeUpsertOne {
 	})
 }
 
+// SetAmount sets the "amount" field.
+func (u *FieldTypeUpsertOne) SetAmount(v string) *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *FieldTypeUpsertOne) UpdateAmount() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *FieldTypeUpsertOne) ClearAmount() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *FieldTypeUpsertOne) SetBalance(v field.Rat) *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *FieldTypeUpsertOne) AddBalance(v field.Rat) *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *FieldTypeUpsertOne) UpdateBalance() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *FieldTypeUpsertOne) ClearBalance() *FieldTypeUpsertOne {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.ClearBalance()
+	})
+}
+
 // SetRole sets the "role" field.
 func (u *FieldTypeUpsertOne) SetRole(v role.Role) *FieldTypeUpsertOne {
 	return u.Update(func(s *FieldTypeUpsert) {
@@ -4460,6 +4655,9 @@ func (ftcb *FieldTypeCreateBulk) Save(ctx context.Context) ([]*FieldType, error)
 	specs := make([]*sqlgraph.CreateSpec, len(ftcb.builders))
 	nodes := make([]*FieldType, len(ftcb.builders))
 	mutators := make([]Mutator, len(ftcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ftcb.builders {
 		func(i int, root context.Context) {
 			builder := ftcb.builders[i]
@@ -4470,13 +4668,20 @@ func (ftcb *FieldTypeCreateBulk) Save(ctx context.Context) ([]*FieldType, error)
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ftcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = ftcb.conflict
@@ -5921,6 +6126,55 @@ func (u *FieldTypeUpsertBulk) ClearNullFloat() *FieldTypeUpsertBulk {
 	})
 }
 
+// SetAmount sets the "amount" field.
+func (u *FieldTypeUpsertBulk) SetAmount(v string) *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.SetAmount(v)
+	})
+}
+
+// UpdateAmount sets the "amount" field to the value that was provided on create.
+func (u *FieldTypeUpsertBulk) UpdateAmount() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.UpdateAmount()
+	})
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (u *FieldTypeUpsertBulk) ClearAmount() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.ClearAmount()
+	})
+}
+
+// SetBalance sets the "balance" field.
+func (u *FieldTypeUpsertBulk) SetBalance(v field.Rat) *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.SetBalance(v)
+	})
+}
+
+// AddBalance adds v to the "balance" field.
+func (u *FieldTypeUpsertBulk) AddBalance(v field.Rat) *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.AddBalance(v)
+	})
+}
+
+// UpdateBalance sets the "balance" field to the value that was provided on create.
+func (u *FieldTypeUpsertBulk) UpdateBalance() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.UpdateBalance()
+	})
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (u *FieldTypeUpsertBulk) ClearBalance() *FieldTypeUpsertBulk {
+	return u.Update(func(s *FieldTypeUpsert) {
+		s.ClearBalance()
+	})
+}
+
 // SetRole sets the "role" field.
 func (u *FieldTypeUpsertBulk) SetRole(v role.Role) *FieldTypeUpsertBulk {
 	return u.Update(func(s *FieldTypeUpsert) {
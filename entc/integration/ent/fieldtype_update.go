@@ -16,6 +16,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/ent/fieldtype"
 	"entgo.io/ent/entc/integration/ent/predicate"
 	"entgo.io/ent/entc/integration/ent/role"
@@ -1147,6 +1148,53 @@ func (ftu *FieldTypeUpdate) ClearNullFloat() *FieldTypeUpdate {
 	return ftu
 }
 
+// SetAmount sets the "amount" field.
+func (ftu *FieldTypeUpdate) SetAmount(s string) *FieldTypeUpdate {
+	ftu.mutation.SetAmount(s)
+	return ftu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableAmount(s *string) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetAmount(*s)
+	}
+	return ftu
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ftu *FieldTypeUpdate) ClearAmount() *FieldTypeUpdate {
+	ftu.mutation.ClearAmount()
+	return ftu
+}
+
+// SetBalance sets the "balance" field.
+func (ftu *FieldTypeUpdate) SetBalance(f field.Rat) *FieldTypeUpdate {
+	ftu.mutation.ResetBalance()
+	ftu.mutation.SetBalance(f)
+	return ftu
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableBalance(f *field.Rat) *FieldTypeUpdate {
+	if f != nil {
+		ftu.SetBalance(*f)
+	}
+	return ftu
+}
+
+// AddBalance adds f to the "balance" field.
+func (ftu *FieldTypeUpdate) AddBalance(f field.Rat) *FieldTypeUpdate {
+	ftu.mutation.AddBalance(f)
+	return ftu
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (ftu *FieldTypeUpdate) ClearBalance() *FieldTypeUpdate {
+	ftu.mutation.ClearBalance()
+	return ftu
+}
+
 // SetRole sets the "role" field.
 func (ftu *FieldTypeUpdate) SetRole(r role.Role) *FieldTypeUpdate {
 	ftu.mutation.SetRole(r)
@@ -1211,6 +1259,18 @@ func (ftu *FieldTypeUpdate) SetStrings(s []string) *FieldTypeUpdate {
 	return ftu
 }
 
+// AppendStrings appends s to the "strings" field.
+func (ftu *FieldTypeUpdate) AppendStrings(s []string) *FieldTypeUpdate {
+	ftu.mutation.AppendStrings(s)
+	return ftu
+}
+
+// RemoveStrings removes all occurrences of s from the "strings" field.
+func (ftu *FieldTypeUpdate) RemoveStrings(s []string) *FieldTypeUpdate {
+	ftu.mutation.RemoveStrings(s)
+	return ftu
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (ftu *FieldTypeUpdate) ClearStrings() *FieldTypeUpdate {
 	ftu.mutation.ClearStrings()
@@ -1397,53 +1457,55 @@ func (ftu *FieldTypeUpdate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftu *FieldTypeUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := ftu.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
 		}
 	}
 	if v, ok := ftu.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -2340,6 +2402,39 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldNullFloat,
 		})
 	}
+	if value, ok := ftu.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldAmount,
+		})
+	}
+	if ftu.mutation.AmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Column: fieldtype.FieldAmount,
+		})
+	}
+	if value, ok := ftu.mutation.Balance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldBalance,
+		})
+	}
+	if value, ok := ftu.mutation.AddedBalance(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldBalance,
+		})
+	}
+	if ftu.mutation.BalanceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Column: fieldtype.FieldBalance,
+		})
+	}
 	if value, ok := ftu.mutation.Role(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeEnum,
@@ -2393,6 +2488,19 @@ func (ftu *FieldTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: fieldtype.FieldStrings,
 		})
 	}
+	if value, ok := ftu.mutation.RemovedStrings(); ok {
+		if _, ok := ftu.mutation.AppendedStrings(); ok {
+			return 0, &ValidationError{Name: "strings", err: errors.New(`ent: cannot append to and remove from "FieldType.strings" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, fieldtype.FieldStrings, value)
+		})
+	}
+	if value, ok := ftu.mutation.AppendedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fieldtype.FieldStrings, value)
+		})
+	}
 	if ftu.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -3595,6 +3703,53 @@ func (ftuo *FieldTypeUpdateOne) ClearNullFloat() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetAmount sets the "amount" field.
+func (ftuo *FieldTypeUpdateOne) SetAmount(s string) *FieldTypeUpdateOne {
+	ftuo.mutation.SetAmount(s)
+	return ftuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableAmount(s *string) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetAmount(*s)
+	}
+	return ftuo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ftuo *FieldTypeUpdateOne) ClearAmount() *FieldTypeUpdateOne {
+	ftuo.mutation.ClearAmount()
+	return ftuo
+}
+
+// SetBalance sets the "balance" field.
+func (ftuo *FieldTypeUpdateOne) SetBalance(f field.Rat) *FieldTypeUpdateOne {
+	ftuo.mutation.ResetBalance()
+	ftuo.mutation.SetBalance(f)
+	return ftuo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableBalance(f *field.Rat) *FieldTypeUpdateOne {
+	if f != nil {
+		ftuo.SetBalance(*f)
+	}
+	return ftuo
+}
+
+// AddBalance adds f to the "balance" field.
+func (ftuo *FieldTypeUpdateOne) AddBalance(f field.Rat) *FieldTypeUpdateOne {
+	ftuo.mutation.AddBalance(f)
+	return ftuo
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (ftuo *FieldTypeUpdateOne) ClearBalance() *FieldTypeUpdateOne {
+	ftuo.mutation.ClearBalance()
+	return ftuo
+}
+
 // SetRole sets the "role" field.
 func (ftuo *FieldTypeUpdateOne) SetRole(r role.Role) *FieldTypeUpdateOne {
 	ftuo.mutation.SetRole(r)
@@ -3659,6 +3814,18 @@ func (ftuo *FieldTypeUpdateOne) SetStrings(s []string) *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// AppendStrings appends s to the "strings" field.
+func (ftuo *FieldTypeUpdateOne) AppendStrings(s []string) *FieldTypeUpdateOne {
+	ftuo.mutation.AppendStrings(s)
+	return ftuo
+}
+
+// RemoveStrings removes all occurrences of s from the "strings" field.
+func (ftuo *FieldTypeUpdateOne) RemoveStrings(s []string) *FieldTypeUpdateOne {
+	ftuo.mutation.RemoveStrings(s)
+	return ftuo
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (ftuo *FieldTypeUpdateOne) ClearStrings() *FieldTypeUpdateOne {
 	ftuo.mutation.ClearStrings()
@@ -3852,53 +4019,55 @@ func (ftuo *FieldTypeUpdateOne) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftuo *FieldTypeUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := ftuo.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
 		}
 	}
 	if v, ok := ftuo.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType, err error) {
@@ -4812,6 +4981,39 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldNullFloat,
 		})
 	}
+	if value, ok := ftuo.mutation.Amount(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldAmount,
+		})
+	}
+	if ftuo.mutation.AmountCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Column: fieldtype.FieldAmount,
+		})
+	}
+	if value, ok := ftuo.mutation.Balance(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldBalance,
+		})
+	}
+	if value, ok := ftuo.mutation.AddedBalance(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Value:  value,
+			Column: fieldtype.FieldBalance,
+		})
+	}
+	if ftuo.mutation.BalanceCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeDecimal,
+			Column: fieldtype.FieldBalance,
+		})
+	}
 	if value, ok := ftuo.mutation.Role(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeEnum,
@@ -4865,6 +5067,19 @@ func (ftuo *FieldTypeUpdateOne) sqlSave(ctx context.Context) (_node *FieldType,
 			Column: fieldtype.FieldStrings,
 		})
 	}
+	if value, ok := ftuo.mutation.RemovedStrings(); ok {
+		if _, ok := ftuo.mutation.AppendedStrings(); ok {
+			return nil, &ValidationError{Name: "strings", err: errors.New(`ent: cannot append to and remove from "FieldType.strings" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, fieldtype.FieldStrings, value)
+		})
+	}
+	if value, ok := ftuo.mutation.AppendedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, fieldtype.FieldStrings, value)
+		})
+	}
 	if ftuo.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
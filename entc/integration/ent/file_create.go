@@ -221,19 +221,21 @@ func (fc *FileCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (fc *FileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Size(); !ok {
-		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
+		errs = append(errs, newValidationError("size", "Required", errors.New(`ent: missing required field "File.size"`)))
 	}
 	if v, ok := fc.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, validatorError("size", fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)))
 		}
 	}
 	if _, ok := fc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "File.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "File.name"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
@@ -416,6 +418,11 @@ type (
 
 // SetSize sets the "size" field.
 func (u *FileUpsert) SetSize(v int) *FileUpsert {
+	if err := file.SizeValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("size", fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)))
+		return u.addError(file.FieldSize, errs.err())
+	}
 	u.Set(file.FieldSize, v)
 	return u
 }
@@ -498,6 +505,15 @@ func (u *FileUpsert) ClearOp() *FileUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *FileUpsert) addError(column string, err error) *FileUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -683,6 +699,9 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
 	nodes := make([]*File, len(fcb.builders))
 	mutators := make([]Mutator, len(fcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range fcb.builders {
 		func(i int, root context.Context) {
 			builder := fcb.builders[i]
@@ -693,13 +712,20 @@ func (fcb *FileCreateBulk) Save(ctx context.Context) ([]*File, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = fcb.conflict
@@ -157,27 +157,29 @@ func (ftc *FileTypeCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftc *FileTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FileType.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "FileType.name"`)))
 	}
 	if _, ok := ftc.mutation.GetType(); !ok {
-		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FileType.type"`)}
+		errs = append(errs, newValidationError("type", "Required", errors.New(`ent: missing required field "FileType.type"`)))
 	}
 	if v, ok := ftc.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, newValidationError("type", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err), "png", "svg", "jpg"))
 		}
 	}
 	if _, ok := ftc.mutation.State(); !ok {
-		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "FileType.state"`)}
+		errs = append(errs, newValidationError("state", "Required", errors.New(`ent: missing required field "FileType.state"`)))
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err), "ON", "OFF"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FileTypeCreate) sqlSave(ctx context.Context) (*FileType, error) {
@@ -316,6 +318,11 @@ func (u *FileTypeUpsert) UpdateName() *FileTypeUpsert {
 
 // SetType sets the "type" field.
 func (u *FileTypeUpsert) SetType(v filetype.Type) *FileTypeUpsert {
+	if err := filetype.TypeValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("type", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err), "png", "svg", "jpg"))
+		return u.addError(filetype.FieldType, errs.err())
+	}
 	u.Set(filetype.FieldType, v)
 	return u
 }
@@ -328,6 +335,11 @@ func (u *FileTypeUpsert) UpdateType() *FileTypeUpsert {
 
 // SetState sets the "state" field.
 func (u *FileTypeUpsert) SetState(v filetype.State) *FileTypeUpsert {
+	if err := filetype.StateValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err), "ON", "OFF"))
+		return u.addError(filetype.FieldState, errs.err())
+	}
 	u.Set(filetype.FieldState, v)
 	return u
 }
@@ -338,6 +350,15 @@ func (u *FileTypeUpsert) UpdateState() *FileTypeUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *FileTypeUpsert) addError(column string, err error) *FileTypeUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -467,6 +488,9 @@ func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ftcb.builders))
 	nodes := make([]*FileType, len(ftcb.builders))
 	mutators := make([]Mutator, len(ftcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ftcb.builders {
 		func(i int, root context.Context) {
 			builder := ftcb.builders[i]
@@ -477,13 +501,20 @@ func (ftcb *FileTypeCreateBulk) Save(ctx context.Context) ([]*FileType, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ftcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = ftcb.conflict
@@ -168,18 +168,20 @@ func (ftu *FileTypeUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftu *FileTypeUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := ftu.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, newValidationError("type", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err), "png", "svg", "jpg"))
 		}
 	}
 	if v, ok := ftu.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err), "ON", "OFF"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftu *FileTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -437,18 +439,20 @@ func (ftuo *FileTypeUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftuo *FileTypeUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := ftuo.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, newValidationError("type", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err), "png", "svg", "jpg"))
 		}
 	}
 	if v, ok := ftuo.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err), "ON", "OFF"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftuo *FileTypeUpdateOne) sqlSave(ctx context.Context) (_node *FileType, err error) {
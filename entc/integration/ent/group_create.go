@@ -221,35 +221,37 @@ func (gc *GroupCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gc *GroupCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gc.mutation.Active(); !ok {
-		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Group.active"`)}
+		errs = append(errs, newValidationError("active", "Required", errors.New(`ent: missing required field "Group.active"`)))
 	}
 	if _, ok := gc.mutation.Expire(); !ok {
-		return &ValidationError{Name: "expire", err: errors.New(`ent: missing required field "Group.expire"`)}
+		errs = append(errs, newValidationError("expire", "Required", errors.New(`ent: missing required field "Group.expire"`)))
 	}
 	if v, ok := gc.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, validatorError("type", fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)))
 		}
 	}
 	if v, ok := gc.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, validatorError("max_users", fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)))
 		}
 	}
 	if _, ok := gc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Group.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "Group.name"`)))
 	}
 	if v, ok := gc.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)))
 		}
 	}
 	if _, ok := gc.mutation.InfoID(); !ok {
-		return &ValidationError{Name: "info", err: errors.New(`ent: missing required edge "Group.info"`)}
+		errs = append(errs, newValidationError("info", "Required", errors.New(`ent: missing required edge "Group.info"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (gc *GroupCreate) sqlSave(ctx context.Context) (*Group, error) {
@@ -474,6 +476,11 @@ func (u *GroupUpsert) UpdateExpire() *GroupUpsert {
 
 // SetType sets the "type" field.
 func (u *GroupUpsert) SetType(v string) *GroupUpsert {
+	if err := group.TypeValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("type", fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)))
+		return u.addError(group.FieldType, errs.err())
+	}
 	u.Set(group.FieldType, v)
 	return u
 }
@@ -492,6 +499,11 @@ func (u *GroupUpsert) ClearType() *GroupUpsert {
 
 // SetMaxUsers sets the "max_users" field.
 func (u *GroupUpsert) SetMaxUsers(v int) *GroupUpsert {
+	if err := group.MaxUsersValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("max_users", fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)))
+		return u.addError(group.FieldMaxUsers, errs.err())
+	}
 	u.Set(group.FieldMaxUsers, v)
 	return u
 }
@@ -516,6 +528,11 @@ func (u *GroupUpsert) ClearMaxUsers() *GroupUpsert {
 
 // SetName sets the "name" field.
 func (u *GroupUpsert) SetName(v string) *GroupUpsert {
+	if err := group.NameValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)))
+		return u.addError(group.FieldName, errs.err())
+	}
 	u.Set(group.FieldName, v)
 	return u
 }
@@ -526,6 +543,15 @@ func (u *GroupUpsert) UpdateName() *GroupUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *GroupUpsert) addError(column string, err error) *GroupUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -704,6 +730,9 @@ func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(gcb.builders))
 	nodes := make([]*Group, len(gcb.builders))
 	mutators := make([]Mutator, len(gcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range gcb.builders {
 		func(i int, root context.Context) {
 			builder := gcb.builders[i]
@@ -714,13 +743,20 @@ func (gcb *GroupCreateBulk) Save(ctx context.Context) ([]*Group, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, gcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = gcb.conflict
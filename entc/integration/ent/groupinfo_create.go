@@ -139,14 +139,16 @@ func (gic *GroupInfoCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gic *GroupInfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gic.mutation.Desc(); !ok {
-		return &ValidationError{Name: "desc", err: errors.New(`ent: missing required field "GroupInfo.desc"`)}
+		errs = append(errs, newValidationError("desc", "Required", errors.New(`ent: missing required field "GroupInfo.desc"`)))
 	}
 	if _, ok := gic.mutation.MaxUsers(); !ok {
-		return &ValidationError{Name: "max_users", err: errors.New(`ent: missing required field "GroupInfo.max_users"`)}
+		errs = append(errs, newValidationError("max_users", "Required", errors.New(`ent: missing required field "GroupInfo.max_users"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (gic *GroupInfoCreate) sqlSave(ctx context.Context) (*GroupInfo, error) {
@@ -415,6 +417,9 @@ func (gicb *GroupInfoCreateBulk) Save(ctx context.Context) ([]*GroupInfo, error)
 	specs := make([]*sqlgraph.CreateSpec, len(gicb.builders))
 	nodes := make([]*GroupInfo, len(gicb.builders))
 	mutators := make([]Mutator, len(gicb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range gicb.builders {
 		func(i int, root context.Context) {
 			builder := gicb.builders[i]
@@ -425,13 +430,20 @@ func (gicb *GroupInfoCreateBulk) Save(ctx context.Context) ([]*GroupInfo, error)
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, gicb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = gicb.conflict
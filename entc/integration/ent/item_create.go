@@ -132,18 +132,20 @@ func (ic *ItemCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ic *ItemCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := ic.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, validatorError("text", fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)))
 		}
 	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ic *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
@@ -244,6 +246,11 @@ type (
 
 // SetText sets the "text" field.
 func (u *ItemUpsert) SetText(v string) *ItemUpsert {
+	if err := item.TextValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("text", fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)))
+		return u.addError(item.FieldText, errs.err())
+	}
 	u.Set(item.FieldText, v)
 	return u
 }
@@ -260,6 +267,15 @@ func (u *ItemUpsert) ClearText() *ItemUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *ItemUpsert) addError(column string, err error) *ItemUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -381,6 +397,9 @@ func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
 	nodes := make([]*Item, len(icb.builders))
 	mutators := make([]Mutator, len(icb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range icb.builders {
 		func(i int, root context.Context) {
 			builder := icb.builders[i]
@@ -391,13 +410,20 @@ func (icb *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = icb.conflict
@@ -125,16 +125,20 @@ func init() {
 	fieldtype.DefaultIP = fieldtypeDescIP.Default.(func() net.IP)
 	// fieldtype.IPValidator is a validator for the "ip" field. It is called by the builders before save.
 	fieldtype.IPValidator = fieldtypeDescIP.Validators[0].(func([]byte) error)
+	// fieldtypeDescAmount is the schema descriptor for amount field.
+	fieldtypeDescAmount := fieldtypeFields[54].Descriptor()
+	// fieldtype.DefaultAmount holds the default value on creation for the amount field.
+	fieldtype.DefaultAmount = fieldtypeDescAmount.Default.(string)
 	// fieldtypeDescPair is the schema descriptor for pair field.
-	fieldtypeDescPair := fieldtypeFields[59].Descriptor()
+	fieldtypeDescPair := fieldtypeFields[61].Descriptor()
 	// fieldtype.DefaultPair holds the default value on creation for the pair field.
 	fieldtype.DefaultPair = fieldtypeDescPair.Default.(func() schema.Pair)
 	// fieldtypeDescVstring is the schema descriptor for vstring field.
-	fieldtypeDescVstring := fieldtypeFields[61].Descriptor()
+	fieldtypeDescVstring := fieldtypeFields[63].Descriptor()
 	// fieldtype.DefaultVstring holds the default value on creation for the vstring field.
 	fieldtype.DefaultVstring = fieldtypeDescVstring.Default.(func() schema.VString)
 	// fieldtypeDescTriple is the schema descriptor for triple field.
-	fieldtypeDescTriple := fieldtypeFields[62].Descriptor()
+	fieldtypeDescTriple := fieldtypeFields[64].Descriptor()
 	// fieldtype.DefaultTriple holds the default value on creation for the triple field.
 	fieldtype.DefaultTriple = fieldtypeDescTriple.Default.(func() schema.Triple)
 	fileFields := schema.File{}.Fields()
@@ -249,6 +249,15 @@ func (FieldType) Fields() []ent.Field { //nolint:funlen
 		field.Float("null_float").
 			Optional().
 			GoType(&sql.NullFloat64{}),
+		field.Decimal("amount").
+			Optional().
+			Precision(10).
+			Scale(2).
+			Default("0.00"),
+		field.Decimal("balance").
+			Optional().
+			Nillable().
+			GoType(field.Rat{}),
 		field.Enum("role").
 			Default(string(role.Read)).
 			GoType(role.Role("role")),
@@ -110,8 +110,10 @@ func (sc *SpecCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (sc *SpecCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (sc *SpecCreate) sqlSave(ctx context.Context) (*Spec, error) {
@@ -293,6 +295,9 @@ func (scb *SpecCreateBulk) Save(ctx context.Context) ([]*Spec, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
 	nodes := make([]*Spec, len(scb.builders))
 	mutators := make([]Mutator, len(scb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
@@ -302,13 +307,20 @@ func (scb *SpecCreateBulk) Save(ctx context.Context) ([]*Spec, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = scb.conflict
@@ -118,16 +118,18 @@ func (tc *TaskCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tc *TaskCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Priority(); !ok {
-		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Task.priority"`)}
+		errs = append(errs, newValidationError("priority", "Required", errors.New(`ent: missing required field "Task.priority"`)))
 	}
 	if v, ok := tc.mutation.Priority(); ok {
 		if err := task.PriorityValidator(int(v)); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, validatorError("priority", fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TaskCreate) sqlSave(ctx context.Context) (*Task, error) {
@@ -219,6 +221,11 @@ type (
 
 // SetPriority sets the "priority" field.
 func (u *TaskUpsert) SetPriority(v schema.Priority) *TaskUpsert {
+	if err := task.PriorityValidator(int(v)); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("priority", fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)))
+		return u.addError(task.FieldPriority, errs.err())
+	}
 	u.Set(task.FieldPriority, v)
 	return u
 }
@@ -235,6 +242,15 @@ func (u *TaskUpsert) AddPriority(v schema.Priority) *TaskUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *TaskUpsert) addError(column string, err error) *TaskUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -343,6 +359,9 @@ func (tcb *TaskCreateBulk) Save(ctx context.Context) ([]*Task, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
 	nodes := make([]*Task, len(tcb.builders))
 	mutators := make([]Mutator, len(tcb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range tcb.builders {
 		func(i int, root context.Context) {
 			builder := tcb.builders[i]
@@ -353,13 +372,20 @@ func (tcb *TaskCreateBulk) Save(ctx context.Context) ([]*Task, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = tcb.conflict
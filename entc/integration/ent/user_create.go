@@ -438,38 +438,40 @@ func (uc *UserCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := uc.mutation.OptionalInt(); ok {
 		if err := user.OptionalIntValidator(v); err != nil {
-			return &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+			errs = append(errs, validatorError("optional_int", fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)))
 		}
 	}
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "User.age"`)}
+		errs = append(errs, newValidationError("age", "Required", errors.New(`ent: missing required field "User.age"`)))
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "User.name"`)))
 	}
 	if _, ok := uc.mutation.Last(); !ok {
-		return &ValidationError{Name: "last", err: errors.New(`ent: missing required field "User.last"`)}
+		errs = append(errs, newValidationError("last", "Required", errors.New(`ent: missing required field "User.last"`)))
 	}
 	if _, ok := uc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+		errs = append(errs, newValidationError("role", "Required", errors.New(`ent: missing required field "User.role"`)))
 	}
 	if v, ok := uc.mutation.Role(); ok {
 		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "User.role": %w`, err), "user", "admin", "free-user", "test user"))
 		}
 	}
 	if _, ok := uc.mutation.Employment(); !ok {
-		return &ValidationError{Name: "employment", err: errors.New(`ent: missing required field "User.employment"`)}
+		errs = append(errs, newValidationError("employment", "Required", errors.New(`ent: missing required field "User.employment"`)))
 	}
 	if v, ok := uc.mutation.Employment(); ok {
 		if err := user.EmploymentValidator(v); err != nil {
-			return &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+			errs = append(errs, newValidationError("employment", "Enum", fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err), "Full-Time", "Part-Time", "Contract"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -852,6 +854,11 @@ type (
 
 // SetOptionalInt sets the "optional_int" field.
 func (u *UserUpsert) SetOptionalInt(v int) *UserUpsert {
+	if err := user.OptionalIntValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, validatorError("optional_int", fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)))
+		return u.addError(user.FieldOptionalInt, errs.err())
+	}
 	u.Set(user.FieldOptionalInt, v)
 	return u
 }
@@ -990,6 +997,11 @@ func (u *UserUpsert) ClearPassword() *UserUpsert {
 
 // SetRole sets the "role" field.
 func (u *UserUpsert) SetRole(v user.Role) *UserUpsert {
+	if err := user.RoleValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "User.role": %w`, err), "user", "admin", "free-user", "test user"))
+		return u.addError(user.FieldRole, errs.err())
+	}
 	u.Set(user.FieldRole, v)
 	return u
 }
@@ -1002,6 +1014,11 @@ func (u *UserUpsert) UpdateRole() *UserUpsert {
 
 // SetEmployment sets the "employment" field.
 func (u *UserUpsert) SetEmployment(v user.Employment) *UserUpsert {
+	if err := user.EmploymentValidator(v); err != nil {
+		var errs ValidationErrors
+		errs = append(errs, newValidationError("employment", "Enum", fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err), "Full-Time", "Part-Time", "Contract"))
+		return u.addError(user.FieldEmployment, errs.err())
+	}
 	u.Set(user.FieldEmployment, v)
 	return u
 }
@@ -1030,6 +1047,15 @@ func (u *UserUpsert) ClearSSOCert() *UserUpsert {
 	return u
 }
 
+// addError sets the column to an expression that fails the INSERT statement with the given
+// error. It is used for reporting validation errors of the values set on conflict.
+func (u *UserUpsert) addError(column string, err error) *UserUpsert {
+	u.Set(column, sql.ExprFunc(func(b *sql.Builder) {
+		b.AddError(err)
+	}))
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create.
 // Using this option is equivalent to using:
 //
@@ -1327,6 +1353,9 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
@@ -1337,13 +1366,20 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					spec.OnConflict = ucb.conflict
@@ -6,10 +6,12 @@ package integration
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/google/uuid"
 
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/ent"
 	"entgo.io/ent/entc/integration/ent/pet"
 	"entgo.io/ent/entc/integration/ent/user"
@@ -94,4 +96,124 @@ func EntQL(t *testing.T, client *ent.Client) {
 	uq = uq.QueryFriends()
 	uq.Filter().WhereName(entql.StringEQ(nati.Name))
 	require.Equal(luna.ID, uq.QueryPets().OnlyIDX(ctx))
+
+	uq = client.User.Query()
+	uq.Filter().Where(entql.EQ(entql.EdgeCount("friends"), &entql.Value{V: 1}))
+	require.Equal(2, uq.CountX(ctx))
+	uq = client.User.Query()
+	uq.Filter().Where(entql.GT(entql.EdgeCount("pets", entql.FieldEQ("name", luna.Name)), &entql.Value{V: 0}))
+	require.Equal(nati.ID, uq.OnlyIDX(ctx))
+	uq = client.User.Query()
+	uq.Filter().Where(
+		entql.And(
+			entql.FieldLike("name", "a_m"),
+			entql.EQ(entql.F("age"), entql.EdgeField("friends", "age")),
+		),
+	)
+	require.Equal(a8m.ID, uq.OnlyIDX(ctx))
+	uq = client.User.Query()
+	uq.Filter().Where(entql.NEQ(entql.F("age"), entql.EdgeField("friends", "age")))
+	require.False(uq.ExistX(ctx))
+
+	allow := sqlgraph.AllowList{
+		"User": {user.FieldName, user.EdgePets},
+		"Pet":  {pet.FieldName},
+	}
+	uq = client.User.Query()
+	err := uq.Filter().WhereExpr(`has_edge(pets, name == "luna") || name == "a8m"`, allow)
+	require.NoError(err)
+	require.Equal(2, uq.CountX(ctx))
+	err = client.User.Query().Filter().WhereExpr(`age > 30`, allow)
+	var perr *entql.ParseError
+	require.True(errors.As(err, &perr))
+	require.Equal(0, perr.Pos)
+
+	uq = client.User.Query()
+	err = uq.ApplyQuery(&entql.Query{
+		Where:  entql.HasEdge("pets"),
+		Order:  []*entql.Order{entql.Desc("name")},
+		Limit:  1,
+		Offset: 1,
+		Select: []string{"name"},
+		With: map[string]*entql.Query{
+			"pets":    {Select: []string{"name"}},
+			"friends": nil,
+		},
+	}, nil)
+	require.NoError(err)
+	users := uq.AllX(ctx)
+	require.Len(users, 1)
+	require.Equal(a8m.ID, users[0].ID)
+	require.Equal(a8m.Name, users[0].Name)
+	require.Zero(users[0].Age, "age should not be selected")
+	require.Len(users[0].Edges.Pets, 1)
+	require.Equal(xabi.Name, users[0].Edges.Pets[0].Name)
+	require.Len(users[0].Edges.Friends, 1)
+	require.Equal(nati.ID, users[0].Edges.Friends[0].ID)
+	err = client.User.Query().ApplyQuery(&entql.Query{With: map[string]*entql.Query{"pets": {Order: []*entql.Order{entql.Asc("uuid")}}}}, allow)
+	require.EqualError(err, `field "uuid" is not allowed for node "Pet"`)
+	err = client.User.Query().ApplyQuery(&entql.Query{With: map[string]*entql.Query{"unknown": nil}}, nil)
+	require.EqualError(err, `edge "unknown" was not found for node "User"`)
+	err = client.User.Query().ApplyQuery(&entql.Query{Where: entql.FieldGT("age", 30)}, allow)
+	require.EqualError(err, `field "age" is not allowed for node "User"`)
+	err = client.User.Query().ApplyQuery(&entql.Query{Where: entql.HasEdge("friends")}, allow)
+	require.EqualError(err, `edge "friends" is not allowed for node "User"`)
+	err = client.User.Query().ApplyQuery(&entql.Query{With: map[string]*entql.Query{"pets": {Where: entql.FieldEQ("uuid", "")}}}, allow)
+	require.EqualError(err, `field "uuid" is not allowed for node "Pet"`)
+}
+
+func Dynamic(t *testing.T, client *ent.Client) {
+	require := require.New(t)
+	ctx := context.Background()
+	d := client.Dynamic()
+	require.Contains(d.Types(), "User")
+
+	v, err := d.Create(ctx, "User", map[string]interface{}{"name": "a8m", "age": 30, "password": "secret"})
+	require.NoError(err)
+	a8m := v.(*ent.User)
+	require.Equal("unknown", a8m.Last, "default value should be applied")
+	_, err = d.Create(ctx, "User", map[string]interface{}{"name": "nati", "age": "30"})
+	require.EqualError(err, "unexpected type string for field age")
+	_, err = d.Create(ctx, "User", map[string]interface{}{"name": "nati"})
+	require.True(ent.IsValidationError(err), "required fields should be validated")
+	_, err = d.Create(ctx, "Unknown", nil)
+	require.EqualError(err, `ent: unknown node type "Unknown"`)
+	v, err = d.Create(ctx, "User", map[string]interface{}{"name": "nati", "age": 28, "nickname": "nati"})
+	require.NoError(err)
+	nati := v.(*ent.User)
+
+	n, err := d.Update(ctx, "User", entql.FieldEQ("name", "nati"), map[string]interface{}{"age": 29, "nickname": nil})
+	require.NoError(err)
+	require.Equal(1, n)
+	v, err = d.UpdateOne(ctx, "User", a8m.ID, map[string]interface{}{"age": 31})
+	require.NoError(err)
+	require.Equal(31, v.(*ent.User).Age)
+	_, err = d.UpdateOne(ctx, "User", "1", nil)
+	require.EqualError(err, "ent: unexpected type string for User id")
+
+	maps, err := d.Query("User").Where(entql.FieldGT("age", 20)).Order(entql.Asc("age")).Select("name").Maps(ctx)
+	require.NoError(err)
+	require.Equal([]map[string]interface{}{{"id": nati.ID, "name": "nati"}, {"id": a8m.ID, "name": "a8m"}}, maps)
+	maps, err = d.Query("User").Where(entql.FieldEQ("name", "nati")).Maps(ctx)
+	require.NoError(err)
+	require.Len(maps, 1)
+	require.Equal(29, maps[0]["age"])
+	require.Empty(maps[0]["nickname"])
+	require.NotContains(maps[0], "password", "sensitive fields should be omitted")
+	_, err = d.Query("User").Select("password").Allow(sqlgraph.AllowList{"User": {"name"}}).All(ctx)
+	require.EqualError(err, `field "password" is not allowed for node "User"`)
+	_, err = d.Query("User").Where(entql.FieldEQ("password", "secret")).Allow(sqlgraph.AllowList{"User": {"name"}}).Count(ctx)
+	require.EqualError(err, `field "password" is not allowed for node "User"`)
+	count, err := d.Query("User").Where(entql.FieldHasPrefix("name", "a")).Count(ctx)
+	require.NoError(err)
+	require.Equal(1, count)
+
+	n, err = d.Delete(ctx, "User", entql.FieldEQ("name", "nati"))
+	require.NoError(err)
+	require.Equal(1, n)
+	_, err = d.Get(ctx, "User", nati.ID)
+	require.True(ent.IsNotFound(err))
+	v, err = d.Get(ctx, "User", a8m.ID)
+	require.NoError(err)
+	require.Equal(a8m.Name, v.(*ent.User).Name)
 }
@@ -0,0 +1,31 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+)
+
+// Post holds the schema definition for the Post entity.
+type Post struct {
+	ent.Schema
+}
+
+// Fields of the Post.
+func (Post) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("title").
+			Annotations(entsql.Annotation{
+				FullText: &entsql.FullText{},
+			}),
+		field.Text("body").
+			Optional().
+			Annotations(entsql.Annotation{
+				FullText: &entsql.FullText{Config: "simple"},
+			}),
+	}
+}
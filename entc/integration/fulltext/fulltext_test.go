@@ -0,0 +1,56 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package fulltext
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/fulltext/ent"
+	"entgo.io/ent/entc/integration/fulltext/ent/post"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// Note that FTS5 is enabled in github.com/mattn/go-sqlite3 by the "sqlite_fts5"
+// build tag. Run this test with: go test -tags sqlite_fts5 ./...
+func TestFullText(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	ctx := context.Background()
+	var enabled bool
+	require.NoError(t, drv.DB().QueryRowContext(ctx, "SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled))
+	if !enabled {
+		t.Skip("FTS5 is not enabled, build with the sqlite_fts5 tag")
+	}
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	p1 := client.Post.Create().SetTitle("Graph traversal in Go").SetBody("ent is an entity framework for Go").SaveX(ctx)
+	p2 := client.Post.Create().SetTitle("Schema migrations").SetBody("graph graph graph").SaveX(ctx)
+	p3 := client.Post.Create().SetTitle("Code generation").SaveX(ctx)
+
+	require.Equal(t, []int{p1.ID}, client.Post.Query().Where(post.TitleMatches("graph")).IDsX(ctx))
+	require.Equal(t, []int{p1.ID}, client.Post.Query().Where(post.BodyMatches("entity")).IDsX(ctx))
+	require.Zero(t, client.Post.Query().Where(post.BodyMatches("generation")).CountX(ctx))
+	require.Equal(t,
+		[]int{p2.ID, p1.ID},
+		client.Post.Query().Where(post.Or(post.BodyMatches("graph"), post.TitleMatches("graph"))).Order(post.ByBodyRelevance("graph")).IDsX(ctx),
+	)
+
+	// The search table is kept in sync with the content table.
+	p3.Update().SetTitle("Graph code generation").ExecX(ctx)
+	require.Equal(t, []int{p1.ID, p3.ID}, client.Post.Query().Where(post.TitleMatches("graph")).Order(ent.Asc(post.FieldID)).IDsX(ctx))
+	client.Post.DeleteOne(p1).ExecX(ctx)
+	require.Equal(t, []int{p3.ID}, client.Post.Query().Where(post.TitleMatches("graph")).IDsX(ctx))
+
+	// Migrating again does not recreate the search tables.
+	require.NoError(t, client.Schema.Create(ctx))
+	require.Equal(t, []int{p3.ID}, client.Post.Query().Where(post.TitleMatches("graph")).IDsX(ctx))
+}
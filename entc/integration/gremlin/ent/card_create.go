@@ -211,30 +211,32 @@ func (cc *CardCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cc *CardCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.CreateTime(); !ok {
-		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Card.create_time"`)}
+		errs = append(errs, newValidationError("create_time", "Required", errors.New(`ent: missing required field "Card.create_time"`)))
 	}
 	if _, ok := cc.mutation.UpdateTime(); !ok {
-		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Card.update_time"`)}
+		errs = append(errs, newValidationError("update_time", "Required", errors.New(`ent: missing required field "Card.update_time"`)))
 	}
 	if _, ok := cc.mutation.Balance(); !ok {
-		return &ValidationError{Name: "balance", err: errors.New(`ent: missing required field "Card.balance"`)}
+		errs = append(errs, newValidationError("balance", "Required", errors.New(`ent: missing required field "Card.balance"`)))
 	}
 	if _, ok := cc.mutation.Number(); !ok {
-		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "Card.number"`)}
+		errs = append(errs, newValidationError("number", "Required", errors.New(`ent: missing required field "Card.number"`)))
 	}
 	if v, ok := cc.mutation.Number(); ok {
 		if err := card.NumberValidator(v); err != nil {
-			return &ValidationError{Name: "number", err: fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)}
+			errs = append(errs, validatorError("number", fmt.Errorf(`ent: validator failed for field "Card.number": %w`, err)))
 		}
 	}
 	if v, ok := cc.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CardCreate) gremlinSave(ctx context.Context) (*Card, error) {
@@ -219,13 +219,15 @@ func (cu *CardUpdate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cu *CardUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := cu.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CardUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -506,13 +508,15 @@ func (cuo *CardUpdateOne) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cuo *CardUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := cuo.mutation.Name(); ok {
 		if err := card.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Card.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CardUpdateOne) gremlinSave(ctx context.Context) (*Card, error) {
@@ -121,14 +121,16 @@ func (cc *CommentCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cc *CommentCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := cc.mutation.UniqueInt(); !ok {
-		return &ValidationError{Name: "unique_int", err: errors.New(`ent: missing required field "Comment.unique_int"`)}
+		errs = append(errs, newValidationError("unique_int", "Required", errors.New(`ent: missing required field "Comment.unique_int"`)))
 	}
 	if _, ok := cc.mutation.UniqueFloat(); !ok {
-		return &ValidationError{Name: "unique_float", err: errors.New(`ent: missing required field "Comment.unique_float"`)}
+		errs = append(errs, newValidationError("unique_float", "Required", errors.New(`ent: missing required field "Comment.unique_float"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (cc *CommentCreate) gremlinSave(ctx context.Context) (*Comment, error) {
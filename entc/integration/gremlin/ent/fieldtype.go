@@ -18,6 +18,7 @@ import (
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/entc/integration/gremlin/ent/fieldtype"
+	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
 
@@ -134,6 +135,10 @@ type FieldType struct {
 	SchemaFloat32 schema.Float32 `json:"schema_float32,omitempty"`
 	// NullFloat holds the value of the "null_float" field.
 	NullFloat *sql.NullFloat64 `json:"null_float,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount string `json:"amount,omitempty"`
+	// Balance holds the value of the "balance" field.
+	Balance *field.Rat `json:"balance,omitempty"`
 	// Role holds the value of the "role" field.
 	Role role.Role `json:"role,omitempty"`
 	// Priority holds the value of the "priority" field.
@@ -220,6 +225,8 @@ func (ft *FieldType) FromResponse(res *gremlin.Response) error {
 		SchemaFloat           schema.Float64        `json:"schema_float,omitempty"`
 		SchemaFloat32         schema.Float32        `json:"schema_float32,omitempty"`
 		NullFloat             *sql.NullFloat64      `json:"null_float,omitempty"`
+		Amount                string                `json:"amount,omitempty"`
+		Balance               *field.Rat            `json:"balance,omitempty"`
 		Role                  role.Role             `json:"role,omitempty"`
 		Priority              role.Priority         `json:"priority,omitempty"`
 		UUID                  uuid.UUID             `json:"uuid,omitempty"`
@@ -290,6 +297,8 @@ func (ft *FieldType) FromResponse(res *gremlin.Response) error {
 	ft.SchemaFloat = scanft.SchemaFloat
 	ft.SchemaFloat32 = scanft.SchemaFloat32
 	ft.NullFloat = scanft.NullFloat
+	ft.Amount = scanft.Amount
+	ft.Balance = scanft.Balance
 	ft.Role = scanft.Role
 	ft.Priority = scanft.Priority
 	ft.UUID = scanft.UUID
@@ -455,6 +464,12 @@ func (ft *FieldType) String() string {
 	builder.WriteString(fmt.Sprintf("%v", ft.SchemaFloat32))
 	builder.WriteString(", null_float=")
 	builder.WriteString(fmt.Sprintf("%v", ft.NullFloat))
+	builder.WriteString(", amount=")
+	builder.WriteString(fmt.Sprintf("%v", ft.Amount))
+	if v := ft.Balance; v != nil {
+		builder.WriteString(", balance=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", role=")
 	builder.WriteString(fmt.Sprintf("%v", ft.Role))
 	builder.WriteString(", priority=")
@@ -549,6 +564,8 @@ func (ft *FieldTypes) FromResponse(res *gremlin.Response) error {
 		SchemaFloat           schema.Float64        `json:"schema_float,omitempty"`
 		SchemaFloat32         schema.Float32        `json:"schema_float32,omitempty"`
 		NullFloat             *sql.NullFloat64      `json:"null_float,omitempty"`
+		Amount                string                `json:"amount,omitempty"`
+		Balance               *field.Rat            `json:"balance,omitempty"`
 		Role                  role.Role             `json:"role,omitempty"`
 		Priority              role.Priority         `json:"priority,omitempty"`
 		UUID                  uuid.UUID             `json:"uuid,omitempty"`
@@ -621,6 +638,8 @@ func (ft *FieldTypes) FromResponse(res *gremlin.Response) error {
 			SchemaFloat:           v.SchemaFloat,
 			SchemaFloat32:         v.SchemaFloat32,
 			NullFloat:             v.NullFloat,
+			Amount:                v.Amount,
+			Balance:               v.Balance,
 			Role:                  v.Role,
 			Priority:              v.Priority,
 			UUID:                  v.UUID,
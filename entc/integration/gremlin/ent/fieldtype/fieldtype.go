@@ -130,6 +130,10 @@ const (
 	FieldSchemaFloat32 = "schema_float32"
 	// FieldNullFloat holds the string denoting the null_float field in the database.
 	FieldNullFloat = "null_float"
+	// FieldAmount holds the string denoting the amount field in the database.
+	FieldAmount = "amount"
+	// FieldBalance holds the string denoting the balance field in the database.
+	FieldBalance = "balance"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
 	// FieldPriority holds the string denoting the priority field in the database.
@@ -183,6 +187,8 @@ var (
 	DefaultIP func() net.IP
 	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
 	IPValidator func([]byte) error
+	// DefaultAmount holds the default value on creation for the "amount" field.
+	DefaultAmount string
 	// DefaultPair holds the default value on creation for the "pair" field.
 	DefaultPair func() schema.Pair
 	// DefaultVstring holds the default value on creation for the "vstring" field.
@@ -21,6 +21,7 @@ import (
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/entc/integration/gremlin/ent/fieldtype"
+	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
 
@@ -651,6 +652,34 @@ func (ftc *FieldTypeCreate) SetNullFloat(sf *sql.NullFloat64) *FieldTypeCreate {
 	return ftc
 }
 
+// SetAmount sets the "amount" field.
+func (ftc *FieldTypeCreate) SetAmount(s string) *FieldTypeCreate {
+	ftc.mutation.SetAmount(s)
+	return ftc
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableAmount(s *string) *FieldTypeCreate {
+	if s != nil {
+		ftc.SetAmount(*s)
+	}
+	return ftc
+}
+
+// SetBalance sets the "balance" field.
+func (ftc *FieldTypeCreate) SetBalance(f field.Rat) *FieldTypeCreate {
+	ftc.mutation.SetBalance(f)
+	return ftc
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ftc *FieldTypeCreate) SetNillableBalance(f *field.Rat) *FieldTypeCreate {
+	if f != nil {
+		ftc.SetBalance(*f)
+	}
+	return ftc
+}
+
 // SetRole sets the "role" field.
 func (ftc *FieldTypeCreate) SetRole(r role.Role) *FieldTypeCreate {
 	ftc.mutation.SetRole(r)
@@ -868,6 +897,10 @@ func (ftc *FieldTypeCreate) defaults() {
 		v := fieldtype.DefaultIP()
 		ftc.mutation.SetIP(v)
 	}
+	if _, ok := ftc.mutation.Amount(); !ok {
+		v := fieldtype.DefaultAmount
+		ftc.mutation.SetAmount(v)
+	}
 	if _, ok := ftc.mutation.Role(); !ok {
 		v := fieldtype.DefaultRole
 		ftc.mutation.SetRole(v)
@@ -887,83 +920,85 @@ func (ftc *FieldTypeCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftc *FieldTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Int(); !ok {
-		return &ValidationError{Name: "int", err: errors.New(`ent: missing required field "FieldType.int"`)}
+		errs = append(errs, newValidationError("int", "Required", errors.New(`ent: missing required field "FieldType.int"`)))
 	}
 	if _, ok := ftc.mutation.Int8(); !ok {
-		return &ValidationError{Name: "int8", err: errors.New(`ent: missing required field "FieldType.int8"`)}
+		errs = append(errs, newValidationError("int8", "Required", errors.New(`ent: missing required field "FieldType.int8"`)))
 	}
 	if _, ok := ftc.mutation.Int16(); !ok {
-		return &ValidationError{Name: "int16", err: errors.New(`ent: missing required field "FieldType.int16"`)}
+		errs = append(errs, newValidationError("int16", "Required", errors.New(`ent: missing required field "FieldType.int16"`)))
 	}
 	if _, ok := ftc.mutation.Int32(); !ok {
-		return &ValidationError{Name: "int32", err: errors.New(`ent: missing required field "FieldType.int32"`)}
+		errs = append(errs, newValidationError("int32", "Required", errors.New(`ent: missing required field "FieldType.int32"`)))
 	}
 	if _, ok := ftc.mutation.Int64(); !ok {
-		return &ValidationError{Name: "int64", err: errors.New(`ent: missing required field "FieldType.int64"`)}
+		errs = append(errs, newValidationError("int64", "Required", errors.New(`ent: missing required field "FieldType.int64"`)))
 	}
 	if v, ok := ftc.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := ftc.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
 		}
 	}
 	if _, ok := ftc.mutation.Dir(); !ok {
-		return &ValidationError{Name: "dir", err: errors.New(`ent: missing required field "FieldType.dir"`)}
+		errs = append(errs, newValidationError("dir", "Required", errors.New(`ent: missing required field "FieldType.dir"`)))
 	}
 	if v, ok := ftc.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
 		}
 	}
 	if v, ok := ftc.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
 		}
 	}
 	if _, ok := ftc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "FieldType.role"`)}
+		errs = append(errs, newValidationError("role", "Required", errors.New(`ent: missing required field "FieldType.role"`)))
 	}
 	if v, ok := ftc.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
 		}
 	}
 	if v, ok := ftc.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
 		}
 	}
 	if _, ok := ftc.mutation.Pair(); !ok {
-		return &ValidationError{Name: "pair", err: errors.New(`ent: missing required field "FieldType.pair"`)}
+		errs = append(errs, newValidationError("pair", "Required", errors.New(`ent: missing required field "FieldType.pair"`)))
 	}
 	if _, ok := ftc.mutation.Vstring(); !ok {
-		return &ValidationError{Name: "vstring", err: errors.New(`ent: missing required field "FieldType.vstring"`)}
+		errs = append(errs, newValidationError("vstring", "Required", errors.New(`ent: missing required field "FieldType.vstring"`)))
 	}
 	if _, ok := ftc.mutation.Triple(); !ok {
-		return &ValidationError{Name: "triple", err: errors.New(`ent: missing required field "FieldType.triple"`)}
+		errs = append(errs, newValidationError("triple", "Required", errors.New(`ent: missing required field "FieldType.triple"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FieldTypeCreate) gremlinSave(ctx context.Context) (*FieldType, error) {
@@ -1146,6 +1181,12 @@ func (ftc *FieldTypeCreate) gremlin() *dsl.Traversal {
 	if value, ok := ftc.mutation.NullFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNullFloat, value)
 	}
+	if value, ok := ftc.mutation.Amount(); ok {
+		v.Property(dsl.Single, fieldtype.FieldAmount, value)
+	}
+	if value, ok := ftc.mutation.Balance(); ok {
+		v.Property(dsl.Single, fieldtype.FieldBalance, value)
+	}
 	if value, ok := ftc.mutation.Role(); ok {
 		v.Property(dsl.Single, fieldtype.FieldRole, value)
 	}
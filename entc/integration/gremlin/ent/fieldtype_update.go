@@ -23,6 +23,7 @@ import (
 	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/entc/integration/gremlin/ent/fieldtype"
 	"entgo.io/ent/entc/integration/gremlin/ent/predicate"
+	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
 
@@ -1149,6 +1150,53 @@ func (ftu *FieldTypeUpdate) ClearNullFloat() *FieldTypeUpdate {
 	return ftu
 }
 
+// SetAmount sets the "amount" field.
+func (ftu *FieldTypeUpdate) SetAmount(s string) *FieldTypeUpdate {
+	ftu.mutation.SetAmount(s)
+	return ftu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableAmount(s *string) *FieldTypeUpdate {
+	if s != nil {
+		ftu.SetAmount(*s)
+	}
+	return ftu
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ftu *FieldTypeUpdate) ClearAmount() *FieldTypeUpdate {
+	ftu.mutation.ClearAmount()
+	return ftu
+}
+
+// SetBalance sets the "balance" field.
+func (ftu *FieldTypeUpdate) SetBalance(f field.Rat) *FieldTypeUpdate {
+	ftu.mutation.ResetBalance()
+	ftu.mutation.SetBalance(f)
+	return ftu
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ftu *FieldTypeUpdate) SetNillableBalance(f *field.Rat) *FieldTypeUpdate {
+	if f != nil {
+		ftu.SetBalance(*f)
+	}
+	return ftu
+}
+
+// AddBalance adds f to the "balance" field.
+func (ftu *FieldTypeUpdate) AddBalance(f field.Rat) *FieldTypeUpdate {
+	ftu.mutation.AddBalance(f)
+	return ftu
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (ftu *FieldTypeUpdate) ClearBalance() *FieldTypeUpdate {
+	ftu.mutation.ClearBalance()
+	return ftu
+}
+
 // SetRole sets the "role" field.
 func (ftu *FieldTypeUpdate) SetRole(r role.Role) *FieldTypeUpdate {
 	ftu.mutation.SetRole(r)
@@ -1399,53 +1447,55 @@ func (ftu *FieldTypeUpdate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftu *FieldTypeUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := ftu.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := ftu.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
 		}
 	}
 	if v, ok := ftu.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
 		}
 	}
 	if v, ok := ftu.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftu *FieldTypeUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -1720,6 +1770,15 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if value, ok := ftu.mutation.NullFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNullFloat, value)
 	}
+	if value, ok := ftu.mutation.Amount(); ok {
+		v.Property(dsl.Single, fieldtype.FieldAmount, value)
+	}
+	if value, ok := ftu.mutation.Balance(); ok {
+		v.Property(dsl.Single, fieldtype.FieldBalance, value)
+	}
+	if value, ok := ftu.mutation.AddedBalance(); ok {
+		v.Property(dsl.Single, fieldtype.FieldBalance, __.Union(__.Values(fieldtype.FieldBalance), __.Constant(value)).Sum())
+	}
 	if value, ok := ftu.mutation.Role(); ok {
 		v.Property(dsl.Single, fieldtype.FieldRole, value)
 	}
@@ -1901,6 +1960,12 @@ func (ftu *FieldTypeUpdate) gremlin() *dsl.Traversal {
 	if ftu.mutation.NullFloatCleared() {
 		properties = append(properties, fieldtype.FieldNullFloat)
 	}
+	if ftu.mutation.AmountCleared() {
+		properties = append(properties, fieldtype.FieldAmount)
+	}
+	if ftu.mutation.BalanceCleared() {
+		properties = append(properties, fieldtype.FieldBalance)
+	}
 	if ftu.mutation.PriorityCleared() {
 		properties = append(properties, fieldtype.FieldPriority)
 	}
@@ -3048,6 +3113,53 @@ func (ftuo *FieldTypeUpdateOne) ClearNullFloat() *FieldTypeUpdateOne {
 	return ftuo
 }
 
+// SetAmount sets the "amount" field.
+func (ftuo *FieldTypeUpdateOne) SetAmount(s string) *FieldTypeUpdateOne {
+	ftuo.mutation.SetAmount(s)
+	return ftuo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableAmount(s *string) *FieldTypeUpdateOne {
+	if s != nil {
+		ftuo.SetAmount(*s)
+	}
+	return ftuo
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (ftuo *FieldTypeUpdateOne) ClearAmount() *FieldTypeUpdateOne {
+	ftuo.mutation.ClearAmount()
+	return ftuo
+}
+
+// SetBalance sets the "balance" field.
+func (ftuo *FieldTypeUpdateOne) SetBalance(f field.Rat) *FieldTypeUpdateOne {
+	ftuo.mutation.ResetBalance()
+	ftuo.mutation.SetBalance(f)
+	return ftuo
+}
+
+// SetNillableBalance sets the "balance" field if the given value is not nil.
+func (ftuo *FieldTypeUpdateOne) SetNillableBalance(f *field.Rat) *FieldTypeUpdateOne {
+	if f != nil {
+		ftuo.SetBalance(*f)
+	}
+	return ftuo
+}
+
+// AddBalance adds f to the "balance" field.
+func (ftuo *FieldTypeUpdateOne) AddBalance(f field.Rat) *FieldTypeUpdateOne {
+	ftuo.mutation.AddBalance(f)
+	return ftuo
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (ftuo *FieldTypeUpdateOne) ClearBalance() *FieldTypeUpdateOne {
+	ftuo.mutation.ClearBalance()
+	return ftuo
+}
+
 // SetRole sets the "role" field.
 func (ftuo *FieldTypeUpdateOne) SetRole(r role.Role) *FieldTypeUpdateOne {
 	ftuo.mutation.SetRole(r)
@@ -3305,53 +3417,55 @@ func (ftuo *FieldTypeUpdateOne) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftuo *FieldTypeUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := ftuo.mutation.ValidateOptionalInt32(); ok {
 		if err := fieldtype.ValidateOptionalInt32Validator(v); err != nil {
-			return &ValidationError{Name: "validate_optional_int32", err: fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)}
+			errs = append(errs, validatorError("validate_optional_int32", fmt.Errorf(`ent: validator failed for field "FieldType.validate_optional_int32": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.State(); ok {
 		if err := fieldtype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.state": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := ftuo.mutation.MAC(); ok {
 		if err := fieldtype.MACValidator(v.String()); err != nil {
-			return &ValidationError{Name: "mac", err: fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)}
+			errs = append(errs, validatorError("mac", fmt.Errorf(`ent: validator failed for field "FieldType.mac": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.Ndir(); ok {
 		if err := fieldtype.NdirValidator(string(v)); err != nil {
-			return &ValidationError{Name: "ndir", err: fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)}
+			errs = append(errs, validatorError("ndir", fmt.Errorf(`ent: validator failed for field "FieldType.ndir": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.Link(); ok {
 		if err := fieldtype.LinkValidator(v.String()); err != nil {
-			return &ValidationError{Name: "link", err: fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)}
+			errs = append(errs, validatorError("link", fmt.Errorf(`ent: validator failed for field "FieldType.link": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.RawData(); ok {
 		if err := fieldtype.RawDataValidator(v); err != nil {
-			return &ValidationError{Name: "raw_data", err: fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)}
+			errs = append(errs, validatorError("raw_data", fmt.Errorf(`ent: validator failed for field "FieldType.raw_data": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.IP(); ok {
 		if err := fieldtype.IPValidator([]byte(v)); err != nil {
-			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)}
+			errs = append(errs, validatorError("ip", fmt.Errorf(`ent: validator failed for field "FieldType.ip": %w`, err)))
 		}
 	}
 	if v, ok := ftuo.mutation.Role(); ok {
 		if err := fieldtype.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.role": %w`, err), "ADMIN", "OWNER", "USER", "READ", "WRITE"))
 		}
 	}
 	if v, ok := ftuo.mutation.Priority(); ok {
 		if err := fieldtype.PriorityValidator(v); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err)}
+			errs = append(errs, newValidationError("priority", "Enum", fmt.Errorf(`ent: validator failed for field "FieldType.priority": %w`, err), "UNKNOWN", "LOW", "HIGH"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftuo *FieldTypeUpdateOne) gremlinSave(ctx context.Context) (*FieldType, error) {
@@ -3631,6 +3745,15 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if value, ok := ftuo.mutation.NullFloat(); ok {
 		v.Property(dsl.Single, fieldtype.FieldNullFloat, value)
 	}
+	if value, ok := ftuo.mutation.Amount(); ok {
+		v.Property(dsl.Single, fieldtype.FieldAmount, value)
+	}
+	if value, ok := ftuo.mutation.Balance(); ok {
+		v.Property(dsl.Single, fieldtype.FieldBalance, value)
+	}
+	if value, ok := ftuo.mutation.AddedBalance(); ok {
+		v.Property(dsl.Single, fieldtype.FieldBalance, __.Union(__.Values(fieldtype.FieldBalance), __.Constant(value)).Sum())
+	}
 	if value, ok := ftuo.mutation.Role(); ok {
 		v.Property(dsl.Single, fieldtype.FieldRole, value)
 	}
@@ -3812,6 +3935,12 @@ func (ftuo *FieldTypeUpdateOne) gremlin(id string) *dsl.Traversal {
 	if ftuo.mutation.NullFloatCleared() {
 		properties = append(properties, fieldtype.FieldNullFloat)
 	}
+	if ftuo.mutation.AmountCleared() {
+		properties = append(properties, fieldtype.FieldAmount)
+	}
+	if ftuo.mutation.BalanceCleared() {
+		properties = append(properties, fieldtype.FieldBalance)
+	}
 	if ftuo.mutation.PriorityCleared() {
 		properties = append(properties, fieldtype.FieldPriority)
 	}
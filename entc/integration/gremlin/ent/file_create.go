@@ -221,19 +221,21 @@ func (fc *FileCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (fc *FileCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := fc.mutation.Size(); !ok {
-		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "File.size"`)}
+		errs = append(errs, newValidationError("size", "Required", errors.New(`ent: missing required field "File.size"`)))
 	}
 	if v, ok := fc.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, validatorError("size", fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)))
 		}
 	}
 	if _, ok := fc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "File.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "File.name"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (fc *FileCreate) gremlinSave(ctx context.Context) (*File, error) {
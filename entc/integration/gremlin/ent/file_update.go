@@ -274,13 +274,15 @@ func (fu *FileUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (fu *FileUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := fu.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, validatorError("size", fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fu *FileUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -636,13 +638,15 @@ func (fuo *FileUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (fuo *FileUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := fuo.mutation.Size(); ok {
 		if err := file.SizeValidator(v); err != nil {
-			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)}
+			errs = append(errs, validatorError("size", fmt.Errorf(`ent: validator failed for field "File.size": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (fuo *FileUpdateOne) gremlinSave(ctx context.Context) (*File, error) {
@@ -157,27 +157,29 @@ func (ftc *FileTypeCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ftc *FileTypeCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := ftc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "FileType.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "FileType.name"`)))
 	}
 	if _, ok := ftc.mutation.GetType(); !ok {
-		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "FileType.type"`)}
+		errs = append(errs, newValidationError("type", "Required", errors.New(`ent: missing required field "FileType.type"`)))
 	}
 	if v, ok := ftc.mutation.GetType(); ok {
 		if err := filetype.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err)}
+			errs = append(errs, newValidationError("type", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.type": %w`, err), "png", "svg", "jpg"))
 		}
 	}
 	if _, ok := ftc.mutation.State(); !ok {
-		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "FileType.state"`)}
+		errs = append(errs, newValidationError("state", "Required", errors.New(`ent: missing required field "FileType.state"`)))
 	}
 	if v, ok := ftc.mutation.State(); ok {
 		if err := filetype.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`ent: validator failed for field "FileType.state": %w`, err), "ON", "OFF"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ftc *FileTypeCreate) gremlinSave(ctx context.Context) (*FileType, error) {
@@ -92,8 +92,10 @@ func (gc *GoodsCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gc *GoodsCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (gc *GoodsCreate) gremlinSave(ctx context.Context) (*Goods, error) {
@@ -220,35 +220,37 @@ func (gc *GroupCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gc *GroupCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gc.mutation.Active(); !ok {
-		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Group.active"`)}
+		errs = append(errs, newValidationError("active", "Required", errors.New(`ent: missing required field "Group.active"`)))
 	}
 	if _, ok := gc.mutation.Expire(); !ok {
-		return &ValidationError{Name: "expire", err: errors.New(`ent: missing required field "Group.expire"`)}
+		errs = append(errs, newValidationError("expire", "Required", errors.New(`ent: missing required field "Group.expire"`)))
 	}
 	if v, ok := gc.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, validatorError("type", fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)))
 		}
 	}
 	if v, ok := gc.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, validatorError("max_users", fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)))
 		}
 	}
 	if _, ok := gc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Group.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "Group.name"`)))
 	}
 	if v, ok := gc.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)))
 		}
 	}
 	if _, ok := gc.mutation.InfoID(); !ok {
-		return &ValidationError{Name: "info", err: errors.New(`ent: missing required edge "Group.info"`)}
+		errs = append(errs, newValidationError("info", "Required", errors.New(`ent: missing required edge "Group.info"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (gc *GroupCreate) gremlinSave(ctx context.Context) (*Group, error) {
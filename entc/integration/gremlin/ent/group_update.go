@@ -299,26 +299,28 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gu *GroupUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := gu.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, validatorError("type", fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)))
 		}
 	}
 	if v, ok := gu.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, validatorError("max_users", fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)))
 		}
 	}
 	if v, ok := gu.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)))
 		}
 	}
 	if _, ok := gu.mutation.InfoID(); gu.mutation.InfoCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.info"`)
+		errs = append(errs, newValidationError("info", "Required", errors.New(`ent: clearing a required unique edge "Group.info"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (gu *GroupUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -707,26 +709,28 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (guo *GroupUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := guo.mutation.GetType(); ok {
 		if err := group.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)}
+			errs = append(errs, validatorError("type", fmt.Errorf(`ent: validator failed for field "Group.type": %w`, err)))
 		}
 	}
 	if v, ok := guo.mutation.MaxUsers(); ok {
 		if err := group.MaxUsersValidator(v); err != nil {
-			return &ValidationError{Name: "max_users", err: fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)}
+			errs = append(errs, validatorError("max_users", fmt.Errorf(`ent: validator failed for field "Group.max_users": %w`, err)))
 		}
 	}
 	if v, ok := guo.mutation.Name(); ok {
 		if err := group.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Group.name": %w`, err)))
 		}
 	}
 	if _, ok := guo.mutation.InfoID(); guo.mutation.InfoCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.info"`)
+		errs = append(errs, newValidationError("info", "Required", errors.New(`ent: clearing a required unique edge "Group.info"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (guo *GroupUpdateOne) gremlinSave(ctx context.Context) (*Group, error) {
@@ -140,14 +140,16 @@ func (gic *GroupInfoCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gic *GroupInfoCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := gic.mutation.Desc(); !ok {
-		return &ValidationError{Name: "desc", err: errors.New(`ent: missing required field "GroupInfo.desc"`)}
+		errs = append(errs, newValidationError("desc", "Required", errors.New(`ent: missing required field "GroupInfo.desc"`)))
 	}
 	if _, ok := gic.mutation.MaxUsers(); !ok {
-		return &ValidationError{Name: "max_users", err: errors.New(`ent: missing required field "GroupInfo.max_users"`)}
+		errs = append(errs, newValidationError("max_users", "Required", errors.New(`ent: missing required field "GroupInfo.max_users"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (gic *GroupInfoCreate) gremlinSave(ctx context.Context) (*GroupInfo, error) {
@@ -131,18 +131,20 @@ func (ic *ItemCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (ic *ItemCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := ic.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, validatorError("text", fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)))
 		}
 	}
 	if v, ok := ic.mutation.ID(); ok {
 		if err := item.IDValidator(v); err != nil {
-			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)}
+			errs = append(errs, validatorError("id", fmt.Errorf(`ent: validator failed for field "Item.id": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (ic *ItemCreate) gremlinSave(ctx context.Context) (*Item, error) {
@@ -119,13 +119,15 @@ func (iu *ItemUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (iu *ItemUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := iu.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, validatorError("text", fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (iu *ItemUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -286,13 +288,15 @@ func (iuo *ItemUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (iuo *ItemUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := iuo.mutation.Text(); ok {
 		if err := item.TextValidator(v); err != nil {
-			return &ValidationError{Name: "text", err: fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)}
+			errs = append(errs, validatorError("text", fmt.Errorf(`ent: validator failed for field "Item.text": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (iuo *ItemUpdateOne) gremlinSave(ctx context.Context) (*Item, error) {
@@ -32,6 +32,7 @@ import (
 	"entgo.io/ent/entc/integration/gremlin/ent/spec"
 	"entgo.io/ent/entc/integration/gremlin/ent/task"
 	"entgo.io/ent/entc/integration/gremlin/ent/user"
+	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 
 	"entgo.io/ent"
@@ -1433,6 +1434,9 @@ type FieldTypeMutation struct {
 	schema_float32             *schema.Float32
 	addschema_float32          *schema.Float32
 	null_float                 **sql.NullFloat64
+	amount                     *string
+	balance                    *field.Rat
+	addbalance                 *field.Rat
 	role                       *role.Role
 	priority                   *role.Priority
 	uuid                       *uuid.UUID
@@ -4742,6 +4746,125 @@ func (m *FieldTypeMutation) ResetNullFloat() {
 	delete(m.clearedFields, fieldtype.FieldNullFloat)
 }
 
+// SetAmount sets the "amount" field.
+func (m *FieldTypeMutation) SetAmount(s string) {
+	m.amount = &s
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *FieldTypeMutation) Amount() (r string, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the FieldType entity.
+// If the FieldType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldTypeMutation) OldAmount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// ClearAmount clears the value of the "amount" field.
+func (m *FieldTypeMutation) ClearAmount() {
+	m.amount = nil
+	m.clearedFields[fieldtype.FieldAmount] = struct{}{}
+}
+
+// AmountCleared returns if the "amount" field was cleared in this mutation.
+func (m *FieldTypeMutation) AmountCleared() bool {
+	_, ok := m.clearedFields[fieldtype.FieldAmount]
+	return ok
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *FieldTypeMutation) ResetAmount() {
+	m.amount = nil
+	delete(m.clearedFields, fieldtype.FieldAmount)
+}
+
+// SetBalance sets the "balance" field.
+func (m *FieldTypeMutation) SetBalance(f field.Rat) {
+	m.balance = &f
+	m.addbalance = nil
+}
+
+// Balance returns the value of the "balance" field in the mutation.
+func (m *FieldTypeMutation) Balance() (r field.Rat, exists bool) {
+	v := m.balance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBalance returns the old "balance" field's value of the FieldType entity.
+// If the FieldType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FieldTypeMutation) OldBalance(ctx context.Context) (v *field.Rat, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBalance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBalance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBalance: %w", err)
+	}
+	return oldValue.Balance, nil
+}
+
+// AddBalance adds f to the "balance" field.
+func (m *FieldTypeMutation) AddBalance(f field.Rat) {
+	if m.addbalance != nil {
+		*m.addbalance = m.addbalance.Add(f)
+	} else {
+		m.addbalance = &f
+	}
+}
+
+// AddedBalance returns the value that was added to the "balance" field in this mutation.
+func (m *FieldTypeMutation) AddedBalance() (r field.Rat, exists bool) {
+	v := m.addbalance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearBalance clears the value of the "balance" field.
+func (m *FieldTypeMutation) ClearBalance() {
+	m.balance = nil
+	m.addbalance = nil
+	m.clearedFields[fieldtype.FieldBalance] = struct{}{}
+}
+
+// BalanceCleared returns if the "balance" field was cleared in this mutation.
+func (m *FieldTypeMutation) BalanceCleared() bool {
+	_, ok := m.clearedFields[fieldtype.FieldBalance]
+	return ok
+}
+
+// ResetBalance resets all changes to the "balance" field.
+func (m *FieldTypeMutation) ResetBalance() {
+	m.balance = nil
+	m.addbalance = nil
+	delete(m.clearedFields, fieldtype.FieldBalance)
+}
+
 // SetRole sets the "role" field.
 func (m *FieldTypeMutation) SetRole(r role.Role) {
 	m.role = &r
@@ -5269,7 +5392,7 @@ func (m *FieldTypeMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *FieldTypeMutation) Fields() []string {
-	fields := make([]string, 0, 65)
+	fields := make([]string, 0, 67)
 	if m.int != nil {
 		fields = append(fields, fieldtype.FieldInt)
 	}
@@ -5432,6 +5555,12 @@ func (m *FieldTypeMutation) Fields() []string {
 	if m.null_float != nil {
 		fields = append(fields, fieldtype.FieldNullFloat)
 	}
+	if m.amount != nil {
+		fields = append(fields, fieldtype.FieldAmount)
+	}
+	if m.balance != nil {
+		fields = append(fields, fieldtype.FieldBalance)
+	}
 	if m.role != nil {
 		fields = append(fields, fieldtype.FieldRole)
 	}
@@ -5581,6 +5710,10 @@ func (m *FieldTypeMutation) Field(name string) (ent.Value, bool) {
 		return m.SchemaFloat32()
 	case fieldtype.FieldNullFloat:
 		return m.NullFloat()
+	case fieldtype.FieldAmount:
+		return m.Amount()
+	case fieldtype.FieldBalance:
+		return m.Balance()
 	case fieldtype.FieldRole:
 		return m.Role()
 	case fieldtype.FieldPriority:
@@ -5720,6 +5853,10 @@ func (m *FieldTypeMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldSchemaFloat32(ctx)
 	case fieldtype.FieldNullFloat:
 		return m.OldNullFloat(ctx)
+	case fieldtype.FieldAmount:
+		return m.OldAmount(ctx)
+	case fieldtype.FieldBalance:
+		return m.OldBalance(ctx)
 	case fieldtype.FieldRole:
 		return m.OldRole(ctx)
 	case fieldtype.FieldPriority:
@@ -6129,6 +6266,20 @@ func (m *FieldTypeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetNullFloat(v)
 		return nil
+	case fieldtype.FieldAmount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case fieldtype.FieldBalance:
+		v, ok := value.(field.Rat)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalance(v)
+		return nil
 	case fieldtype.FieldRole:
 		v, ok := value.(role.Role)
 		if !ok {
@@ -6304,6 +6455,9 @@ func (m *FieldTypeMutation) AddedFields() []string {
 	if m.addschema_float32 != nil {
 		fields = append(fields, fieldtype.FieldSchemaFloat32)
 	}
+	if m.addbalance != nil {
+		fields = append(fields, fieldtype.FieldBalance)
+	}
 	if m.addbig_int != nil {
 		fields = append(fields, fieldtype.FieldBigInt)
 	}
@@ -6375,6 +6529,8 @@ func (m *FieldTypeMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedSchemaFloat()
 	case fieldtype.FieldSchemaFloat32:
 		return m.AddedSchemaFloat32()
+	case fieldtype.FieldBalance:
+		return m.AddedBalance()
 	case fieldtype.FieldBigInt:
 		return m.AddedBigInt()
 	}
@@ -6596,6 +6752,13 @@ func (m *FieldTypeMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddSchemaFloat32(v)
 		return nil
+	case fieldtype.FieldBalance:
+		v, ok := value.(field.Rat)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBalance(v)
+		return nil
 	case fieldtype.FieldBigInt:
 		v, ok := value.(schema.BigInt)
 		if !ok {
@@ -6755,6 +6918,12 @@ func (m *FieldTypeMutation) ClearedFields() []string {
 	if m.FieldCleared(fieldtype.FieldNullFloat) {
 		fields = append(fields, fieldtype.FieldNullFloat)
 	}
+	if m.FieldCleared(fieldtype.FieldAmount) {
+		fields = append(fields, fieldtype.FieldAmount)
+	}
+	if m.FieldCleared(fieldtype.FieldBalance) {
+		fields = append(fields, fieldtype.FieldBalance)
+	}
 	if m.FieldCleared(fieldtype.FieldPriority) {
 		fields = append(fields, fieldtype.FieldPriority)
 	}
@@ -6934,6 +7103,12 @@ func (m *FieldTypeMutation) ClearField(name string) error {
 	case fieldtype.FieldNullFloat:
 		m.ClearNullFloat()
 		return nil
+	case fieldtype.FieldAmount:
+		m.ClearAmount()
+		return nil
+	case fieldtype.FieldBalance:
+		m.ClearBalance()
+		return nil
 	case fieldtype.FieldPriority:
 		m.ClearPriority()
 		return nil
@@ -7125,6 +7300,12 @@ func (m *FieldTypeMutation) ResetField(name string) error {
 	case fieldtype.FieldNullFloat:
 		m.ResetNullFloat()
 		return nil
+	case fieldtype.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case fieldtype.FieldBalance:
+		m.ResetBalance()
+		return nil
 	case fieldtype.FieldRole:
 		m.ResetRole()
 		return nil
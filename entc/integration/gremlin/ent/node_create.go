@@ -146,8 +146,10 @@ func (nc *NodeCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (nc *NodeCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (nc *NodeCreate) gremlinSave(ctx context.Context) (*Node, error) {
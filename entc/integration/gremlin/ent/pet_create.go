@@ -184,14 +184,16 @@ func (pc *PetCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (pc *PetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := pc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "Pet.age"`)}
+		errs = append(errs, newValidationError("age", "Required", errors.New(`ent: missing required field "Pet.age"`)))
 	}
 	if _, ok := pc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Pet.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "Pet.name"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (pc *PetCreate) gremlinSave(ctx context.Context) (*Pet, error) {
@@ -107,8 +107,10 @@ func (sc *SpecCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (sc *SpecCreate) check() error {
-	return nil
+	var errs ValidationErrors
+	return errs.err()
 }
 
 func (sc *SpecCreate) gremlinSave(ctx context.Context) (*Spec, error) {
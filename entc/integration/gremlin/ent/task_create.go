@@ -117,16 +117,18 @@ func (tc *TaskCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tc *TaskCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := tc.mutation.Priority(); !ok {
-		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "Task.priority"`)}
+		errs = append(errs, newValidationError("priority", "Required", errors.New(`ent: missing required field "Task.priority"`)))
 	}
 	if v, ok := tc.mutation.Priority(); ok {
 		if err := task.PriorityValidator(int(v)); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, validatorError("priority", fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tc *TaskCreate) gremlinSave(ctx context.Context) (*Task, error) {
@@ -120,13 +120,15 @@ func (tu *TaskUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tu *TaskUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := tu.mutation.Priority(); ok {
 		if err := task.PriorityValidator(int(v)); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, validatorError("priority", fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tu *TaskUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -262,13 +264,15 @@ func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tuo *TaskUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := tuo.mutation.Priority(); ok {
 		if err := task.PriorityValidator(int(v)); err != nil {
-			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)}
+			errs = append(errs, validatorError("priority", fmt.Errorf(`ent: validator failed for field "Task.priority": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tuo *TaskUpdateOne) gremlinSave(ctx context.Context) (*Task, error) {
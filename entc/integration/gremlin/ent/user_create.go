@@ -435,38 +435,40 @@ func (uc *UserCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if v, ok := uc.mutation.OptionalInt(); ok {
 		if err := user.OptionalIntValidator(v); err != nil {
-			return &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+			errs = append(errs, validatorError("optional_int", fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)))
 		}
 	}
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`ent: missing required field "User.age"`)}
+		errs = append(errs, newValidationError("age", "Required", errors.New(`ent: missing required field "User.age"`)))
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "User.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "User.name"`)))
 	}
 	if _, ok := uc.mutation.Last(); !ok {
-		return &ValidationError{Name: "last", err: errors.New(`ent: missing required field "User.last"`)}
+		errs = append(errs, newValidationError("last", "Required", errors.New(`ent: missing required field "User.last"`)))
 	}
 	if _, ok := uc.mutation.Role(); !ok {
-		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "User.role"`)}
+		errs = append(errs, newValidationError("role", "Required", errors.New(`ent: missing required field "User.role"`)))
 	}
 	if v, ok := uc.mutation.Role(); ok {
 		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "User.role": %w`, err), "user", "admin", "free-user", "test user"))
 		}
 	}
 	if _, ok := uc.mutation.Employment(); !ok {
-		return &ValidationError{Name: "employment", err: errors.New(`ent: missing required field "User.employment"`)}
+		errs = append(errs, newValidationError("employment", "Required", errors.New(`ent: missing required field "User.employment"`)))
 	}
 	if v, ok := uc.mutation.Employment(); ok {
 		if err := user.EmploymentValidator(v); err != nil {
-			return &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+			errs = append(errs, newValidationError("employment", "Enum", fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err), "Full-Time", "Part-Time", "Contract"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) gremlinSave(ctx context.Context) (*User, error) {
@@ -639,23 +639,25 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uu *UserUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := uu.mutation.OptionalInt(); ok {
 		if err := user.OptionalIntValidator(v); err != nil {
-			return &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+			errs = append(errs, validatorError("optional_int", fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)))
 		}
 	}
 	if v, ok := uu.mutation.Role(); ok {
 		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "User.role": %w`, err), "user", "admin", "free-user", "test user"))
 		}
 	}
 	if v, ok := uu.mutation.Employment(); ok {
 		if err := user.EmploymentValidator(v); err != nil {
-			return &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+			errs = append(errs, newValidationError("employment", "Enum", fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err), "Full-Time", "Part-Time", "Contract"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uu *UserUpdate) gremlinSave(ctx context.Context) (int, error) {
@@ -1496,23 +1498,25 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uuo *UserUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := uuo.mutation.OptionalInt(); ok {
 		if err := user.OptionalIntValidator(v); err != nil {
-			return &ValidationError{Name: "optional_int", err: fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)}
+			errs = append(errs, validatorError("optional_int", fmt.Errorf(`ent: validator failed for field "User.optional_int": %w`, err)))
 		}
 	}
 	if v, ok := uuo.mutation.Role(); ok {
 		if err := user.RoleValidator(v); err != nil {
-			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "User.role": %w`, err)}
+			errs = append(errs, newValidationError("role", "Enum", fmt.Errorf(`ent: validator failed for field "User.role": %w`, err), "user", "admin", "free-user", "test user"))
 		}
 	}
 	if v, ok := uuo.mutation.Employment(); ok {
 		if err := user.EmploymentValidator(v); err != nil {
-			return &ValidationError{Name: "employment", err: fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err)}
+			errs = append(errs, newValidationError("employment", "Enum", fmt.Errorf(`ent: validator failed for field "User.employment": %w`, err), "Full-Time", "Part-Time", "Contract"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uuo *UserUpdateOne) gremlinSave(ctx context.Context) (*User, error) {
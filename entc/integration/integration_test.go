@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -120,6 +121,7 @@ var (
 		Types,
 		Clone,
 		EntQL,
+		Dynamic,
 		Sanity,
 		Paging,
 		Select,
@@ -1390,11 +1392,78 @@ func Tx(t *testing.T, client *ent.Client) {
 		tx, err := client.Tx(ctx)
 		require.NoError(t, err)
 		var m mocker
-		m.On("onRollback", nil).Once()
+		m.On("onRollback", nil).Twice()
 		defer m.AssertExpectations(t)
 		tx.OnRollback(m.rHook())
-		_, err = tx.Client().Tx(ctx)
-		require.Error(t, err, "cannot start a transaction within a transaction")
+		tx.Node.Create().SetValue(1).ExecX(ctx)
+
+		// Rolled back nested transaction.
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.Node.Create().SetValue(2).ExecX(ctx)
+		require.Equal(t, 2, nested.Node.Query().Where(node.ValueGT(0)).CountX(ctx))
+		require.NoError(t, nested.Rollback())
+		require.Error(t, nested.Commit(), "should return an error after rollback")
+		require.Equal(t, 1, tx.Node.Query().Where(node.ValueGT(0)).CountX(ctx))
+
+		// Committed nested transaction.
+		nested, err = tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.OnRollback(m.rHook())
+		nested.OnCommit(func(ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(context.Context, *ent.Tx) error {
+				t.Fatal("commit hooks of nested transactions should not be called on rollback")
+				return nil
+			})
+		})
+		nested.Node.Create().SetValue(3).ExecX(ctx)
+		inner, err := nested.Client().Tx(ctx)
+		require.NoError(t, err)
+		inner.Node.Create().SetValue(4).ExecX(ctx)
+		require.NoError(t, inner.Commit())
+		require.NoError(t, nested.Commit())
+		require.Equal(t, []int{1, 3, 4}, tx.Node.Query().Where(node.ValueGT(0)).Order(ent.Asc(node.FieldValue)).Select(node.FieldValue).IntsX(ctx))
+		require.NoError(t, tx.Rollback())
+		require.Zero(t, client.Node.Query().Where(node.ValueGT(0)).CountX(ctx), "rollback should discard all changes")
+	})
+	t.Run("NestedCommit", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var m mocker
+		m.On("onCommit", mock.Anything).Once()
+		defer m.AssertExpectations(t)
+		nested, err := tx.Client().Tx(ctx)
+		require.NoError(t, err)
+		nested.OnCommit(func(next ent.Committer) ent.Committer {
+			return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+				err := next.Commit(ctx, tx)
+				m.onCommit(err)
+				return err
+			})
+		})
+		nested.Node.Create().SetValue(5).ExecX(ctx)
+		require.NoError(t, nested.Commit())
+		require.NoError(t, tx.Commit())
+		require.Equal(t, 1, client.Node.Query().Where(node.Value(5)).CountX(ctx))
+	})
+	t.Run("NestedConcurrent", func(t *testing.T) {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		var (
+			wg   sync.WaitGroup
+			errs = make([]error, 5)
+		)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = tx.Client().Tx(ctx)
+			}(i)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
 		require.NoError(t, tx.Rollback())
 	})
 	t.Run("TxOptions", func(t *testing.T) {
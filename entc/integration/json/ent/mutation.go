@@ -44,9 +44,16 @@ type UserMutation struct {
 	url           **url.URL
 	raw           *json.RawMessage
 	dirs          *[]http.Dir
+	appenddirs    []http.Dir
 	ints          *[]int
+	appendints    []int
+	removeints    []int
 	floats        *[]float64
+	appendfloats  []float64
+	removefloats  []float64
 	strings       *[]string
+	appendstrings []string
+	removestrings []string
 	clearedFields map[string]struct{}
 	done          bool
 	oldValue      func(context.Context) (*User, error)
@@ -301,6 +308,7 @@ func (m *UserMutation) ResetRaw() {
 // SetDirs sets the "dirs" field.
 func (m *UserMutation) SetDirs(h []http.Dir) {
 	m.dirs = &h
+	m.appenddirs = nil
 }
 
 // Dirs returns the value of the "dirs" field in the mutation.
@@ -329,14 +337,34 @@ func (m *UserMutation) OldDirs(ctx context.Context) (v []http.Dir, err error) {
 	return oldValue.Dirs, nil
 }
 
+// AppendDirs adds v to the "dirs" field.
+func (m *UserMutation) AppendDirs(v []http.Dir) {
+	if m.dirs != nil {
+		*m.dirs = append(append([]http.Dir(nil), *m.dirs...), v...)
+		return
+	}
+	m.appenddirs = append(m.appenddirs, v...)
+}
+
+// AppendedDirs returns the list of values that were appended to the "dirs" field in this mutation.
+func (m *UserMutation) AppendedDirs() ([]http.Dir, bool) {
+	if len(m.appenddirs) == 0 {
+		return nil, false
+	}
+	return m.appenddirs, true
+}
+
 // ResetDirs resets all changes to the "dirs" field.
 func (m *UserMutation) ResetDirs() {
 	m.dirs = nil
+	m.appenddirs = nil
 }
 
 // SetInts sets the "ints" field.
 func (m *UserMutation) SetInts(i []int) {
 	m.ints = &i
+	m.appendints = nil
+	m.removeints = nil
 }
 
 // Ints returns the value of the "ints" field in the mutation.
@@ -365,9 +393,68 @@ func (m *UserMutation) OldInts(ctx context.Context) (v []int, err error) {
 	return oldValue.Ints, nil
 }
 
+// AppendInts adds v to the "ints" field.
+func (m *UserMutation) AppendInts(v []int) {
+	if m.ints != nil {
+		*m.ints = append(append([]int(nil), *m.ints...), v...)
+		return
+	}
+	if m.IntsCleared() {
+		delete(m.clearedFields, user.FieldInts)
+		vs := append([]int(nil), v...)
+		m.ints = &vs
+		return
+	}
+	m.appendints = append(m.appendints, v...)
+}
+
+// AppendedInts returns the list of values that were appended to the "ints" field in this mutation.
+func (m *UserMutation) AppendedInts() ([]int, bool) {
+	if len(m.appendints) == 0 {
+		return nil, false
+	}
+	return m.appendints, true
+}
+
+// RemoveInts removes all occurrences of v from the "ints" field.
+func (m *UserMutation) RemoveInts(v []int) {
+	without := func(vs []int) []int {
+		r := make([]int, 0, len(vs))
+		for i := range vs {
+			keep := true
+			for j := 0; keep && j < len(v); j++ {
+				keep = vs[i] != v[j]
+			}
+			if keep {
+				r = append(r, vs[i])
+			}
+		}
+		return r
+	}
+	if m.ints != nil {
+		*m.ints = without(*m.ints)
+		return
+	}
+	if m.IntsCleared() {
+		return
+	}
+	m.appendints = without(m.appendints)
+	m.removeints = append(m.removeints, v...)
+}
+
+// RemovedInts returns the list of values that were removed from the "ints" field in this mutation.
+func (m *UserMutation) RemovedInts() ([]int, bool) {
+	if len(m.removeints) == 0 {
+		return nil, false
+	}
+	return m.removeints, true
+}
+
 // ClearInts clears the value of the "ints" field.
 func (m *UserMutation) ClearInts() {
 	m.ints = nil
+	m.appendints = nil
+	m.removeints = nil
 	m.clearedFields[user.FieldInts] = struct{}{}
 }
 
@@ -380,12 +467,16 @@ func (m *UserMutation) IntsCleared() bool {
 // ResetInts resets all changes to the "ints" field.
 func (m *UserMutation) ResetInts() {
 	m.ints = nil
+	m.appendints = nil
+	m.removeints = nil
 	delete(m.clearedFields, user.FieldInts)
 }
 
 // SetFloats sets the "floats" field.
 func (m *UserMutation) SetFloats(f []float64) {
 	m.floats = &f
+	m.appendfloats = nil
+	m.removefloats = nil
 }
 
 // Floats returns the value of the "floats" field in the mutation.
@@ -414,9 +505,68 @@ func (m *UserMutation) OldFloats(ctx context.Context) (v []float64, err error) {
 	return oldValue.Floats, nil
 }
 
+// AppendFloats adds v to the "floats" field.
+func (m *UserMutation) AppendFloats(v []float64) {
+	if m.floats != nil {
+		*m.floats = append(append([]float64(nil), *m.floats...), v...)
+		return
+	}
+	if m.FloatsCleared() {
+		delete(m.clearedFields, user.FieldFloats)
+		vs := append([]float64(nil), v...)
+		m.floats = &vs
+		return
+	}
+	m.appendfloats = append(m.appendfloats, v...)
+}
+
+// AppendedFloats returns the list of values that were appended to the "floats" field in this mutation.
+func (m *UserMutation) AppendedFloats() ([]float64, bool) {
+	if len(m.appendfloats) == 0 {
+		return nil, false
+	}
+	return m.appendfloats, true
+}
+
+// RemoveFloats removes all occurrences of v from the "floats" field.
+func (m *UserMutation) RemoveFloats(v []float64) {
+	without := func(vs []float64) []float64 {
+		r := make([]float64, 0, len(vs))
+		for i := range vs {
+			keep := true
+			for j := 0; keep && j < len(v); j++ {
+				keep = vs[i] != v[j]
+			}
+			if keep {
+				r = append(r, vs[i])
+			}
+		}
+		return r
+	}
+	if m.floats != nil {
+		*m.floats = without(*m.floats)
+		return
+	}
+	if m.FloatsCleared() {
+		return
+	}
+	m.appendfloats = without(m.appendfloats)
+	m.removefloats = append(m.removefloats, v...)
+}
+
+// RemovedFloats returns the list of values that were removed from the "floats" field in this mutation.
+func (m *UserMutation) RemovedFloats() ([]float64, bool) {
+	if len(m.removefloats) == 0 {
+		return nil, false
+	}
+	return m.removefloats, true
+}
+
 // ClearFloats clears the value of the "floats" field.
 func (m *UserMutation) ClearFloats() {
 	m.floats = nil
+	m.appendfloats = nil
+	m.removefloats = nil
 	m.clearedFields[user.FieldFloats] = struct{}{}
 }
 
@@ -429,12 +579,16 @@ func (m *UserMutation) FloatsCleared() bool {
 // ResetFloats resets all changes to the "floats" field.
 func (m *UserMutation) ResetFloats() {
 	m.floats = nil
+	m.appendfloats = nil
+	m.removefloats = nil
 	delete(m.clearedFields, user.FieldFloats)
 }
 
 // SetStrings sets the "strings" field.
 func (m *UserMutation) SetStrings(s []string) {
 	m.strings = &s
+	m.appendstrings = nil
+	m.removestrings = nil
 }
 
 // Strings returns the value of the "strings" field in the mutation.
@@ -463,9 +617,68 @@ func (m *UserMutation) OldStrings(ctx context.Context) (v []string, err error) {
 	return oldValue.Strings, nil
 }
 
+// AppendStrings adds v to the "strings" field.
+func (m *UserMutation) AppendStrings(v []string) {
+	if m.strings != nil {
+		*m.strings = append(append([]string(nil), *m.strings...), v...)
+		return
+	}
+	if m.StringsCleared() {
+		delete(m.clearedFields, user.FieldStrings)
+		vs := append([]string(nil), v...)
+		m.strings = &vs
+		return
+	}
+	m.appendstrings = append(m.appendstrings, v...)
+}
+
+// AppendedStrings returns the list of values that were appended to the "strings" field in this mutation.
+func (m *UserMutation) AppendedStrings() ([]string, bool) {
+	if len(m.appendstrings) == 0 {
+		return nil, false
+	}
+	return m.appendstrings, true
+}
+
+// RemoveStrings removes all occurrences of v from the "strings" field.
+func (m *UserMutation) RemoveStrings(v []string) {
+	without := func(vs []string) []string {
+		r := make([]string, 0, len(vs))
+		for i := range vs {
+			keep := true
+			for j := 0; keep && j < len(v); j++ {
+				keep = vs[i] != v[j]
+			}
+			if keep {
+				r = append(r, vs[i])
+			}
+		}
+		return r
+	}
+	if m.strings != nil {
+		*m.strings = without(*m.strings)
+		return
+	}
+	if m.StringsCleared() {
+		return
+	}
+	m.appendstrings = without(m.appendstrings)
+	m.removestrings = append(m.removestrings, v...)
+}
+
+// RemovedStrings returns the list of values that were removed from the "strings" field in this mutation.
+func (m *UserMutation) RemovedStrings() ([]string, bool) {
+	if len(m.removestrings) == 0 {
+		return nil, false
+	}
+	return m.removestrings, true
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (m *UserMutation) ClearStrings() {
 	m.strings = nil
+	m.appendstrings = nil
+	m.removestrings = nil
 	m.clearedFields[user.FieldStrings] = struct{}{}
 }
 
@@ -478,6 +691,8 @@ func (m *UserMutation) StringsCleared() bool {
 // ResetStrings resets all changes to the "strings" field.
 func (m *UserMutation) ResetStrings() {
 	m.strings = nil
+	m.appendstrings = nil
+	m.removestrings = nil
 	delete(m.clearedFields, user.FieldStrings)
 }
 
@@ -499,6 +714,10 @@ func (m *UserMutation) Type() string {
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
+//
+// Fields that values were only appended to or removed from are also returned,
+// but their values are available only using the Appended<F> and Removed<F>
+// methods, and not using the Field method.
 func (m *UserMutation) Fields() []string {
 	fields := make([]string, 0, 7)
 	if m.t != nil {
@@ -510,16 +729,16 @@ func (m *UserMutation) Fields() []string {
 	if m.raw != nil {
 		fields = append(fields, user.FieldRaw)
 	}
-	if m.dirs != nil {
+	if m.dirs != nil || len(m.appenddirs) > 0 {
 		fields = append(fields, user.FieldDirs)
 	}
-	if m.ints != nil {
+	if m.ints != nil || len(m.appendints) > 0 || len(m.removeints) > 0 {
 		fields = append(fields, user.FieldInts)
 	}
-	if m.floats != nil {
+	if m.floats != nil || len(m.appendfloats) > 0 || len(m.removefloats) > 0 {
 		fields = append(fields, user.FieldFloats)
 	}
-	if m.strings != nil {
+	if m.strings != nil || len(m.appendstrings) > 0 || len(m.removestrings) > 0 {
 		fields = append(fields, user.FieldStrings)
 	}
 	return fields
@@ -8,6 +8,9 @@ package user
 
 import (
 	"net/http"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 )
 
 const (
@@ -55,6 +58,162 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// TIAsc returns an ordering function for ordering the results
+// by the "i" value of the "t" field in ascending order.
+func TIAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldT, sqljson.Path("i"))
+}
+
+// TIDesc returns an ordering function for ordering the results
+// by the "i" value of the "t" field in descending order.
+func TIDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldT, sqljson.Path("i"))
+}
+
+// TFAsc returns an ordering function for ordering the results
+// by the "f" value of the "t" field in ascending order.
+func TFAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldT, sqljson.Path("f"))
+}
+
+// TFDesc returns an ordering function for ordering the results
+// by the "f" value of the "t" field in descending order.
+func TFDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldT, sqljson.Path("f"))
+}
+
+// TBAsc returns an ordering function for ordering the results
+// by the "b" value of the "t" field in ascending order.
+func TBAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldT, sqljson.Path("b"))
+}
+
+// TBDesc returns an ordering function for ordering the results
+// by the "b" value of the "t" field in descending order.
+func TBDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldT, sqljson.Path("b"))
+}
+
+// TSAsc returns an ordering function for ordering the results
+// by the "s" value of the "t" field in ascending order.
+func TSAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldT, sqljson.Path("s"))
+}
+
+// TSDesc returns an ordering function for ordering the results
+// by the "s" value of the "t" field in descending order.
+func TSDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldT, sqljson.Path("s"))
+}
+
+// URLSchemeAsc returns an ordering function for ordering the results
+// by the "Scheme" value of the "url" field in ascending order.
+func URLSchemeAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("Scheme"))
+}
+
+// URLSchemeDesc returns an ordering function for ordering the results
+// by the "Scheme" value of the "url" field in descending order.
+func URLSchemeDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("Scheme"))
+}
+
+// URLOpaqueAsc returns an ordering function for ordering the results
+// by the "Opaque" value of the "url" field in ascending order.
+func URLOpaqueAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("Opaque"))
+}
+
+// URLOpaqueDesc returns an ordering function for ordering the results
+// by the "Opaque" value of the "url" field in descending order.
+func URLOpaqueDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("Opaque"))
+}
+
+// URLHostAsc returns an ordering function for ordering the results
+// by the "Host" value of the "url" field in ascending order.
+func URLHostAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("Host"))
+}
+
+// URLHostDesc returns an ordering function for ordering the results
+// by the "Host" value of the "url" field in descending order.
+func URLHostDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("Host"))
+}
+
+// URLPathAsc returns an ordering function for ordering the results
+// by the "Path" value of the "url" field in ascending order.
+func URLPathAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("Path"))
+}
+
+// URLPathDesc returns an ordering function for ordering the results
+// by the "Path" value of the "url" field in descending order.
+func URLPathDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("Path"))
+}
+
+// URLRawPathAsc returns an ordering function for ordering the results
+// by the "RawPath" value of the "url" field in ascending order.
+func URLRawPathAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("RawPath"))
+}
+
+// URLRawPathDesc returns an ordering function for ordering the results
+// by the "RawPath" value of the "url" field in descending order.
+func URLRawPathDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("RawPath"))
+}
+
+// URLForceQueryAsc returns an ordering function for ordering the results
+// by the "ForceQuery" value of the "url" field in ascending order.
+func URLForceQueryAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("ForceQuery"))
+}
+
+// URLForceQueryDesc returns an ordering function for ordering the results
+// by the "ForceQuery" value of the "url" field in descending order.
+func URLForceQueryDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("ForceQuery"))
+}
+
+// URLRawQueryAsc returns an ordering function for ordering the results
+// by the "RawQuery" value of the "url" field in ascending order.
+func URLRawQueryAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("RawQuery"))
+}
+
+// URLRawQueryDesc returns an ordering function for ordering the results
+// by the "RawQuery" value of the "url" field in descending order.
+func URLRawQueryDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("RawQuery"))
+}
+
+// URLFragmentAsc returns an ordering function for ordering the results
+// by the "Fragment" value of the "url" field in ascending order.
+func URLFragmentAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("Fragment"))
+}
+
+// URLFragmentDesc returns an ordering function for ordering the results
+// by the "Fragment" value of the "url" field in descending order.
+func URLFragmentDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("Fragment"))
+}
+
+// URLRawFragmentAsc returns an ordering function for ordering the results
+// by the "RawFragment" value of the "url" field in ascending order.
+func URLRawFragmentAsc() func(*sql.Selector) {
+	return sqljson.OrderValue(FieldURL, sqljson.Path("RawFragment"))
+}
+
+// URLRawFragmentDesc returns an ordering function for ordering the results
+// by the "RawFragment" value of the "url" field in descending order.
+func URLRawFragmentDesc() func(*sql.Selector) {
+	return sqljson.OrderValueDesc(FieldURL, sqljson.Path("RawFragment"))
+}
+
 var (
 	// DefaultDirs holds the default value on creation for the "dirs" field.
 	DefaultDirs func() []http.Dir
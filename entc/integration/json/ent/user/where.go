@@ -8,6 +8,7 @@ package user
 
 import (
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/json/ent/predicate"
 )
 
@@ -178,6 +179,685 @@ func StringsNotNil() predicate.User {
 	})
 }
 
+// TI applies equality check predicate on the "i" value of the "t" field.
+func TI(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path("i")))
+	})
+}
+
+// TINEQ applies the NEQ predicate on the "i" value of the "t" field.
+func TINEQ(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path("i")))
+	})
+}
+
+// TIGT applies the GT predicate on the "i" value of the "t" field.
+func TIGT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path("i")))
+	})
+}
+
+// TIGTE applies the GTE predicate on the "i" value of the "t" field.
+func TIGTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path("i")))
+	})
+}
+
+// TILT applies the LT predicate on the "i" value of the "t" field.
+func TILT(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path("i")))
+	})
+}
+
+// TILTE applies the LTE predicate on the "i" value of the "t" field.
+func TILTE(v int) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path("i")))
+	})
+}
+
+// TF applies equality check predicate on the "f" value of the "t" field.
+func TF(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path("f")))
+	})
+}
+
+// TFNEQ applies the NEQ predicate on the "f" value of the "t" field.
+func TFNEQ(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path("f")))
+	})
+}
+
+// TFGT applies the GT predicate on the "f" value of the "t" field.
+func TFGT(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path("f")))
+	})
+}
+
+// TFGTE applies the GTE predicate on the "f" value of the "t" field.
+func TFGTE(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path("f")))
+	})
+}
+
+// TFLT applies the LT predicate on the "f" value of the "t" field.
+func TFLT(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path("f")))
+	})
+}
+
+// TFLTE applies the LTE predicate on the "f" value of the "t" field.
+func TFLTE(v float64) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path("f")))
+	})
+}
+
+// TB applies equality check predicate on the "b" value of the "t" field.
+func TB(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path("b")))
+	})
+}
+
+// TBNEQ applies the NEQ predicate on the "b" value of the "t" field.
+func TBNEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path("b")))
+	})
+}
+
+// TS applies equality check predicate on the "s" value of the "t" field.
+func TS(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSNEQ applies the NEQ predicate on the "s" value of the "t" field.
+func TSNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSGT applies the GT predicate on the "s" value of the "t" field.
+func TSGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSGTE applies the GTE predicate on the "s" value of the "t" field.
+func TSGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSLT applies the LT predicate on the "s" value of the "t" field.
+func TSLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSLTE applies the LTE predicate on the "s" value of the "t" field.
+func TSLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSHasPrefix applies the HasPrefix predicate on the "s" value of the "t" field.
+func TSHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSHasSuffix applies the HasSuffix predicate on the "s" value of the "t" field.
+func TSHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// TSContains applies the Contains predicate on the "s" value of the "t" field.
+func TSContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldT), v, sqljson.Path("s")))
+	})
+}
+
+// URLScheme applies equality check predicate on the "Scheme" value of the "url" field.
+func URLScheme(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeNEQ applies the NEQ predicate on the "Scheme" value of the "url" field.
+func URLSchemeNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeGT applies the GT predicate on the "Scheme" value of the "url" field.
+func URLSchemeGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeGTE applies the GTE predicate on the "Scheme" value of the "url" field.
+func URLSchemeGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeLT applies the LT predicate on the "Scheme" value of the "url" field.
+func URLSchemeLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeLTE applies the LTE predicate on the "Scheme" value of the "url" field.
+func URLSchemeLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeHasPrefix applies the HasPrefix predicate on the "Scheme" value of the "url" field.
+func URLSchemeHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeHasSuffix applies the HasSuffix predicate on the "Scheme" value of the "url" field.
+func URLSchemeHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLSchemeContains applies the Contains predicate on the "Scheme" value of the "url" field.
+func URLSchemeContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("Scheme")))
+	})
+}
+
+// URLOpaque applies equality check predicate on the "Opaque" value of the "url" field.
+func URLOpaque(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueNEQ applies the NEQ predicate on the "Opaque" value of the "url" field.
+func URLOpaqueNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueGT applies the GT predicate on the "Opaque" value of the "url" field.
+func URLOpaqueGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueGTE applies the GTE predicate on the "Opaque" value of the "url" field.
+func URLOpaqueGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueLT applies the LT predicate on the "Opaque" value of the "url" field.
+func URLOpaqueLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueLTE applies the LTE predicate on the "Opaque" value of the "url" field.
+func URLOpaqueLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueHasPrefix applies the HasPrefix predicate on the "Opaque" value of the "url" field.
+func URLOpaqueHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueHasSuffix applies the HasSuffix predicate on the "Opaque" value of the "url" field.
+func URLOpaqueHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLOpaqueContains applies the Contains predicate on the "Opaque" value of the "url" field.
+func URLOpaqueContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("Opaque")))
+	})
+}
+
+// URLHost applies equality check predicate on the "Host" value of the "url" field.
+func URLHost(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostNEQ applies the NEQ predicate on the "Host" value of the "url" field.
+func URLHostNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostGT applies the GT predicate on the "Host" value of the "url" field.
+func URLHostGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostGTE applies the GTE predicate on the "Host" value of the "url" field.
+func URLHostGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostLT applies the LT predicate on the "Host" value of the "url" field.
+func URLHostLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostLTE applies the LTE predicate on the "Host" value of the "url" field.
+func URLHostLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostHasPrefix applies the HasPrefix predicate on the "Host" value of the "url" field.
+func URLHostHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostHasSuffix applies the HasSuffix predicate on the "Host" value of the "url" field.
+func URLHostHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLHostContains applies the Contains predicate on the "Host" value of the "url" field.
+func URLHostContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("Host")))
+	})
+}
+
+// URLPath applies equality check predicate on the "Path" value of the "url" field.
+func URLPath(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathNEQ applies the NEQ predicate on the "Path" value of the "url" field.
+func URLPathNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathGT applies the GT predicate on the "Path" value of the "url" field.
+func URLPathGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathGTE applies the GTE predicate on the "Path" value of the "url" field.
+func URLPathGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathLT applies the LT predicate on the "Path" value of the "url" field.
+func URLPathLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathLTE applies the LTE predicate on the "Path" value of the "url" field.
+func URLPathLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathHasPrefix applies the HasPrefix predicate on the "Path" value of the "url" field.
+func URLPathHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathHasSuffix applies the HasSuffix predicate on the "Path" value of the "url" field.
+func URLPathHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLPathContains applies the Contains predicate on the "Path" value of the "url" field.
+func URLPathContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("Path")))
+	})
+}
+
+// URLRawPath applies equality check predicate on the "RawPath" value of the "url" field.
+func URLRawPath(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathNEQ applies the NEQ predicate on the "RawPath" value of the "url" field.
+func URLRawPathNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathGT applies the GT predicate on the "RawPath" value of the "url" field.
+func URLRawPathGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathGTE applies the GTE predicate on the "RawPath" value of the "url" field.
+func URLRawPathGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathLT applies the LT predicate on the "RawPath" value of the "url" field.
+func URLRawPathLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathLTE applies the LTE predicate on the "RawPath" value of the "url" field.
+func URLRawPathLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathHasPrefix applies the HasPrefix predicate on the "RawPath" value of the "url" field.
+func URLRawPathHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathHasSuffix applies the HasSuffix predicate on the "RawPath" value of the "url" field.
+func URLRawPathHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLRawPathContains applies the Contains predicate on the "RawPath" value of the "url" field.
+func URLRawPathContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("RawPath")))
+	})
+}
+
+// URLForceQuery applies equality check predicate on the "ForceQuery" value of the "url" field.
+func URLForceQuery(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("ForceQuery")))
+	})
+}
+
+// URLForceQueryNEQ applies the NEQ predicate on the "ForceQuery" value of the "url" field.
+func URLForceQueryNEQ(v bool) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("ForceQuery")))
+	})
+}
+
+// URLRawQuery applies equality check predicate on the "RawQuery" value of the "url" field.
+func URLRawQuery(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryNEQ applies the NEQ predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryGT applies the GT predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryGTE applies the GTE predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryLT applies the LT predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryLTE applies the LTE predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryHasPrefix applies the HasPrefix predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryHasSuffix applies the HasSuffix predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLRawQueryContains applies the Contains predicate on the "RawQuery" value of the "url" field.
+func URLRawQueryContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("RawQuery")))
+	})
+}
+
+// URLFragment applies equality check predicate on the "Fragment" value of the "url" field.
+func URLFragment(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentNEQ applies the NEQ predicate on the "Fragment" value of the "url" field.
+func URLFragmentNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentGT applies the GT predicate on the "Fragment" value of the "url" field.
+func URLFragmentGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentGTE applies the GTE predicate on the "Fragment" value of the "url" field.
+func URLFragmentGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentLT applies the LT predicate on the "Fragment" value of the "url" field.
+func URLFragmentLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentLTE applies the LTE predicate on the "Fragment" value of the "url" field.
+func URLFragmentLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentHasPrefix applies the HasPrefix predicate on the "Fragment" value of the "url" field.
+func URLFragmentHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentHasSuffix applies the HasSuffix predicate on the "Fragment" value of the "url" field.
+func URLFragmentHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLFragmentContains applies the Contains predicate on the "Fragment" value of the "url" field.
+func URLFragmentContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("Fragment")))
+	})
+}
+
+// URLRawFragment applies equality check predicate on the "RawFragment" value of the "url" field.
+func URLRawFragment(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentNEQ applies the NEQ predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentNEQ(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueNEQ(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentGT applies the GT predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentGT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGT(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentGTE applies the GTE predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentGTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueGTE(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentLT applies the LT predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentLT(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLT(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentLTE applies the LTE predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentLTE(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.ValueLTE(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentHasPrefix applies the HasPrefix predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentHasPrefix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasPrefix(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentHasSuffix applies the HasSuffix predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentHasSuffix(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringHasSuffix(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
+// URLRawFragmentContains applies the Contains predicate on the "RawFragment" value of the "url" field.
+func URLRawFragmentContains(v string) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		s.Where(sqljson.StringContains(s.C(FieldURL), v, sqljson.Path("RawFragment")))
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(func(s *sql.Selector) {
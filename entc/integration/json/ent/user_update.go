@@ -16,6 +16,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/json/ent/predicate"
 	"entgo.io/ent/entc/integration/json/ent/schema"
 	"entgo.io/ent/entc/integration/json/ent/user"
@@ -77,12 +78,30 @@ func (uu *UserUpdate) SetDirs(h []http.Dir) *UserUpdate {
 	return uu
 }
 
+// AppendDirs appends h to the "dirs" field.
+func (uu *UserUpdate) AppendDirs(h []http.Dir) *UserUpdate {
+	uu.mutation.AppendDirs(h)
+	return uu
+}
+
 // SetInts sets the "ints" field.
 func (uu *UserUpdate) SetInts(i []int) *UserUpdate {
 	uu.mutation.SetInts(i)
 	return uu
 }
 
+// AppendInts appends i to the "ints" field.
+func (uu *UserUpdate) AppendInts(i []int) *UserUpdate {
+	uu.mutation.AppendInts(i)
+	return uu
+}
+
+// RemoveInts removes all occurrences of i from the "ints" field.
+func (uu *UserUpdate) RemoveInts(i []int) *UserUpdate {
+	uu.mutation.RemoveInts(i)
+	return uu
+}
+
 // ClearInts clears the value of the "ints" field.
 func (uu *UserUpdate) ClearInts() *UserUpdate {
 	uu.mutation.ClearInts()
@@ -95,6 +114,18 @@ func (uu *UserUpdate) SetFloats(f []float64) *UserUpdate {
 	return uu
 }
 
+// AppendFloats appends f to the "floats" field.
+func (uu *UserUpdate) AppendFloats(f []float64) *UserUpdate {
+	uu.mutation.AppendFloats(f)
+	return uu
+}
+
+// RemoveFloats removes all occurrences of f from the "floats" field.
+func (uu *UserUpdate) RemoveFloats(f []float64) *UserUpdate {
+	uu.mutation.RemoveFloats(f)
+	return uu
+}
+
 // ClearFloats clears the value of the "floats" field.
 func (uu *UserUpdate) ClearFloats() *UserUpdate {
 	uu.mutation.ClearFloats()
@@ -107,6 +138,18 @@ func (uu *UserUpdate) SetStrings(s []string) *UserUpdate {
 	return uu
 }
 
+// AppendStrings appends s to the "strings" field.
+func (uu *UserUpdate) AppendStrings(s []string) *UserUpdate {
+	uu.mutation.AppendStrings(s)
+	return uu
+}
+
+// RemoveStrings removes all occurrences of s from the "strings" field.
+func (uu *UserUpdate) RemoveStrings(s []string) *UserUpdate {
+	uu.mutation.RemoveStrings(s)
+	return uu
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (uu *UserUpdate) ClearStrings() *UserUpdate {
 	uu.mutation.ClearStrings()
@@ -236,6 +279,11 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldDirs,
 		})
 	}
+	if value, ok := uu.mutation.AppendedDirs(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldDirs, value)
+		})
+	}
 	if value, ok := uu.mutation.Ints(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -243,6 +291,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldInts,
 		})
 	}
+	if value, ok := uu.mutation.RemovedInts(); ok {
+		if _, ok := uu.mutation.AppendedInts(); ok {
+			return 0, &ValidationError{Name: "ints", err: errors.New(`ent: cannot append to and remove from "User.ints" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldInts, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldInts, value)
+		})
+	}
 	if uu.mutation.IntsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -256,6 +317,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldFloats,
 		})
 	}
+	if value, ok := uu.mutation.RemovedFloats(); ok {
+		if _, ok := uu.mutation.AppendedFloats(); ok {
+			return 0, &ValidationError{Name: "floats", err: errors.New(`ent: cannot append to and remove from "User.floats" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldFloats, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedFloats(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldFloats, value)
+		})
+	}
 	if uu.mutation.FloatsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -269,6 +343,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldStrings,
 		})
 	}
+	if value, ok := uu.mutation.RemovedStrings(); ok {
+		if _, ok := uu.mutation.AppendedStrings(); ok {
+			return 0, &ValidationError{Name: "strings", err: errors.New(`ent: cannot append to and remove from "User.strings" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldStrings, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldStrings, value)
+		})
+	}
 	if uu.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -336,12 +423,30 @@ func (uuo *UserUpdateOne) SetDirs(h []http.Dir) *UserUpdateOne {
 	return uuo
 }
 
+// AppendDirs appends h to the "dirs" field.
+func (uuo *UserUpdateOne) AppendDirs(h []http.Dir) *UserUpdateOne {
+	uuo.mutation.AppendDirs(h)
+	return uuo
+}
+
 // SetInts sets the "ints" field.
 func (uuo *UserUpdateOne) SetInts(i []int) *UserUpdateOne {
 	uuo.mutation.SetInts(i)
 	return uuo
 }
 
+// AppendInts appends i to the "ints" field.
+func (uuo *UserUpdateOne) AppendInts(i []int) *UserUpdateOne {
+	uuo.mutation.AppendInts(i)
+	return uuo
+}
+
+// RemoveInts removes all occurrences of i from the "ints" field.
+func (uuo *UserUpdateOne) RemoveInts(i []int) *UserUpdateOne {
+	uuo.mutation.RemoveInts(i)
+	return uuo
+}
+
 // ClearInts clears the value of the "ints" field.
 func (uuo *UserUpdateOne) ClearInts() *UserUpdateOne {
 	uuo.mutation.ClearInts()
@@ -354,6 +459,18 @@ func (uuo *UserUpdateOne) SetFloats(f []float64) *UserUpdateOne {
 	return uuo
 }
 
+// AppendFloats appends f to the "floats" field.
+func (uuo *UserUpdateOne) AppendFloats(f []float64) *UserUpdateOne {
+	uuo.mutation.AppendFloats(f)
+	return uuo
+}
+
+// RemoveFloats removes all occurrences of f from the "floats" field.
+func (uuo *UserUpdateOne) RemoveFloats(f []float64) *UserUpdateOne {
+	uuo.mutation.RemoveFloats(f)
+	return uuo
+}
+
 // ClearFloats clears the value of the "floats" field.
 func (uuo *UserUpdateOne) ClearFloats() *UserUpdateOne {
 	uuo.mutation.ClearFloats()
@@ -366,6 +483,18 @@ func (uuo *UserUpdateOne) SetStrings(s []string) *UserUpdateOne {
 	return uuo
 }
 
+// AppendStrings appends s to the "strings" field.
+func (uuo *UserUpdateOne) AppendStrings(s []string) *UserUpdateOne {
+	uuo.mutation.AppendStrings(s)
+	return uuo
+}
+
+// RemoveStrings removes all occurrences of s from the "strings" field.
+func (uuo *UserUpdateOne) RemoveStrings(s []string) *UserUpdateOne {
+	uuo.mutation.RemoveStrings(s)
+	return uuo
+}
+
 // ClearStrings clears the value of the "strings" field.
 func (uuo *UserUpdateOne) ClearStrings() *UserUpdateOne {
 	uuo.mutation.ClearStrings()
@@ -519,6 +648,11 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldDirs,
 		})
 	}
+	if value, ok := uuo.mutation.AppendedDirs(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldDirs, value)
+		})
+	}
 	if value, ok := uuo.mutation.Ints(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -526,6 +660,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldInts,
 		})
 	}
+	if value, ok := uuo.mutation.RemovedInts(); ok {
+		if _, ok := uuo.mutation.AppendedInts(); ok {
+			return nil, &ValidationError{Name: "ints", err: errors.New(`ent: cannot append to and remove from "User.ints" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldInts, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedInts(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldInts, value)
+		})
+	}
 	if uuo.mutation.IntsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -539,6 +686,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldFloats,
 		})
 	}
+	if value, ok := uuo.mutation.RemovedFloats(); ok {
+		if _, ok := uuo.mutation.AppendedFloats(); ok {
+			return nil, &ValidationError{Name: "floats", err: errors.New(`ent: cannot append to and remove from "User.floats" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldFloats, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedFloats(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldFloats, value)
+		})
+	}
 	if uuo.mutation.FloatsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -552,6 +712,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldStrings,
 		})
 	}
+	if value, ok := uuo.mutation.RemovedStrings(); ok {
+		if _, ok := uuo.mutation.AppendedStrings(); ok {
+			return nil, &ValidationError{Name: "strings", err: errors.New(`ent: cannot append to and remove from "User.strings" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldStrings, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedStrings(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldStrings, value)
+		})
+	}
 	if uuo.mutation.StringsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
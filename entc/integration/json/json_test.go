@@ -50,6 +50,11 @@ func TestMySQL(t *testing.T) {
 			// Skip predicates test for MySQL old versions.
 			if version != "56" {
 				Predicates(t, client)
+				TypedPredicates(t, client)
+			}
+			// JSON_TABLE is supported only in MySQL 8.
+			if version == "8" {
+				AppendRemove(t, client)
 			}
 		})
 	}
@@ -81,6 +86,7 @@ func TestMaria(t *testing.T) {
 			Strings(t, client)
 			RawMessage(t, client)
 			Predicates(t, client)
+			TypedPredicates(t, client)
 		})
 	}
 }
@@ -110,6 +116,8 @@ func TestPostgres(t *testing.T) {
 			Strings(t, client)
 			RawMessage(t, client)
 			Predicates(t, client)
+			TypedPredicates(t, client)
+			AppendRemove(t, client)
 		})
 	}
 }
@@ -128,6 +136,8 @@ func TestSQLite(t *testing.T) {
 	Strings(t, client)
 	RawMessage(t, client)
 	Predicates(t, client)
+	TypedPredicates(t, client)
+	AppendRemove(t, client)
 }
 
 func Ints(t *testing.T, client *ent.Client) {
@@ -390,3 +400,67 @@ func Predicates(t *testing.T, client *ent.Client) {
 		}
 	})
 }
+
+func TypedPredicates(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	client.User.Delete().ExecX(ctx)
+	users := client.User.CreateBulk(
+		client.User.Create().SetT(&schema.T{I: 1, F: 1.5, S: "foo", B: true}),
+		client.User.Create().SetT(&schema.T{I: 2, F: 2.5, S: "bar"}),
+		client.User.Create().SetT(&schema.T{I: 3, F: 3.5, S: "baz", B: true}),
+	).SaveX(ctx)
+
+	require.Equal(t, users[0].ID, client.User.Query().Where(user.TI(1)).OnlyIDX(ctx))
+	require.Equal(t, users[1].ID, client.User.Query().Where(user.TS("bar")).OnlyIDX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.TINEQ(2)).CountX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.TIGT(1)).CountX(ctx))
+	require.Equal(t, 3, client.User.Query().Where(user.TFGTE(1.5)).CountX(ctx))
+	require.Equal(t, 1, client.User.Query().Where(user.TFLT(2.5)).CountX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.TSHasPrefix("ba")).CountX(ctx))
+	require.Equal(t, 1, client.User.Query().Where(user.TSHasSuffix("oo")).CountX(ctx))
+	require.Equal(t, 1, client.User.Query().Where(user.TSContains("az")).CountX(ctx))
+	require.Equal(t, 2, client.User.Query().Where(user.TB(true)).CountX(ctx))
+	require.Equal(t, users[2].ID, client.User.Query().Where(user.TB(true), user.TSLT("foo")).OnlyIDX(ctx))
+
+	ids := client.User.Query().Order(user.TIDesc()).IDsX(ctx)
+	require.Equal(t, []int{users[2].ID, users[1].ID, users[0].ID}, ids)
+	ids = client.User.Query().Order(user.TSAsc()).IDsX(ctx)
+	require.Equal(t, []int{users[1].ID, users[2].ID, users[0].ID}, ids)
+}
+
+func AppendRemove(t *testing.T, client *ent.Client) {
+	ctx := context.Background()
+	client.User.Delete().ExecX(ctx)
+	u1 := client.User.Create().SetStrings([]string{"a"}).SaveX(ctx)
+	u2 := client.User.Create().SaveX(ctx)
+
+	client.User.Update().AppendStrings([]string{"b", "c"}).AppendInts([]int{4}).ExecX(ctx)
+	u1, u2 = client.User.GetX(ctx, u1.ID), client.User.GetX(ctx, u2.ID)
+	require.Equal(t, []string{"a", "b", "c"}, u1.Strings)
+	require.Equal(t, []int{1, 2, 3, 4}, u1.Ints)
+	require.Equal(t, []string{"b", "c"}, u2.Strings, "NULL columns are treated as empty arrays")
+
+	u1 = u1.Update().RemoveStrings([]string{"a", "c"}).RemoveInts([]int{1, 3}).SaveX(ctx)
+	require.Equal(t, []string{"b"}, u1.Strings)
+	require.Equal(t, []int{2, 4}, u1.Ints)
+	u1 = client.User.GetX(ctx, u1.ID)
+	require.Equal(t, []string{"b"}, u1.Strings)
+	require.Equal(t, []int{2, 4}, u1.Ints)
+
+	u1 = u1.Update().RemoveStrings([]string{"b"}).SaveX(ctx)
+	require.Empty(t, u1.Strings)
+	u1 = u1.Update().AppendFloats([]float64{1.5}).AppendDirs([]http.Dir{"/dev"}).SaveX(ctx)
+	require.Equal(t, []float64{1.5}, u1.Floats)
+	require.Equal(t, []http.Dir{"/tmp", "/dev"}, u1.Dirs)
+
+	// Set, clear and remove are applied on the mutation value.
+	u1 = u1.Update().SetStrings([]string{"a"}).AppendStrings([]string{"b", "c"}).RemoveStrings([]string{"c"}).SaveX(ctx)
+	require.Equal(t, []string{"a", "b"}, u1.Strings)
+	u1 = u1.Update().ClearStrings().AppendStrings([]string{"d"}).SaveX(ctx)
+	require.Equal(t, []string{"d"}, u1.Strings)
+	u1 = u1.Update().AppendStrings([]string{"e"}).RemoveStrings([]string{"e"}).SaveX(ctx)
+	require.Equal(t, []string{"d"}, u1.Strings)
+
+	err := u1.Update().RemoveStrings([]string{"d"}).AppendStrings([]string{"e"}).Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+}
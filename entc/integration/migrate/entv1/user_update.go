@@ -366,28 +366,30 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uu *UserUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := uu.mutation.Name(); ok {
 		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`entv1: validator failed for field "User.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`entv1: validator failed for field "User.name": %w`, err)))
 		}
 	}
 	if v, ok := uu.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv1: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, validatorError("blob", fmt.Errorf(`entv1: validator failed for field "User.blob": %w`, err)))
 		}
 	}
 	if v, ok := uu.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv1: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`entv1: validator failed for field "User.state": %w`, err), "logged_in", "logged_out"))
 		}
 	}
 	if v, ok := uu.mutation.Workplace(); ok {
 		if err := user.WorkplaceValidator(v); err != nil {
-			return &ValidationError{Name: "workplace", err: fmt.Errorf(`entv1: validator failed for field "User.workplace": %w`, err)}
+			errs = append(errs, validatorError("workplace", fmt.Errorf(`entv1: validator failed for field "User.workplace": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -1046,28 +1048,30 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uuo *UserUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := uuo.mutation.Name(); ok {
 		if err := user.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`entv1: validator failed for field "User.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`entv1: validator failed for field "User.name": %w`, err)))
 		}
 	}
 	if v, ok := uuo.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv1: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, validatorError("blob", fmt.Errorf(`entv1: validator failed for field "User.blob": %w`, err)))
 		}
 	}
 	if v, ok := uuo.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv1: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`entv1: validator failed for field "User.state": %w`, err), "logged_in", "logged_out"))
 		}
 	}
 	if v, ok := uuo.mutation.Workplace(); ok {
 		if err := user.WorkplaceValidator(v); err != nil {
-			return &ValidationError{Name: "workplace", err: fmt.Errorf(`entv1: validator failed for field "User.workplace": %w`, err)}
+			errs = append(errs, validatorError("workplace", fmt.Errorf(`entv1: validator failed for field "User.workplace": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
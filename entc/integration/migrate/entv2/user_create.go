@@ -349,57 +349,59 @@ func (uc *UserCreate) defaults() {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uc *UserCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := uc.mutation.MixedString(); !ok {
-		return &ValidationError{Name: "mixed_string", err: errors.New(`entv2: missing required field "User.mixed_string"`)}
+		errs = append(errs, newValidationError("mixed_string", "Required", errors.New(`entv2: missing required field "User.mixed_string"`)))
 	}
 	if _, ok := uc.mutation.MixedEnum(); !ok {
-		return &ValidationError{Name: "mixed_enum", err: errors.New(`entv2: missing required field "User.mixed_enum"`)}
+		errs = append(errs, newValidationError("mixed_enum", "Required", errors.New(`entv2: missing required field "User.mixed_enum"`)))
 	}
 	if v, ok := uc.mutation.MixedEnum(); ok {
 		if err := user.MixedEnumValidator(v); err != nil {
-			return &ValidationError{Name: "mixed_enum", err: fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err)}
+			errs = append(errs, newValidationError("mixed_enum", "Enum", fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err), "on", "off"))
 		}
 	}
 	if _, ok := uc.mutation.Age(); !ok {
-		return &ValidationError{Name: "age", err: errors.New(`entv2: missing required field "User.age"`)}
+		errs = append(errs, newValidationError("age", "Required", errors.New(`entv2: missing required field "User.age"`)))
 	}
 	if _, ok := uc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`entv2: missing required field "User.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`entv2: missing required field "User.name"`)))
 	}
 	if _, ok := uc.mutation.Nickname(); !ok {
-		return &ValidationError{Name: "nickname", err: errors.New(`entv2: missing required field "User.nickname"`)}
+		errs = append(errs, newValidationError("nickname", "Required", errors.New(`entv2: missing required field "User.nickname"`)))
 	}
 	if v, ok := uc.mutation.Nickname(); ok {
 		if err := user.NicknameValidator(v); err != nil {
-			return &ValidationError{Name: "nickname", err: fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)}
+			errs = append(errs, validatorError("nickname", fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)))
 		}
 	}
 	if _, ok := uc.mutation.Phone(); !ok {
-		return &ValidationError{Name: "phone", err: errors.New(`entv2: missing required field "User.phone"`)}
+		errs = append(errs, newValidationError("phone", "Required", errors.New(`entv2: missing required field "User.phone"`)))
 	}
 	if _, ok := uc.mutation.Title(); !ok {
-		return &ValidationError{Name: "title", err: errors.New(`entv2: missing required field "User.title"`)}
+		errs = append(errs, newValidationError("title", "Required", errors.New(`entv2: missing required field "User.title"`)))
 	}
 	if v, ok := uc.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, validatorError("blob", fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)))
 		}
 	}
 	if v, ok := uc.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err), "logged_in", "logged_out", "online"))
 		}
 	}
 	if v, ok := uc.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err)}
+			errs = append(errs, newValidationError("status", "Enum", fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err), "done", "pending"))
 		}
 	}
 	if _, ok := uc.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`entv2: missing required field "User.created_at"`)}
+		errs = append(errs, newValidationError("created_at", "Required", errors.New(`entv2: missing required field "User.created_at"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
@@ -623,6 +625,9 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(ucb.builders))
 	nodes := make([]*User, len(ucb.builders))
 	mutators := make([]Mutator, len(ucb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range ucb.builders {
 		func(i int, root context.Context) {
 			builder := ucb.builders[i]
@@ -633,13 +638,20 @@ func (ucb *UserCreateBulk) Save(ctx context.Context) ([]*User, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, ucb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
@@ -416,33 +416,35 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uu *UserUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := uu.mutation.MixedEnum(); ok {
 		if err := user.MixedEnumValidator(v); err != nil {
-			return &ValidationError{Name: "mixed_enum", err: fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err)}
+			errs = append(errs, newValidationError("mixed_enum", "Enum", fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := uu.mutation.Nickname(); ok {
 		if err := user.NicknameValidator(v); err != nil {
-			return &ValidationError{Name: "nickname", err: fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)}
+			errs = append(errs, validatorError("nickname", fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)))
 		}
 	}
 	if v, ok := uu.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, validatorError("blob", fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)))
 		}
 	}
 	if v, ok := uu.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err), "logged_in", "logged_out", "online"))
 		}
 	}
 	if v, ok := uu.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err)}
+			errs = append(errs, newValidationError("status", "Enum", fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err), "done", "pending"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -1168,33 +1170,35 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uuo *UserUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := uuo.mutation.MixedEnum(); ok {
 		if err := user.MixedEnumValidator(v); err != nil {
-			return &ValidationError{Name: "mixed_enum", err: fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err)}
+			errs = append(errs, newValidationError("mixed_enum", "Enum", fmt.Errorf(`entv2: validator failed for field "User.mixed_enum": %w`, err), "on", "off"))
 		}
 	}
 	if v, ok := uuo.mutation.Nickname(); ok {
 		if err := user.NicknameValidator(v); err != nil {
-			return &ValidationError{Name: "nickname", err: fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)}
+			errs = append(errs, validatorError("nickname", fmt.Errorf(`entv2: validator failed for field "User.nickname": %w`, err)))
 		}
 	}
 	if v, ok := uuo.mutation.Blob(); ok {
 		if err := user.BlobValidator(v); err != nil {
-			return &ValidationError{Name: "blob", err: fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)}
+			errs = append(errs, validatorError("blob", fmt.Errorf(`entv2: validator failed for field "User.blob": %w`, err)))
 		}
 	}
 	if v, ok := uuo.mutation.State(); ok {
 		if err := user.StateValidator(v); err != nil {
-			return &ValidationError{Name: "state", err: fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err)}
+			errs = append(errs, newValidationError("state", "Enum", fmt.Errorf(`entv2: validator failed for field "User.state": %w`, err), "logged_in", "logged_out", "online"))
 		}
 	}
 	if v, ok := uuo.mutation.Status(); ok {
 		if err := user.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err)}
+			errs = append(errs, newValidationError("status", "Enum", fmt.Errorf(`entv2: validator failed for field "User.status": %w`, err), "done", "pending"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
@@ -0,0 +1,113 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/outbox"
+	"entgo.io/ent/entc/integration/outbox/ent"
+	"entgo.io/ent/entc/integration/outbox/ent/user"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutbox(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	var notified int
+	client := ent.NewClient(ent.Driver(drv), ent.OutboxNotify(func() { notified++ }))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	err = client.User.Create().SetName("a8m").Exec(ctx)
+	require.Error(t, err, "mutations must be executed in a transaction")
+
+	var events []*outbox.Event
+	relay := outbox.NewRelay(drv, outbox.DispatchFunc(func(_ context.Context, e *outbox.Event) error {
+		events = append(events, e)
+		return nil
+	}))
+	n, err := relay.Process(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+
+	var a8m *ent.User
+	err = client.WithTx(ctx, func(tx *ent.Tx) error {
+		a8m = tx.User.Create().SetName("a8m").SetAge(30).SetPassword("secret").SaveX(ctx)
+		tx.User.Create().SetName("nati").SetAge(28).ExecX(ctx)
+		tx.User.UpdateOne(a8m).AddAge(1).ExecX(ctx)
+		tx.User.Update().Where(user.AgeLT(31)).ClearAge().ExecX(ctx)
+		tx.User.Delete().Where(user.Name("nati")).ExecX(ctx)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, notified)
+
+	// Rolled back mutations do not write events.
+	err = client.WithTx(ctx, func(tx *ent.Tx) error {
+		tx.User.Create().SetName("rolled").ExecX(ctx)
+		return errors.New("rollback")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, notified)
+
+	n, err = relay.Process(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+	require.Len(t, events, 5)
+	types := make([]string, len(events))
+	for i, e := range events {
+		require.Equal(t, "User", e.Type)
+		types[i] = e.Op
+	}
+	require.Equal(t, []string{"OpCreate", "OpCreate", "OpUpdateOne", "OpUpdate", "OpDelete"}, types)
+
+	// Sensitive fields are recorded without their values.
+	create := events[0]
+	require.Equal(t, json.RawMessage(mustMarshal(t, a8m.ID)), create.EntityID)
+	require.Len(t, create.Changes, 3)
+	require.Equal(t, user.FieldName, create.Changes[0].Field)
+	require.Empty(t, create.Changes[0].Old)
+	require.JSONEq(t, `"a8m"`, string(create.Changes[0].New))
+	require.True(t, create.Changes[2].Sensitive)
+	require.Empty(t, create.Changes[2].New)
+
+	update := events[2]
+	require.Len(t, update.Changes, 1)
+	require.Equal(t, user.FieldAge, update.Changes[0].Field)
+	require.JSONEq(t, "30", string(update.Changes[0].Old))
+	require.JSONEq(t, "31", string(update.Changes[0].New))
+
+	// Bulk update resolves the affected entities ("nati") before the mutation.
+	bulk := events[3]
+	require.Len(t, bulk.Changes, 1)
+	require.JSONEq(t, "28", string(bulk.Changes[0].Old))
+	require.JSONEq(t, "0", string(bulk.Changes[0].New))
+
+	// Deletions record the last values of all fields.
+	deletion := events[4]
+	require.Len(t, deletion.Changes, 3)
+	require.JSONEq(t, `"nati"`, string(deletion.Changes[0].Old))
+	require.Empty(t, deletion.Changes[0].New)
+
+	// Dispatched events are not dispatched again.
+	n, err = relay.Process(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return b
+}
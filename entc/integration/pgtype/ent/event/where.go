@@ -0,0 +1,381 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package event
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/pgtype"
+	"entgo.io/ent/entc/integration/pgtype/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Tags applies equality check predicate on the "tags" field. It's identical to TagsEQ.
+func Tags(v pgtype.StringArray) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTags), v))
+	})
+}
+
+// Scores applies equality check predicate on the "scores" field. It's identical to ScoresEQ.
+func Scores(v pgtype.Int64Array) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScores), v))
+	})
+}
+
+// Weights applies equality check predicate on the "weights" field. It's identical to WeightsEQ.
+func Weights(v pgtype.Float64Array) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWeights), v))
+	})
+}
+
+// Seats applies equality check predicate on the "seats" field. It's identical to SeatsEQ.
+func Seats(v pgtype.Int64Range) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeats), v))
+	})
+}
+
+// During applies equality check predicate on the "during" field. It's identical to DuringEQ.
+func During(v pgtype.TimeRange) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuring), v))
+	})
+}
+
+// TagsEQ applies the EQ predicate on the "tags" field.
+func TagsEQ(v pgtype.StringArray) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTags), v))
+	})
+}
+
+// TagsNEQ applies the NEQ predicate on the "tags" field.
+func TagsNEQ(v pgtype.StringArray) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTags), v))
+	})
+}
+
+// ScoresEQ applies the EQ predicate on the "scores" field.
+func ScoresEQ(v pgtype.Int64Array) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldScores), v))
+	})
+}
+
+// ScoresNEQ applies the NEQ predicate on the "scores" field.
+func ScoresNEQ(v pgtype.Int64Array) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldScores), v))
+	})
+}
+
+// ScoresIsNil applies the IsNil predicate on the "scores" field.
+func ScoresIsNil() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldScores)))
+	})
+}
+
+// ScoresNotNil applies the NotNil predicate on the "scores" field.
+func ScoresNotNil() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldScores)))
+	})
+}
+
+// WeightsEQ applies the EQ predicate on the "weights" field.
+func WeightsEQ(v pgtype.Float64Array) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWeights), v))
+	})
+}
+
+// WeightsNEQ applies the NEQ predicate on the "weights" field.
+func WeightsNEQ(v pgtype.Float64Array) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWeights), v))
+	})
+}
+
+// WeightsIsNil applies the IsNil predicate on the "weights" field.
+func WeightsIsNil() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWeights)))
+	})
+}
+
+// WeightsNotNil applies the NotNil predicate on the "weights" field.
+func WeightsNotNil() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWeights)))
+	})
+}
+
+// SeatsEQ applies the EQ predicate on the "seats" field.
+func SeatsEQ(v pgtype.Int64Range) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsNEQ applies the NEQ predicate on the "seats" field.
+func SeatsNEQ(v pgtype.Int64Range) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsIsNil applies the IsNil predicate on the "seats" field.
+func SeatsIsNil() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldSeats)))
+	})
+}
+
+// SeatsNotNil applies the NotNil predicate on the "seats" field.
+func SeatsNotNil() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldSeats)))
+	})
+}
+
+// DuringEQ applies the EQ predicate on the "during" field.
+func DuringEQ(v pgtype.TimeRange) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuring), v))
+	})
+}
+
+// DuringNEQ applies the NEQ predicate on the "during" field.
+func DuringNEQ(v pgtype.TimeRange) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDuring), v))
+	})
+}
+
+// TagsContains applies the Contains predicate on the "tags" field.
+// It matches arrays that contain all the given elements.
+func TagsContains(vs ...string) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayContains(s.C(FieldTags), pgtype.StringArray(vs)))
+	})
+}
+
+// TagsOverlaps applies the Overlaps predicate on the "tags" field.
+// It matches arrays that have at least one element in common with the given elements.
+func TagsOverlaps(vs ...string) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayOverlaps(s.C(FieldTags), pgtype.StringArray(vs)))
+	})
+}
+
+// TagsAnyEQ applies the AnyEQ predicate on the "tags" field.
+// It matches arrays that contain the given element.
+func TagsAnyEQ(v string) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayAnyEQ(s.C(FieldTags), v))
+	})
+}
+
+// ScoresContains applies the Contains predicate on the "scores" field.
+// It matches arrays that contain all the given elements.
+func ScoresContains(vs ...int64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayContains(s.C(FieldScores), pgtype.Int64Array(vs)))
+	})
+}
+
+// ScoresOverlaps applies the Overlaps predicate on the "scores" field.
+// It matches arrays that have at least one element in common with the given elements.
+func ScoresOverlaps(vs ...int64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayOverlaps(s.C(FieldScores), pgtype.Int64Array(vs)))
+	})
+}
+
+// ScoresAnyEQ applies the AnyEQ predicate on the "scores" field.
+// It matches arrays that contain the given element.
+func ScoresAnyEQ(v int64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayAnyEQ(s.C(FieldScores), v))
+	})
+}
+
+// WeightsContains applies the Contains predicate on the "weights" field.
+// It matches arrays that contain all the given elements.
+func WeightsContains(vs ...float64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayContains(s.C(FieldWeights), pgtype.Float64Array(vs)))
+	})
+}
+
+// WeightsOverlaps applies the Overlaps predicate on the "weights" field.
+// It matches arrays that have at least one element in common with the given elements.
+func WeightsOverlaps(vs ...float64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayOverlaps(s.C(FieldWeights), pgtype.Float64Array(vs)))
+	})
+}
+
+// WeightsAnyEQ applies the AnyEQ predicate on the "weights" field.
+// It matches arrays that contain the given element.
+func WeightsAnyEQ(v float64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.ArrayAnyEQ(s.C(FieldWeights), v))
+	})
+}
+
+// SeatsContains applies the Contains predicate on the "seats" field.
+// It matches ranges that contain the given element.
+func SeatsContains(v int64) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.RangeContains(s.C(FieldSeats), v))
+	})
+}
+
+// SeatsOverlaps applies the Overlaps predicate on the "seats" field.
+// It matches ranges that have points in common with the given range.
+func SeatsOverlaps(r pgtype.Int64Range) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.RangeOverlaps(s.C(FieldSeats), r))
+	})
+}
+
+// DuringContains applies the Contains predicate on the "during" field.
+// It matches ranges that contain the given element.
+func DuringContains(v time.Time) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.RangeContains(s.C(FieldDuring), v))
+	})
+}
+
+// DuringOverlaps applies the Overlaps predicate on the "during" field.
+// It matches ranges that have points in common with the given range.
+func DuringOverlaps(r pgtype.TimeRange) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s.Where(pgtype.RangeOverlaps(s.C(FieldDuring), r))
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Event) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Event) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Event) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
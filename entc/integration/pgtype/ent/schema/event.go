@@ -0,0 +1,30 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql/pgtype"
+	"entgo.io/ent/schema/field"
+)
+
+// Event holds the schema definition for the Event entity.
+type Event struct {
+	ent.Schema
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.Other("tags", pgtype.StringArray{}),
+		field.Other("scores", pgtype.Int64Array{}).
+			Optional(),
+		field.Other("weights", pgtype.Float64Array{}).
+			Optional(),
+		field.Other("seats", pgtype.Int64Range{}).
+			Optional(),
+		field.Other("during", pgtype.TimeRange{}),
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package pgtype
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/pgtype"
+	"entgo.io/ent/entc/integration/pgtype/ent/event"
+	"entgo.io/ent/entc/integration/pgtype/ent/migrate"
+	"entgo.io/ent/entc/integration/pgtype/ent/predicate"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredicates(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		p         predicate.Event
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			p:         event.TagsContains("a", "b"),
+			wantQuery: `SELECT * FROM "events" WHERE "events"."tags" @> $1`,
+			wantArgs:  []interface{}{pgtype.StringArray{"a", "b"}},
+		},
+		{
+			p:         event.WeightsOverlaps(0.5, 1),
+			wantQuery: `SELECT * FROM "events" WHERE "events"."weights" && $1`,
+			wantArgs:  []interface{}{pgtype.Float64Array{0.5, 1}},
+		},
+		{
+			p:         event.ScoresAnyEQ(1),
+			wantQuery: `SELECT * FROM "events" WHERE $1 = ANY("events"."scores")`,
+			wantArgs:  []interface{}{int64(1)},
+		},
+		{
+			p:         event.SeatsOverlaps(pgtype.Int64Range{Lower: 1, Upper: 10}),
+			wantQuery: `SELECT * FROM "events" WHERE "events"."seats" && $1`,
+			wantArgs:  []interface{}{pgtype.Int64Range{Lower: 1, Upper: 10}},
+		},
+		{
+			p:         event.DuringContains(now),
+			wantQuery: `SELECT * FROM "events" WHERE "events"."during" @> $1::timestamptz`,
+			wantArgs:  []interface{}{now},
+		},
+	}
+	for _, tt := range tests {
+		s := sql.Dialect(dialect.Postgres).Select("*").From(sql.Table(event.Table))
+		tt.p(s)
+		query, args := s.Query()
+		require.Equal(t, tt.wantQuery, query)
+		require.Equal(t, tt.wantArgs, args)
+	}
+}
+
+func TestSchemaTypes(t *testing.T) {
+	types := make(map[string]string)
+	for _, c := range migrate.EventsTable.Columns {
+		types[c.Name] = c.SchemaType[dialect.Postgres]
+	}
+	require.Equal(t, "text[]", types[event.FieldTags])
+	require.Equal(t, "bigint[]", types[event.FieldScores])
+	require.Equal(t, "double precision[]", types[event.FieldWeights])
+	require.Equal(t, "int8range", types[event.FieldSeats])
+	require.Equal(t, "tstzrange", types[event.FieldDuring])
+}
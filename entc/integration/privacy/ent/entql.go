@@ -36,6 +36,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			task.FieldDescription: {Type: field.TypeString, Column: task.FieldDescription},
 			task.FieldStatus:      {Type: field.TypeEnum, Column: task.FieldStatus},
 			task.FieldUUID:        {Type: field.TypeUUID, Column: task.FieldUUID},
+			task.FieldLabels:      {Type: field.TypeJSON, Column: task.FieldLabels},
 		},
 	}
 	graph.Nodes[1] = &sqlgraph.Node{
@@ -63,8 +64,9 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "User",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			user.FieldName: {Type: field.TypeString, Column: user.FieldName},
-			user.FieldAge:  {Type: field.TypeUint, Column: user.FieldAge},
+			user.FieldName:  {Type: field.TypeString, Column: user.FieldName},
+			user.FieldAge:   {Type: field.TypeUint, Column: user.FieldAge},
+			user.FieldPhone: {Type: field.TypeString, Column: user.FieldPhone},
 		},
 	}
 	graph.MustAddE(
@@ -155,7 +157,52 @@ func (tq *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the TaskQuery builder.
 func (tq *TaskQuery) Filter() *TaskFilter {
-	return &TaskFilter{tq}
+	return &TaskFilter{predicateAdder: tq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// TaskQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (tq *TaskQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[0].Type, q, allow); err != nil {
+		return err
+	}
+	tq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the TaskQuery builder.
+func (tq *TaskQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		tq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		tq.Offset(q.Offset)
+	}
+	tq.fields = append(tq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case task.EdgeTeams:
+			tq.WithTeams(func(query *TeamQuery) { query.applyQuery(wq) })
+		case task.EdgeOwner:
+			tq.WithOwner(func(query *UserQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -165,16 +212,26 @@ func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the TaskMutation builder.
 func (m *TaskMutation) Filter() *TaskFilter {
-	return &TaskFilter{m}
+	return &TaskFilter{predicateAdder: m}
 }
 
 // TaskFilter provides a generic filtering capability at runtime for TaskQuery.
 type TaskFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *TaskFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *TaskFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
 			s.AddError(err)
@@ -182,6 +239,19 @@ func (f *TaskFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *TaskFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[0].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *TaskFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(task.FieldID))
@@ -207,6 +277,11 @@ func (f *TaskFilter) WhereUUID(p entql.ValueP) {
 	f.Where(p.Field(task.FieldUUID))
 }
 
+// WhereLabels applies the entql json.RawMessage predicate on the labels field.
+func (f *TaskFilter) WhereLabels(p entql.BytesP) {
+	f.Where(p.Field(task.FieldLabels))
+}
+
 // WhereHasTeams applies a predicate to check if query has an edge teams.
 func (f *TaskFilter) WhereHasTeams() {
 	f.Where(entql.HasEdge("teams"))
@@ -242,7 +317,52 @@ func (tq *TeamQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the TeamQuery builder.
 func (tq *TeamQuery) Filter() *TeamFilter {
-	return &TeamFilter{tq}
+	return &TeamFilter{predicateAdder: tq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// TeamQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (tq *TeamQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[1].Type, q, allow); err != nil {
+		return err
+	}
+	tq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the TeamQuery builder.
+func (tq *TeamQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		tq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		tq.Offset(q.Offset)
+	}
+	tq.fields = append(tq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case team.EdgeTasks:
+			tq.WithTasks(func(query *TaskQuery) { query.applyQuery(wq) })
+		case team.EdgeUsers:
+			tq.WithUsers(func(query *UserQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -252,16 +372,26 @@ func (m *TeamMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the TeamMutation builder.
 func (m *TeamMutation) Filter() *TeamFilter {
-	return &TeamFilter{m}
+	return &TeamFilter{predicateAdder: m}
 }
 
 // TeamFilter provides a generic filtering capability at runtime for TeamQuery.
 type TeamFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *TeamFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *TeamFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
@@ -269,6 +399,19 @@ func (f *TeamFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *TeamFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[1].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *TeamFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(team.FieldID))
@@ -314,7 +457,52 @@ func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the UserQuery builder.
 func (uq *UserQuery) Filter() *UserFilter {
-	return &UserFilter{uq}
+	return &UserFilter{predicateAdder: uq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// UserQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (uq *UserQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[2].Type, q, allow); err != nil {
+		return err
+	}
+	uq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the UserQuery builder.
+func (uq *UserQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		uq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		uq.Offset(q.Offset)
+	}
+	uq.fields = append(uq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case user.EdgeTeams:
+			uq.WithTeams(func(query *TeamQuery) { query.applyQuery(wq) })
+		case user.EdgeTasks:
+			uq.WithTasks(func(query *TaskQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -324,16 +512,26 @@ func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
 func (m *UserMutation) Filter() *UserFilter {
-	return &UserFilter{m}
+	return &UserFilter{predicateAdder: m}
 }
 
 // UserFilter provides a generic filtering capability at runtime for UserQuery.
 type UserFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *UserFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
@@ -341,6 +539,19 @@ func (f *UserFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *UserFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[2].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *UserFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(user.FieldID))
@@ -356,6 +567,11 @@ func (f *UserFilter) WhereAge(p entql.UintP) {
 	f.Where(p.Field(user.FieldAge))
 }
 
+// WherePhone applies the entql string predicate on the phone field.
+func (f *UserFilter) WherePhone(p entql.StringP) {
+	f.Where(p.Field(user.FieldPhone))
+}
+
 // WhereHasTeams applies a predicate to check if query has an edge teams.
 func (f *UserFilter) WhereHasTeams() {
 	f.Where(entql.HasEdge("teams"))
@@ -45,6 +45,9 @@ type TaskMutation struct {
 	description   *string
 	status        *task.Status
 	uuid          *uuid.UUID
+	labels        *[]string
+	appendlabels  []string
+	removelabels  []string
 	clearedFields map[string]struct{}
 	teams         map[int]struct{}
 	removedteams  map[int]struct{}
@@ -324,6 +327,118 @@ func (m *TaskMutation) ResetUUID() {
 	delete(m.clearedFields, task.FieldUUID)
 }
 
+// SetLabels sets the "labels" field.
+func (m *TaskMutation) SetLabels(s []string) {
+	m.labels = &s
+	m.appendlabels = nil
+	m.removelabels = nil
+}
+
+// Labels returns the value of the "labels" field in the mutation.
+func (m *TaskMutation) Labels() (r []string, exists bool) {
+	v := m.labels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabels returns the old "labels" field's value of the Task entity.
+// If the Task object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaskMutation) OldLabels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabels: %w", err)
+	}
+	return oldValue.Labels, nil
+}
+
+// AppendLabels adds v to the "labels" field.
+func (m *TaskMutation) AppendLabels(v []string) {
+	if m.labels != nil {
+		*m.labels = append(append([]string(nil), *m.labels...), v...)
+		return
+	}
+	if m.LabelsCleared() {
+		delete(m.clearedFields, task.FieldLabels)
+		vs := append([]string(nil), v...)
+		m.labels = &vs
+		return
+	}
+	m.appendlabels = append(m.appendlabels, v...)
+}
+
+// AppendedLabels returns the list of values that were appended to the "labels" field in this mutation.
+func (m *TaskMutation) AppendedLabels() ([]string, bool) {
+	if len(m.appendlabels) == 0 {
+		return nil, false
+	}
+	return m.appendlabels, true
+}
+
+// RemoveLabels removes all occurrences of v from the "labels" field.
+func (m *TaskMutation) RemoveLabels(v []string) {
+	without := func(vs []string) []string {
+		r := make([]string, 0, len(vs))
+		for i := range vs {
+			keep := true
+			for j := 0; keep && j < len(v); j++ {
+				keep = vs[i] != v[j]
+			}
+			if keep {
+				r = append(r, vs[i])
+			}
+		}
+		return r
+	}
+	if m.labels != nil {
+		*m.labels = without(*m.labels)
+		return
+	}
+	if m.LabelsCleared() {
+		return
+	}
+	m.appendlabels = without(m.appendlabels)
+	m.removelabels = append(m.removelabels, v...)
+}
+
+// RemovedLabels returns the list of values that were removed from the "labels" field in this mutation.
+func (m *TaskMutation) RemovedLabels() ([]string, bool) {
+	if len(m.removelabels) == 0 {
+		return nil, false
+	}
+	return m.removelabels, true
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (m *TaskMutation) ClearLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	m.removelabels = nil
+	m.clearedFields[task.FieldLabels] = struct{}{}
+}
+
+// LabelsCleared returns if the "labels" field was cleared in this mutation.
+func (m *TaskMutation) LabelsCleared() bool {
+	_, ok := m.clearedFields[task.FieldLabels]
+	return ok
+}
+
+// ResetLabels resets all changes to the "labels" field.
+func (m *TaskMutation) ResetLabels() {
+	m.labels = nil
+	m.appendlabels = nil
+	m.removelabels = nil
+	delete(m.clearedFields, task.FieldLabels)
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by ids.
 func (m *TaskMutation) AddTeamIDs(ids ...int) {
 	if m.teams == nil {
@@ -435,8 +550,12 @@ func (m *TaskMutation) Type() string {
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
+//
+// Fields that values were only appended to or removed from are also returned,
+// but their values are available only using the Appended<F> and Removed<F>
+// methods, and not using the Field method.
 func (m *TaskMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.title != nil {
 		fields = append(fields, task.FieldTitle)
 	}
@@ -449,6 +568,9 @@ func (m *TaskMutation) Fields() []string {
 	if m.uuid != nil {
 		fields = append(fields, task.FieldUUID)
 	}
+	if m.labels != nil || len(m.appendlabels) > 0 || len(m.removelabels) > 0 {
+		fields = append(fields, task.FieldLabels)
+	}
 	return fields
 }
 
@@ -465,6 +587,8 @@ func (m *TaskMutation) Field(name string) (ent.Value, bool) {
 		return m.Status()
 	case task.FieldUUID:
 		return m.UUID()
+	case task.FieldLabels:
+		return m.Labels()
 	}
 	return nil, false
 }
@@ -482,6 +606,8 @@ func (m *TaskMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldStatus(ctx)
 	case task.FieldUUID:
 		return m.OldUUID(ctx)
+	case task.FieldLabels:
+		return m.OldLabels(ctx)
 	}
 	return nil, fmt.Errorf("unknown Task field %s", name)
 }
@@ -519,6 +645,13 @@ func (m *TaskMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUUID(v)
 		return nil
+	case task.FieldLabels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabels(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Task field %s", name)
 }
@@ -555,6 +688,9 @@ func (m *TaskMutation) ClearedFields() []string {
 	if m.FieldCleared(task.FieldUUID) {
 		fields = append(fields, task.FieldUUID)
 	}
+	if m.FieldCleared(task.FieldLabels) {
+		fields = append(fields, task.FieldLabels)
+	}
 	return fields
 }
 
@@ -575,6 +711,9 @@ func (m *TaskMutation) ClearField(name string) error {
 	case task.FieldUUID:
 		m.ClearUUID()
 		return nil
+	case task.FieldLabels:
+		m.ClearLabels()
+		return nil
 	}
 	return fmt.Errorf("unknown Task nullable field %s", name)
 }
@@ -595,6 +734,9 @@ func (m *TaskMutation) ResetField(name string) error {
 	case task.FieldUUID:
 		m.ResetUUID()
 		return nil
+	case task.FieldLabels:
+		m.ResetLabels()
+		return nil
 	}
 	return fmt.Errorf("unknown Task field %s", name)
 }
@@ -1197,6 +1339,7 @@ type UserMutation struct {
 	name          *string
 	age           *uint
 	addage        *int
+	phone         *string
 	clearedFields map[string]struct{}
 	teams         map[int]struct{}
 	removedteams  map[int]struct{}
@@ -1413,6 +1556,55 @@ func (m *UserMutation) ResetAge() {
 	delete(m.clearedFields, user.FieldAge)
 }
 
+// SetPhone sets the "phone" field.
+func (m *UserMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *UserMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (m *UserMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[user.FieldPhone] = struct{}{}
+}
+
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *UserMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[user.FieldPhone]
+	return ok
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *UserMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, user.FieldPhone)
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by ids.
 func (m *UserMutation) AddTeamIDs(ids ...int) {
 	if m.teams == nil {
@@ -1540,13 +1732,16 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 3)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
 	if m.age != nil {
 		fields = append(fields, user.FieldAge)
 	}
+	if m.phone != nil {
+		fields = append(fields, user.FieldPhone)
+	}
 	return fields
 }
 
@@ -1559,6 +1754,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Name()
 	case user.FieldAge:
 		return m.Age()
+	case user.FieldPhone:
+		return m.Phone()
 	}
 	return nil, false
 }
@@ -1572,6 +1769,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldName(ctx)
 	case user.FieldAge:
 		return m.OldAge(ctx)
+	case user.FieldPhone:
+		return m.OldPhone(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -1595,6 +1794,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAge(v)
 		return nil
+	case user.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -1643,6 +1849,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldAge) {
 		fields = append(fields, user.FieldAge)
 	}
+	if m.FieldCleared(user.FieldPhone) {
+		fields = append(fields, user.FieldPhone)
+	}
 	return fields
 }
 
@@ -1660,6 +1869,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldAge:
 		m.ClearAge()
 		return nil
+	case user.FieldPhone:
+		m.ClearPhone()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -1674,6 +1886,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldAge:
 		m.ResetAge()
 		return nil
+	case user.FieldPhone:
+		m.ResetPhone()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -9,6 +9,7 @@ package privacy
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"entgo.io/ent/entc/integration/privacy/ent"
 	"entgo.io/ent/entql"
@@ -56,6 +57,14 @@ func DecisionFromContext(ctx context.Context) (error, bool) {
 	return privacy.DecisionFromContext(ctx)
 }
 
+// Trace records the evaluation of privacy policies and their rules.
+type Trace = privacy.Trace
+
+// TraceContext returns a new context from the given parent context with the trace attached to it.
+func TraceContext(parent context.Context, t *Trace) context.Context {
+	return privacy.TraceContext(parent, t)
+}
+
 type (
 	// QueryRule defines the interface deciding whether a
 	// query is allowed and optionally modify it.
@@ -90,10 +99,22 @@ func (f MutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) erro
 	return f(ctx, m)
 }
 
-// Policy groups query and mutation policies.
+type (
+	// LoadRule defines the interface deciding whether the
+	// loaded entities are visible to the viewer.
+	LoadRule = privacy.LoadRule
+	// LoadPolicy combines multiple load rules into a single policy.
+	LoadPolicy = privacy.LoadPolicy
+	// LoadRuleFunc type is an adapter to allow the use of
+	// ordinary functions as load rules.
+	LoadRuleFunc = privacy.LoadRuleFunc
+)
+
+// Policy groups query, mutation and load policies.
 type Policy struct {
 	Query    QueryPolicy
 	Mutation MutationPolicy
+	Load     LoadPolicy
 }
 
 // EvalQuery forwards evaluation to query a policy.
@@ -106,6 +127,45 @@ func (policy Policy) EvalMutation(ctx context.Context, m ent.Mutation) error {
 	return policy.Mutation.EvalMutation(ctx, m)
 }
 
+// EvalLoad forwards evaluation to load a policy.
+func (policy Policy) EvalLoad(ctx context.Context, nodes []ent.Value) ([]error, error) {
+	return policy.Load.EvalLoad(ctx, nodes)
+}
+
+// HasLoadRules reports if the policy has load rules.
+func (policy Policy) HasLoadRules() bool {
+	return policy.Load.HasLoadRules()
+}
+
+var (
+	// Omit may be returned by read rules to indicate that
+	// the field should be omitted from the query.
+	Omit = privacy.Omit
+
+	// Null may be returned by read rules to indicate that
+	// the field should be returned as null.
+	Null = privacy.Null
+)
+
+// Mask returns a read decision for masking the value of
+// the field using the given function.
+func Mask(fn func(ent.Value) ent.Value) error {
+	return privacy.Mask(fn)
+}
+
+type (
+	// ReadRule defines the interface deciding how a field is read.
+	ReadRule = privacy.ReadRule
+	// ReadPolicy combines multiple read rules into a single policy.
+	ReadPolicy = privacy.ReadPolicy
+	// ReadRuleFunc type is an adapter to allow the use of
+	// ordinary functions as read rules.
+	ReadRuleFunc = privacy.ReadRuleFunc
+	// FieldPolicy is a field annotation for defining
+	// field-level read and mutation policies.
+	FieldPolicy = privacy.FieldPolicy
+)
+
 // QueryMutationRule is an interface which groups query and mutation rules.
 type QueryMutationRule interface {
 	QueryRule
@@ -161,6 +221,27 @@ func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
 	})
 }
 
+// EdgeChange describes the changes of a mutation to an edge.
+type EdgeChange = privacy.EdgeChange
+
+// EdgeRule returns a mutation rule that calls the given function only if the
+// mutation changes the given edge. Other mutations are skipped.
+func EdgeRule(edge string, fn func(context.Context, ent.Mutation, EdgeChange) error) MutationRule {
+	return privacy.EdgeRule(edge, fn)
+}
+
+// DenyEdgeAdd returns a mutation rule that denies adding entities to the
+// given edge, unless their IDs are returned by the allowed function.
+func DenyEdgeAdd(edge string, allowed func(context.Context) ([]ent.Value, error)) MutationRule {
+	return privacy.DenyEdgeAdd(edge, allowed)
+}
+
+// DenyEdgeRemove returns a mutation rule that denies removing entities from
+// the given edge, unless their IDs are returned by the allowed function.
+func DenyEdgeRemove(edge string, allowed func(context.Context) ([]ent.Value, error)) MutationRule {
+	return privacy.DenyEdgeRemove(edge, allowed)
+}
+
 // DenyMutationOperationRule returns a rule denying specified mutation operation.
 func DenyMutationOperationRule(op ent.Op) MutationRule {
 	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
@@ -193,6 +274,62 @@ func (f TaskMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskMutation", m)
 }
 
+// The TaskEdgeTeamsRule type is an adapter to allow the use of ordinary functions as mutation
+// rules that are evaluated only when the "teams" edge of Task is changed.
+// The function gets the IDs that were added to, and removed from the edge, and reports if
+// the edge was cleared. Mutations that do not change the edge are skipped.
+type TaskEdgeTeamsRule func(ctx context.Context, m *ent.TaskMutation, added, removed []int, cleared bool) error
+
+// EvalMutation calls f(ctx, m, added, removed, cleared) if the mutation changes the edge.
+func (f TaskEdgeTeamsRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	mm, ok := m.(*ent.TaskMutation)
+	if !ok {
+		return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskMutation", m)
+	}
+	added, removed, cleared := mm.TeamsIDs(), mm.RemovedTeamsIDs(), mm.TeamsCleared()
+	if len(added) == 0 && len(removed) == 0 && !cleared {
+		return Skip
+	}
+	return f(ctx, mm, added, removed, cleared)
+}
+
+// The TaskEdgeOwnerRule type is an adapter to allow the use of ordinary functions as mutation
+// rules that are evaluated only when the "owner" edge of Task is changed.
+// The function gets the IDs that were added to, and removed from the edge, and reports if
+// the edge was cleared. Mutations that do not change the edge are skipped.
+type TaskEdgeOwnerRule func(ctx context.Context, m *ent.TaskMutation, added, removed []int, cleared bool) error
+
+// EvalMutation calls f(ctx, m, added, removed, cleared) if the mutation changes the edge.
+func (f TaskEdgeOwnerRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	mm, ok := m.(*ent.TaskMutation)
+	if !ok {
+		return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskMutation", m)
+	}
+	var removed []int
+	added, cleared := mm.OwnerIDs(), mm.OwnerCleared()
+	if len(added) == 0 && len(removed) == 0 && !cleared {
+		return Skip
+	}
+	return f(ctx, mm, added, removed, cleared)
+}
+
+// The TaskLoadRuleFunc type is an adapter to allow the use of ordinary
+// functions as a load rule.
+type TaskLoadRuleFunc func(context.Context, []*ent.Task) ([]error, error)
+
+// EvalLoad calls f(ctx, nodes).
+func (f TaskLoadRuleFunc) EvalLoad(ctx context.Context, values []ent.Value) ([]error, error) {
+	nodes := make([]*ent.Task, len(values))
+	for i, v := range values {
+		n, ok := v.(*ent.Task)
+		if !ok {
+			return nil, Denyf("ent/privacy: unexpected node type %T, expect *ent.Task", v)
+		}
+		nodes[i] = n
+	}
+	return f(ctx, nodes)
+}
+
 // The TeamQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TeamQueryRuleFunc func(context.Context, *ent.TeamQuery) error
@@ -217,6 +354,61 @@ func (f TeamMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TeamMutation", m)
 }
 
+// The TeamEdgeTasksRule type is an adapter to allow the use of ordinary functions as mutation
+// rules that are evaluated only when the "tasks" edge of Team is changed.
+// The function gets the IDs that were added to, and removed from the edge, and reports if
+// the edge was cleared. Mutations that do not change the edge are skipped.
+type TeamEdgeTasksRule func(ctx context.Context, m *ent.TeamMutation, added, removed []int, cleared bool) error
+
+// EvalMutation calls f(ctx, m, added, removed, cleared) if the mutation changes the edge.
+func (f TeamEdgeTasksRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	mm, ok := m.(*ent.TeamMutation)
+	if !ok {
+		return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TeamMutation", m)
+	}
+	added, removed, cleared := mm.TasksIDs(), mm.RemovedTasksIDs(), mm.TasksCleared()
+	if len(added) == 0 && len(removed) == 0 && !cleared {
+		return Skip
+	}
+	return f(ctx, mm, added, removed, cleared)
+}
+
+// The TeamEdgeUsersRule type is an adapter to allow the use of ordinary functions as mutation
+// rules that are evaluated only when the "users" edge of Team is changed.
+// The function gets the IDs that were added to, and removed from the edge, and reports if
+// the edge was cleared. Mutations that do not change the edge are skipped.
+type TeamEdgeUsersRule func(ctx context.Context, m *ent.TeamMutation, added, removed []int, cleared bool) error
+
+// EvalMutation calls f(ctx, m, added, removed, cleared) if the mutation changes the edge.
+func (f TeamEdgeUsersRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	mm, ok := m.(*ent.TeamMutation)
+	if !ok {
+		return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TeamMutation", m)
+	}
+	added, removed, cleared := mm.UsersIDs(), mm.RemovedUsersIDs(), mm.UsersCleared()
+	if len(added) == 0 && len(removed) == 0 && !cleared {
+		return Skip
+	}
+	return f(ctx, mm, added, removed, cleared)
+}
+
+// The TeamLoadRuleFunc type is an adapter to allow the use of ordinary
+// functions as a load rule.
+type TeamLoadRuleFunc func(context.Context, []*ent.Team) ([]error, error)
+
+// EvalLoad calls f(ctx, nodes).
+func (f TeamLoadRuleFunc) EvalLoad(ctx context.Context, values []ent.Value) ([]error, error) {
+	nodes := make([]*ent.Team, len(values))
+	for i, v := range values {
+		n, ok := v.(*ent.Team)
+		if !ok {
+			return nil, Denyf("ent/privacy: unexpected node type %T, expect *ent.Team", v)
+		}
+		nodes[i] = n
+	}
+	return f(ctx, nodes)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
@@ -241,6 +433,61 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
 }
 
+// The UserEdgeTeamsRule type is an adapter to allow the use of ordinary functions as mutation
+// rules that are evaluated only when the "teams" edge of User is changed.
+// The function gets the IDs that were added to, and removed from the edge, and reports if
+// the edge was cleared. Mutations that do not change the edge are skipped.
+type UserEdgeTeamsRule func(ctx context.Context, m *ent.UserMutation, added, removed []int, cleared bool) error
+
+// EvalMutation calls f(ctx, m, added, removed, cleared) if the mutation changes the edge.
+func (f UserEdgeTeamsRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	mm, ok := m.(*ent.UserMutation)
+	if !ok {
+		return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
+	}
+	added, removed, cleared := mm.TeamsIDs(), mm.RemovedTeamsIDs(), mm.TeamsCleared()
+	if len(added) == 0 && len(removed) == 0 && !cleared {
+		return Skip
+	}
+	return f(ctx, mm, added, removed, cleared)
+}
+
+// The UserEdgeTasksRule type is an adapter to allow the use of ordinary functions as mutation
+// rules that are evaluated only when the "tasks" edge of User is changed.
+// The function gets the IDs that were added to, and removed from the edge, and reports if
+// the edge was cleared. Mutations that do not change the edge are skipped.
+type UserEdgeTasksRule func(ctx context.Context, m *ent.UserMutation, added, removed []int, cleared bool) error
+
+// EvalMutation calls f(ctx, m, added, removed, cleared) if the mutation changes the edge.
+func (f UserEdgeTasksRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	mm, ok := m.(*ent.UserMutation)
+	if !ok {
+		return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
+	}
+	added, removed, cleared := mm.TasksIDs(), mm.RemovedTasksIDs(), mm.TasksCleared()
+	if len(added) == 0 && len(removed) == 0 && !cleared {
+		return Skip
+	}
+	return f(ctx, mm, added, removed, cleared)
+}
+
+// The UserLoadRuleFunc type is an adapter to allow the use of ordinary
+// functions as a load rule.
+type UserLoadRuleFunc func(context.Context, []*ent.User) ([]error, error)
+
+// EvalLoad calls f(ctx, nodes).
+func (f UserLoadRuleFunc) EvalLoad(ctx context.Context, values []ent.Value) ([]error, error) {
+	nodes := make([]*ent.User, len(values))
+	for i, v := range values {
+		n, ok := v.(*ent.User)
+		if !ok {
+			return nil, Denyf("ent/privacy: unexpected node type %T, expect *ent.User", v)
+		}
+		nodes[i] = n
+	}
+	return f(ctx, nodes)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -260,7 +507,7 @@ func (f FilterFunc) EvalQuery(ctx context.Context, q ent.Query) error {
 	if err != nil {
 		return err
 	}
-	return f(ctx, fr)
+	return f(ctx, traceFilter(ctx, fr))
 }
 
 // EvalMutation calls f(ctx, q) if the mutation implements the Filter interface, otherwise it is denied.
@@ -269,11 +516,22 @@ func (f FilterFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
 	if err != nil {
 		return err
 	}
-	return f(ctx, fr)
+	return f(ctx, traceFilter(ctx, fr))
 }
 
 var _ QueryMutationRule = FilterFunc(nil)
 
+// traceFilter records the predicates that are applied on the
+// filter in the trace attached to the context, if there is one.
+func traceFilter(ctx context.Context, fr Filter) Filter {
+	if w, ok := fr.(interface{ OnWhere(func(entql.P)) }); ok && privacy.TraceFromContext(ctx) != nil {
+		w.OnWhere(func(p entql.P) {
+			privacy.TraceFilter(ctx, p)
+		})
+	}
+	return fr
+}
+
 func queryFilter(q ent.Query) (Filter, error) {
 	switch q := q.(type) {
 	case *ent.TaskQuery:
@@ -299,3 +557,197 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return nil, Denyf("ent/privacy: unexpected mutation type %T for mutation filter", m)
 	}
 }
+
+// ViewerIDFunc extracts the ID of the viewer from the context,
+// and reports false if the context does not hold a viewer.
+type ViewerIDFunc func(context.Context) (ent.Value, bool)
+
+// EdgePathRule returns a rule that limits the viewer to the nodes that are connected to it by one of the
+// given edge paths. A path is a dot-separated list of edge names (e.g. "owner" or "team.members") leading
+// from the node to the viewer, and the ID of the viewer is extracted from the context using the given function.
+//
+// Queries, and update and delete mutations are filtered to the nodes that are connected to the viewer, and
+// skipped to the next rule. Create mutations are skipped only if the created node is connected to the viewer
+// by its new edges, and denied otherwise. Contexts without a viewer are denied. For example:
+//
+//	privacy.Policy{
+//		Query: privacy.QueryPolicy{
+//			privacy.EdgePathRule(viewer.IDFromContext, "owner", "team.members"),
+//		},
+//		Mutation: privacy.MutationPolicy{
+//			privacy.EdgePathRule(viewer.IDFromContext, "owner"),
+//		},
+//	}
+//
+func EdgePathRule(viewer ViewerIDFunc, paths ...string) QueryMutationRule {
+	return edgePathRule{viewer: viewer, paths: paths}
+}
+
+type edgePathRule struct {
+	viewer ViewerIDFunc
+	paths  []string
+}
+
+// EvalQuery filters the query to the nodes that are connected to the viewer.
+func (r edgePathRule) EvalQuery(ctx context.Context, q ent.Query) error {
+	fr, err := queryFilter(q)
+	if err != nil {
+		return err
+	}
+	return r.filter(ctx, queryType(q), traceFilter(ctx, fr))
+}
+
+// EvalMutation filters the updated or deleted nodes to the nodes that are connected
+// to the viewer, or checks that the created node is connected to the viewer.
+func (r edgePathRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if !m.Op().Is(ent.OpCreate) {
+		fr, err := mutationFilter(m)
+		if err != nil {
+			return err
+		}
+		return r.filter(ctx, m.Type(), traceFilter(ctx, fr))
+	}
+	id, ok := r.viewer(ctx)
+	if !ok {
+		return Denyf("ent/privacy: missing viewer in context")
+	}
+	for _, path := range r.paths {
+		connected, err := createdOnPath(ctx, m, strings.Split(path, "."), id)
+		if err != nil {
+			return err
+		}
+		if connected {
+			return Skip
+		}
+	}
+	return Denyf("ent/privacy: created %s is not connected to the viewer by %s", m.Type(), strings.Join(r.paths, ", "))
+}
+
+// filter applies the edge paths predicate on the filter of the given node type.
+func (r edgePathRule) filter(ctx context.Context, typ string, fr Filter) error {
+	id, ok := r.viewer(ctx)
+	if !ok {
+		return Denyf("ent/privacy: missing viewer in context")
+	}
+	var preds []entql.P
+	for _, path := range r.paths {
+		p, err := edgePathP(typ, strings.Split(path, "."), id)
+		if err != nil {
+			return err
+		}
+		preds = append(preds, p)
+	}
+	switch len(preds) {
+	case 0:
+		return Denyf("ent/privacy: missing edge paths for %s", typ)
+	case 1:
+		fr.Where(preds[0])
+	default:
+		fr.Where(entql.Or(preds[0], preds[1], preds[2:]...))
+	}
+	return Skip
+}
+
+// createdOnPath reports if the node that is created by the mutation
+// is connected to the given id by its first edge in the path.
+func createdOnPath(ctx context.Context, m ent.Mutation, path []string, id ent.Value) (bool, error) {
+	to, ok := pathNodes[m.Type()].edges[path[0]]
+	if !ok {
+		return false, Denyf("ent/privacy: edge %q was not found for %s", path[0], m.Type())
+	}
+	ids := m.AddedIDs(path[0])
+	if len(ids) == 0 {
+		return false, nil
+	}
+	if len(path) == 1 {
+		for i := range ids {
+			if ids[i] == id {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	p, err := edgePathP(to, path[1:], id)
+	if err != nil {
+		return false, err
+	}
+	c, ok := m.(interface{ Client() *ent.Client })
+	if !ok {
+		return false, Denyf("ent/privacy: unexpected mutation type %T", m)
+	}
+	vs := make([]interface{}, len(ids))
+	for i := range ids {
+		vs[i] = ids[i]
+	}
+	// The neighbors are checked regardless of their own privacy policies.
+	n, err := c.Client().Dynamic().
+		Query(to).
+		Where(entql.FieldIn(pathNodes[to].id, vs...), p).
+		Count(DecisionContext(ctx, Allow))
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// edgePathP returns the predicate for the nodes of the given type
+// that are connected to the given id by the edge path.
+func edgePathP(typ string, path []string, id ent.Value) (entql.P, error) {
+	node, ok := pathNodes[typ]
+	if !ok {
+		return nil, Denyf("ent/privacy: unexpected node type %q", typ)
+	}
+	if len(path) == 0 {
+		return entql.FieldEQ(node.id, id), nil
+	}
+	to, ok := node.edges[path[0]]
+	if !ok {
+		return nil, Denyf("ent/privacy: edge %q was not found for %s", path[0], typ)
+	}
+	p, err := edgePathP(to, path[1:], id)
+	if err != nil {
+		return nil, err
+	}
+	return entql.HasEdgeWith(path[0], p), nil
+}
+
+// pathNodes holds the id field and the edges (mapped to their neighbor types) of each node type.
+var pathNodes = map[string]struct {
+	id    string
+	edges map[string]string
+}{
+	"Task": {
+		id: "id",
+		edges: map[string]string{
+			"teams": "Team",
+			"owner": "User",
+		},
+	},
+	"Team": {
+		id: "id",
+		edges: map[string]string{
+			"tasks": "Task",
+			"users": "User",
+		},
+	},
+	"User": {
+		id: "id",
+		edges: map[string]string{
+			"teams": "Team",
+			"tasks": "Task",
+		},
+	},
+}
+
+func queryType(q ent.Query) string {
+	switch q.(type) {
+	case *ent.TaskQuery:
+		return "Task"
+	case *ent.TeamQuery:
+		return "Team"
+	case *ent.UserQuery:
+		return "User"
+	default:
+		return ""
+	}
+}
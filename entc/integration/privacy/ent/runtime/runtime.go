@@ -37,10 +37,19 @@ func init() {
 	task.Hooks[1] = taskHooks[0]
 	taskFields := schema.Task{}.Fields()
 	_ = taskFields
+	task.FieldPolicies = make(map[string]privacy.FieldPolicy, 2)
 	// taskDescTitle is the schema descriptor for title field.
 	taskDescTitle := taskFields[0].Descriptor()
 	// task.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	task.TitleValidator = taskDescTitle.Validators[0].(func(string) error)
+	// taskDescUUID is the schema descriptor for uuid field.
+	taskDescUUID := taskFields[3].Descriptor()
+	// task.FieldPolicies holds the privacy policies of the "uuid" field.
+	task.FieldPolicies[task.FieldUUID] = privacy.FieldPolicyOf(taskDescUUID.Annotations)
+	// taskDescLabels is the schema descriptor for labels field.
+	taskDescLabels := taskFields[4].Descriptor()
+	// task.FieldPolicies holds the privacy policies of the "labels" field.
+	task.FieldPolicies[task.FieldLabels] = privacy.FieldPolicyOf(taskDescLabels.Annotations)
 	teamMixin := schema.Team{}.Mixin()
 	team.Policy = privacy.NewPolicies(teamMixin[0], schema.Team{})
 	team.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -69,10 +78,15 @@ func init() {
 	}
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	user.FieldPolicies = make(map[string]privacy.FieldPolicy, 1)
 	// userDescName is the schema descriptor for name field.
 	userDescName := userFields[0].Descriptor()
 	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescPhone is the schema descriptor for phone field.
+	userDescPhone := userFields[2].Descriptor()
+	// user.FieldPolicies holds the privacy policies of the "phone" field.
+	user.FieldPolicies[user.FieldPhone] = privacy.FieldPolicyOf(userDescPhone.Annotations)
 }
 
 const (
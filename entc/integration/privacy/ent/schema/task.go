@@ -37,7 +37,21 @@ func (Task) Fields() []ent.Field {
 			Values("planned", "in_progress", "closed").
 			Default("planned"),
 		field.UUID("uuid", uuid.UUID{}).
-			Optional(),
+			Optional().
+			Annotations(privacy.FieldPolicy{
+				Mutation: privacy.MutationPolicy{
+					rule.AllowIfAdmin(),
+					privacy.AlwaysDenyRule(),
+				},
+			}),
+		field.Strings("labels").
+			Optional().
+			Annotations(privacy.FieldPolicy{
+				Mutation: privacy.MutationPolicy{
+					rule.AllowIfAdmin(),
+					privacy.AlwaysDenyRule(),
+				},
+			}),
 	}
 }
 
@@ -61,6 +75,8 @@ func (Task) Hooks() []ent.Hook {
 func (Task) Policy() ent.Policy {
 	return privacy.Policy{
 		Mutation: privacy.MutationPolicy{
+			rule.DenyTaskTeamsAddIfNotMember(),
+			rule.DenyTaskOwnerChangeIfNotAdmin(),
 			rule.AllowTaskCreateIfOwner(),
 			rule.DenyIfStatusChangedByOther(),
 			rule.AllowIfViewerInTheSameTeam(),
@@ -69,5 +85,9 @@ func (Task) Policy() ent.Policy {
 		Query: privacy.QueryPolicy{
 			privacy.AlwaysAllowRule(),
 		},
+		Load: privacy.LoadPolicy{
+			rule.AllowTaskLoadIfOwner(),
+			rule.DenyClosedTaskLoadIfNotViewer(),
+		},
 	}
 }
@@ -34,6 +34,14 @@ func (User) Fields() []ent.Field {
 			Unique(),
 		field.Uint("age").
 			Optional(),
+		field.String("phone").
+			Optional().
+			Annotations(privacy.FieldPolicy{
+				Read: privacy.ReadPolicy{
+					rule.AllowReadIfAdmin(),
+					rule.MaskIfViewer(),
+				},
+			}),
 	}
 }
 
@@ -49,6 +57,7 @@ func (User) Policy() ent.Policy {
 	return privacy.Policy{
 		Mutation: privacy.MutationPolicy{
 			rule.AllowUserCreateIfAdmin(),
+			rule.AllowUserUpdateIfEditor(),
 			privacy.AlwaysDenyRule(),
 		},
 		Query: privacy.QueryPolicy{
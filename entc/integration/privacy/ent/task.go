@@ -7,6 +7,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -29,6 +30,8 @@ type Task struct {
 	Status task.Status `json:"status,omitempty"`
 	// UUID holds the value of the "uuid" field.
 	UUID uuid.UUID `json:"uuid,omitempty"`
+	// Labels holds the value of the "labels" field.
+	Labels []string `json:"labels,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TaskQuery when eager-loading is set.
 	Edges      TaskEdges `json:"edges"`
@@ -74,6 +77,8 @@ func (*Task) scanValues(columns []string) ([]interface{}, error) {
 	values := make([]interface{}, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case task.FieldLabels:
+			values[i] = new([]byte)
 		case task.FieldID:
 			values[i] = new(sql.NullInt64)
 		case task.FieldTitle, task.FieldDescription, task.FieldStatus:
@@ -127,6 +132,14 @@ func (t *Task) assignValues(columns []string, values []interface{}) error {
 			} else if value != nil {
 				t.UUID = *value
 			}
+		case task.FieldLabels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field labels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &t.Labels); err != nil {
+					return fmt.Errorf("unmarshal field labels: %w", err)
+				}
+			}
 		case task.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_tasks", value)
@@ -180,6 +193,8 @@ func (t *Task) String() string {
 	builder.WriteString(fmt.Sprintf("%v", t.Status))
 	builder.WriteString(", uuid=")
 	builder.WriteString(fmt.Sprintf("%v", t.UUID))
+	builder.WriteString(", labels=")
+	builder.WriteString(fmt.Sprintf("%v", t.Labels))
 	builder.WriteByte(')')
 	return builder.String()
 }
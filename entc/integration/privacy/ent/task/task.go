@@ -10,6 +10,7 @@ import (
 	"fmt"
 
 	"entgo.io/ent"
+	"entgo.io/ent/privacy"
 )
 
 const (
@@ -25,6 +26,8 @@ const (
 	FieldStatus = "status"
 	// FieldUUID holds the string denoting the uuid field in the database.
 	FieldUUID = "uuid"
+	// FieldLabels holds the string denoting the labels field in the database.
+	FieldLabels = "labels"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
@@ -52,6 +55,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldStatus,
 	FieldUUID,
+	FieldLabels,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "tasks"
@@ -90,6 +94,8 @@ func ValidColumn(column string) bool {
 var (
 	Hooks  [2]ent.Hook
 	Policy ent.Policy
+	// FieldPolicies holds the field-level privacy policies that are declared in the schema.
+	FieldPolicies map[string]privacy.FieldPolicy
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
 )
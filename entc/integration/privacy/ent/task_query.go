@@ -13,13 +13,16 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
 	"entgo.io/ent/entc/integration/privacy/ent/team"
 	"entgo.io/ent/entc/integration/privacy/ent/user"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
 )
 
 // TaskQuery is the builder for querying Task entities.
@@ -31,6 +34,8 @@ type TaskQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.Task
+	// read decisions of the field policies.
+	fieldReads privacy.FieldReads
 	// eager-loading edges.
 	withTeams *TeamQuery
 	withOwner *UserQuery
@@ -234,6 +239,18 @@ func (tq *TaskQuery) AllX(ctx context.Context) []*Task {
 
 // IDs executes the query and returns a list of Task IDs.
 func (tq *TaskQuery) IDs(ctx context.Context) ([]int, error) {
+	// Load rules are evaluated on the loaded entities.
+	if privacy.HasLoadRules(task.Policy) {
+		nodes, err := tq.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		return ids, nil
+	}
 	var ids []int
 	if err := tq.Select(task.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
@@ -269,6 +286,13 @@ func (tq *TaskQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (tq *TaskQuery) Exist(ctx context.Context) (bool, error) {
+	if privacy.HasLoadRules(task.Policy) {
+		ids, err := tq.IDs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(ids) > 0, nil
+	}
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
@@ -348,6 +372,9 @@ func (tq *TaskQuery) GroupBy(field string, fields ...string) *TaskGroupBy {
 		if err := tq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if err := tq.fieldReads.Check(group.fields...); err != nil {
+			return nil, err
+		}
 		return tq.sqlQuery(ctx), nil
 	}
 	return group
@@ -390,6 +417,14 @@ func (tq *TaskQuery) prepareQuery(ctx context.Context) error {
 	if err := task.Policy.EvalQuery(ctx, tq); err != nil {
 		return err
 	}
+	if task.FieldPolicies == nil {
+		return errors.New("ent: uninitialized task.FieldPolicies (forgotten import ent/runtime?)")
+	}
+	reads, err := privacy.EvalReads(ctx, task.FieldPolicies)
+	if err != nil {
+		return err
+	}
+	tq.fieldReads = reads
 	return nil
 }
 
@@ -409,6 +444,7 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 	if withFKs {
 		_spec.Node.Columns = append(_spec.Node.Columns, task.ForeignKeys...)
 	}
+	_spec.Node.Columns = tq.fieldReads.Columns(_spec.Node.Columns)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &Task{config: tq.config}
 		nodes = append(nodes, node)
@@ -428,6 +464,20 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	nodes, err := tq.evalLoad(ctx, nodes)
+	if err != nil || len(nodes) == 0 {
+		return nodes, err
+	}
+	if reads := tq.fieldReads; len(reads) > 0 {
+		for _, n := range nodes {
+			if v, ok := reads.Value(task.FieldUUID, n.UUID); ok {
+				n.UUID, _ = v.(uuid.UUID)
+			}
+			if v, ok := reads.Value(task.FieldLabels, n.Labels); ok {
+				n.Labels, _ = v.([]string)
+			}
+		}
+	}
 
 	if query := tq.withTeams; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
@@ -526,6 +576,29 @@ func (tq *TaskQuery) sqlAll(ctx context.Context) ([]*Task, error) {
 	return nodes, nil
 }
 
+// evalLoad evaluates the load policy of the Task type on
+// the loaded nodes, and returns the nodes that are visible to the viewer.
+func (tq *TaskQuery) evalLoad(ctx context.Context, nodes []*Task) ([]*Task, error) {
+	values := make([]ent.Value, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i]
+	}
+	visible, err := privacy.EvalLoad(ctx, task.Policy, values)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return nodes, nil
+	}
+	filtered := nodes[:0]
+	for i := range nodes {
+		if visible[i] {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered, nil
+}
+
 func (tq *TaskQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
 	_spec.Node.Columns = tq.fields
@@ -902,6 +975,18 @@ func (ts *TaskSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
+	if err := ts.fieldReads.Check(ts.fields...); err != nil {
+		return err
+	}
+	// Load rules are evaluated on the loaded entities. Hence, the
+	// selection is limited to the IDs of the visible entities.
+	if privacy.HasLoadRules(task.Policy) {
+		ids, err := ts.TaskQuery.Clone().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		ts.Where(task.IDIn(ids...))
+	}
 	ts.sql = ts.TaskQuery.sqlQuery(ctx)
 	return ts.sqlScan(ctx, v)
 }
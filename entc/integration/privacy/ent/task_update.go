@@ -13,10 +13,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
 	"entgo.io/ent/entc/integration/privacy/ent/team"
 	"entgo.io/ent/entc/integration/privacy/ent/user"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
@@ -86,6 +88,30 @@ func (tu *TaskUpdate) ClearUUID() *TaskUpdate {
 	return tu
 }
 
+// SetLabels sets the "labels" field.
+func (tu *TaskUpdate) SetLabels(s []string) *TaskUpdate {
+	tu.mutation.SetLabels(s)
+	return tu
+}
+
+// AppendLabels appends s to the "labels" field.
+func (tu *TaskUpdate) AppendLabels(s []string) *TaskUpdate {
+	tu.mutation.AppendLabels(s)
+	return tu
+}
+
+// RemoveLabels removes all occurrences of s from the "labels" field.
+func (tu *TaskUpdate) RemoveLabels(s []string) *TaskUpdate {
+	tu.mutation.RemoveLabels(s)
+	return tu
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (tu *TaskUpdate) ClearLabels() *TaskUpdate {
+	tu.mutation.ClearLabels()
+	return tu
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tu *TaskUpdate) AddTeamIDs(ids ...int) *TaskUpdate {
 	tu.mutation.AddTeamIDs(ids...)
@@ -159,7 +185,7 @@ func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
 		affected int
 	)
 	if len(tu.hooks) == 0 {
-		if err = tu.check(); err != nil {
+		if err = tu.check(ctx); err != nil {
 			return 0, err
 		}
 		affected, err = tu.sqlSave(ctx)
@@ -169,7 +195,7 @@ func (tu *TaskUpdate) Save(ctx context.Context) (int, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
-			if err = tu.check(); err != nil {
+			if err = tu.check(ctx); err != nil {
 				return 0, err
 			}
 			tu.mutation = mutation
@@ -213,18 +239,26 @@ func (tu *TaskUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (tu *TaskUpdate) check() error {
+// All failures are collected and returned as ValidationErrors.
+func (tu *TaskUpdate) check(ctx context.Context) error {
+	if task.FieldPolicies == nil {
+		return errors.New("ent: uninitialized task.FieldPolicies (forgotten import ent/runtime?)")
+	}
+	if err := privacy.EvalMutationFields(ctx, task.FieldPolicies, tu.mutation); err != nil {
+		return err
+	}
+	var errs ValidationErrors
 	if v, ok := tu.mutation.Title(); ok {
 		if err := task.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
+			errs = append(errs, validatorError("title", fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)))
 		}
 	}
 	if v, ok := tu.mutation.Status(); ok {
 		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+			errs = append(errs, newValidationError("status", "Enum", fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err), "planned", "in_progress", "closed"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -285,6 +319,32 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: task.FieldUUID,
 		})
 	}
+	if value, ok := tu.mutation.Labels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: task.FieldLabels,
+		})
+	}
+	if value, ok := tu.mutation.RemovedLabels(); ok {
+		if _, ok := tu.mutation.AppendedLabels(); ok {
+			return 0, &ValidationError{Name: "labels", err: errors.New(`ent: cannot append to and remove from "Task.labels" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, task.FieldLabels, value)
+		})
+	}
+	if value, ok := tu.mutation.AppendedLabels(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldLabels, value)
+		})
+	}
+	if tu.mutation.LabelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: task.FieldLabels,
+		})
+	}
 	if tu.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -445,6 +505,30 @@ func (tuo *TaskUpdateOne) ClearUUID() *TaskUpdateOne {
 	return tuo
 }
 
+// SetLabels sets the "labels" field.
+func (tuo *TaskUpdateOne) SetLabels(s []string) *TaskUpdateOne {
+	tuo.mutation.SetLabels(s)
+	return tuo
+}
+
+// AppendLabels appends s to the "labels" field.
+func (tuo *TaskUpdateOne) AppendLabels(s []string) *TaskUpdateOne {
+	tuo.mutation.AppendLabels(s)
+	return tuo
+}
+
+// RemoveLabels removes all occurrences of s from the "labels" field.
+func (tuo *TaskUpdateOne) RemoveLabels(s []string) *TaskUpdateOne {
+	tuo.mutation.RemoveLabels(s)
+	return tuo
+}
+
+// ClearLabels clears the value of the "labels" field.
+func (tuo *TaskUpdateOne) ClearLabels() *TaskUpdateOne {
+	tuo.mutation.ClearLabels()
+	return tuo
+}
+
 // AddTeamIDs adds the "teams" edge to the Team entity by IDs.
 func (tuo *TaskUpdateOne) AddTeamIDs(ids ...int) *TaskUpdateOne {
 	tuo.mutation.AddTeamIDs(ids...)
@@ -525,7 +609,7 @@ func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
 		node *Task
 	)
 	if len(tuo.hooks) == 0 {
-		if err = tuo.check(); err != nil {
+		if err = tuo.check(ctx); err != nil {
 			return nil, err
 		}
 		node, err = tuo.sqlSave(ctx)
@@ -535,7 +619,7 @@ func (tuo *TaskUpdateOne) Save(ctx context.Context) (*Task, error) {
 			if !ok {
 				return nil, fmt.Errorf("unexpected mutation type %T", m)
 			}
-			if err = tuo.check(); err != nil {
+			if err = tuo.check(ctx); err != nil {
 				return nil, err
 			}
 			tuo.mutation = mutation
@@ -579,18 +663,26 @@ func (tuo *TaskUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
-func (tuo *TaskUpdateOne) check() error {
+// All failures are collected and returned as ValidationErrors.
+func (tuo *TaskUpdateOne) check(ctx context.Context) error {
+	if task.FieldPolicies == nil {
+		return errors.New("ent: uninitialized task.FieldPolicies (forgotten import ent/runtime?)")
+	}
+	if err := privacy.EvalMutationFields(ctx, task.FieldPolicies, tuo.mutation); err != nil {
+		return err
+	}
+	var errs ValidationErrors
 	if v, ok := tuo.mutation.Title(); ok {
 		if err := task.TitleValidator(v); err != nil {
-			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)}
+			errs = append(errs, validatorError("title", fmt.Errorf(`ent: validator failed for field "Task.title": %w`, err)))
 		}
 	}
 	if v, ok := tuo.mutation.Status(); ok {
 		if err := task.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err)}
+			errs = append(errs, newValidationError("status", "Enum", fmt.Errorf(`ent: validator failed for field "Task.status": %w`, err), "planned", "in_progress", "closed"))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error) {
@@ -668,6 +760,32 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 			Column: task.FieldUUID,
 		})
 	}
+	if value, ok := tuo.mutation.Labels(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: task.FieldLabels,
+		})
+	}
+	if value, ok := tuo.mutation.RemovedLabels(); ok {
+		if _, ok := tuo.mutation.AppendedLabels(); ok {
+			return nil, &ValidationError{Name: "labels", err: errors.New(`ent: cannot append to and remove from "Task.labels" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, task.FieldLabels, value)
+		})
+	}
+	if value, ok := tuo.mutation.AppendedLabels(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, task.FieldLabels, value)
+		})
+	}
+	if tuo.mutation.LabelsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: task.FieldLabels,
+		})
+	}
 	if tuo.mutation.TeamsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -757,6 +875,11 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	reads, err := privacy.EvalReads(ctx, task.FieldPolicies)
+	if err != nil {
+		return nil, err
+	}
+	_spec.Node.Columns = reads.Columns(_spec.Node.Columns)
 	_node = &Task{config: tuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -768,5 +891,13 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 		}
 		return nil, err
 	}
+	if len(reads) > 0 {
+		if v, ok := reads.Value(task.FieldUUID, _node.UUID); ok {
+			_node.UUID, _ = v.(uuid.UUID)
+		}
+		if v, ok := reads.Value(task.FieldLabels, _node.Labels); ok {
+			_node.Labels, _ = v.([]string)
+		}
+	}
 	return _node, nil
 }
@@ -13,12 +13,14 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
 	"entgo.io/ent/entc/integration/privacy/ent/team"
 	"entgo.io/ent/entc/integration/privacy/ent/user"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
 )
 
@@ -233,6 +235,18 @@ func (tq *TeamQuery) AllX(ctx context.Context) []*Team {
 
 // IDs executes the query and returns a list of Team IDs.
 func (tq *TeamQuery) IDs(ctx context.Context) ([]int, error) {
+	// Load rules are evaluated on the loaded entities.
+	if privacy.HasLoadRules(team.Policy) {
+		nodes, err := tq.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		return ids, nil
+	}
 	var ids []int
 	if err := tq.Select(team.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
@@ -268,6 +282,13 @@ func (tq *TeamQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (tq *TeamQuery) Exist(ctx context.Context) (bool, error) {
+	if privacy.HasLoadRules(team.Policy) {
+		ids, err := tq.IDs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(ids) > 0, nil
+	}
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
@@ -420,6 +441,10 @@ func (tq *TeamQuery) sqlAll(ctx context.Context) ([]*Team, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	nodes, err := tq.evalLoad(ctx, nodes)
+	if err != nil || len(nodes) == 0 {
+		return nodes, err
+	}
 
 	if query := tq.withTasks; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
@@ -554,6 +579,29 @@ func (tq *TeamQuery) sqlAll(ctx context.Context) ([]*Team, error) {
 	return nodes, nil
 }
 
+// evalLoad evaluates the load policy of the Team type on
+// the loaded nodes, and returns the nodes that are visible to the viewer.
+func (tq *TeamQuery) evalLoad(ctx context.Context, nodes []*Team) ([]*Team, error) {
+	values := make([]ent.Value, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i]
+	}
+	visible, err := privacy.EvalLoad(ctx, team.Policy, values)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return nodes, nil
+	}
+	filtered := nodes[:0]
+	for i := range nodes {
+		if visible[i] {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered, nil
+}
+
 func (tq *TeamQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
 	_spec.Node.Columns = tq.fields
@@ -930,6 +978,15 @@ func (ts *TeamSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
+	// Load rules are evaluated on the loaded entities. Hence, the
+	// selection is limited to the IDs of the visible entities.
+	if privacy.HasLoadRules(team.Policy) {
+		ids, err := ts.TeamQuery.Clone().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		ts.Where(team.IDIn(ids...))
+	}
 	ts.sql = ts.TeamQuery.sqlQuery(ctx)
 	return ts.sqlScan(ctx, v)
 }
@@ -177,13 +177,15 @@ func (tu *TeamUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tu *TeamUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := tu.mutation.Name(); ok {
 		if err := team.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tu *TeamUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -489,13 +491,15 @@ func (tuo *TeamUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tuo *TeamUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := tuo.mutation.Name(); ok {
 		if err := team.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Team.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tuo *TeamUpdateOne) sqlSave(ctx context.Context) (_node *Team, err error) {
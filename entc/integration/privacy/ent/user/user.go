@@ -8,6 +8,7 @@ package user
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/privacy"
 )
 
 const (
@@ -19,6 +20,8 @@ const (
 	FieldName = "name"
 	// FieldAge holds the string denoting the age field in the database.
 	FieldAge = "age"
+	// FieldPhone holds the string denoting the phone field in the database.
+	FieldPhone = "phone"
 	// EdgeTeams holds the string denoting the teams edge name in mutations.
 	EdgeTeams = "teams"
 	// EdgeTasks holds the string denoting the tasks edge name in mutations.
@@ -44,6 +47,7 @@ var Columns = []string{
 	FieldID,
 	FieldName,
 	FieldAge,
+	FieldPhone,
 }
 
 var (
@@ -71,6 +75,8 @@ func ValidColumn(column string) bool {
 var (
 	Hooks  [1]ent.Hook
 	Policy ent.Policy
+	// FieldPolicies holds the field-level privacy policies that are declared in the schema.
+	FieldPolicies map[string]privacy.FieldPolicy
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 )
@@ -13,12 +13,14 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/entc/integration/privacy/ent/predicate"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
 	"entgo.io/ent/entc/integration/privacy/ent/team"
 	"entgo.io/ent/entc/integration/privacy/ent/user"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
 )
 
@@ -31,6 +33,8 @@ type UserQuery struct {
 	order      []OrderFunc
 	fields     []string
 	predicates []predicate.User
+	// read decisions of the field policies.
+	fieldReads privacy.FieldReads
 	// eager-loading edges.
 	withTeams *TeamQuery
 	withTasks *TaskQuery
@@ -233,6 +237,18 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 
 // IDs executes the query and returns a list of User IDs.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
+	// Load rules are evaluated on the loaded entities.
+	if privacy.HasLoadRules(user.Policy) {
+		nodes, err := uq.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		return ids, nil
+	}
 	var ids []int
 	if err := uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
@@ -268,6 +284,13 @@ func (uq *UserQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
+	if privacy.HasLoadRules(user.Policy) {
+		ids, err := uq.IDs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(ids) > 0, nil
+	}
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
@@ -347,6 +370,9 @@ func (uq *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
 		if err := uq.prepareQuery(ctx); err != nil {
 			return nil, err
 		}
+		if err := uq.fieldReads.Check(group.fields...); err != nil {
+			return nil, err
+		}
 		return uq.sqlQuery(ctx), nil
 	}
 	return group
@@ -389,6 +415,14 @@ func (uq *UserQuery) prepareQuery(ctx context.Context) error {
 	if err := user.Policy.EvalQuery(ctx, uq); err != nil {
 		return err
 	}
+	if user.FieldPolicies == nil {
+		return errors.New("ent: uninitialized user.FieldPolicies (forgotten import ent/runtime?)")
+	}
+	reads, err := privacy.EvalReads(ctx, user.FieldPolicies)
+	if err != nil {
+		return err
+	}
+	uq.fieldReads = reads
 	return nil
 }
 
@@ -401,6 +435,7 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 			uq.withTasks != nil,
 		}
 	)
+	_spec.Node.Columns = uq.fieldReads.Columns(_spec.Node.Columns)
 	_spec.ScanValues = func(columns []string) ([]interface{}, error) {
 		node := &User{config: uq.config}
 		nodes = append(nodes, node)
@@ -420,6 +455,17 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	nodes, err := uq.evalLoad(ctx, nodes)
+	if err != nil || len(nodes) == 0 {
+		return nodes, err
+	}
+	if reads := uq.fieldReads; len(reads) > 0 {
+		for _, n := range nodes {
+			if v, ok := reads.Value(user.FieldPhone, n.Phone); ok {
+				n.Phone, _ = v.(string)
+			}
+		}
+	}
 
 	if query := uq.withTeams; query != nil {
 		fks := make([]driver.Value, 0, len(nodes))
@@ -518,6 +564,29 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	return nodes, nil
 }
 
+// evalLoad evaluates the load policy of the User type on
+// the loaded nodes, and returns the nodes that are visible to the viewer.
+func (uq *UserQuery) evalLoad(ctx context.Context, nodes []*User) ([]*User, error) {
+	values := make([]ent.Value, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i]
+	}
+	visible, err := privacy.EvalLoad(ctx, user.Policy, values)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return nodes, nil
+	}
+	filtered := nodes[:0]
+	for i := range nodes {
+		if visible[i] {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered, nil
+}
+
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
 	_spec.Node.Columns = uq.fields
@@ -894,6 +963,18 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := us.prepareQuery(ctx); err != nil {
 		return err
 	}
+	if err := us.fieldReads.Check(us.fields...); err != nil {
+		return err
+	}
+	// Load rules are evaluated on the loaded entities. Hence, the
+	// selection is limited to the IDs of the visible entities.
+	if privacy.HasLoadRules(user.Policy) {
+		ids, err := us.UserQuery.Clone().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		us.Where(user.IDIn(ids...))
+	}
 	us.sql = us.UserQuery.sqlQuery(ctx)
 	return us.sqlScan(ctx, v)
 }
@@ -9,12 +9,15 @@ import (
 	"errors"
 	"testing"
 
+	"entgo.io/ent/entc/integration/privacy/ent"
 	"entgo.io/ent/entc/integration/privacy/ent/enttest"
 	"entgo.io/ent/entc/integration/privacy/ent/privacy"
 	"entgo.io/ent/entc/integration/privacy/ent/task"
+	"entgo.io/ent/entc/integration/privacy/ent/user"
 	"entgo.io/ent/entc/integration/privacy/rule"
 	"entgo.io/ent/entc/integration/privacy/viewer"
 
+	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
 )
@@ -80,3 +83,255 @@ func TestPrivacyRules(t *testing.T) {
 	task3.Update().SetDescription("boring description").SaveX(natctx)
 	task3.Update().SetDescription("boring description").SaveX(a8mctx)
 }
+
+func TestLoadPolicies(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:load?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	ctx := context.Background()
+	admin := viewer.NewContext(ctx, viewer.AppViewer{
+		Role: viewer.Admin,
+	})
+	team := client.Team.Create().SetName("ent").SaveX(admin)
+	a8m := client.User.Create().SetName("a8m").AddTeams(team).SaveX(admin)
+	nat := client.User.Create().SetName("nati").AddTeams(team).SaveX(admin)
+	a8mctx := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View | viewer.Edit})
+	natctx := viewer.NewContext(ctx, &viewer.UserViewer{User: nat, Role: viewer.Edit})
+	planned := client.Task.Create().SetTitle("planned").AddTeams(team).SetOwner(a8m).SaveX(a8mctx)
+	closed := client.Task.Create().SetTitle("closed").SetStatus(task.StatusClosed).AddTeams(team).SetOwner(a8m).SaveX(a8mctx)
+	client.Task.Create().SetTitle("nati").SetStatus(task.StatusClosed).AddTeams(team).SetOwner(nat).SaveX(natctx)
+
+	require.Equal(t, 3, client.Task.Query().CountX(natctx), "load policies are not evaluated on count")
+	tasks := client.Task.Query().Order(ent.Asc(task.FieldID)).AllX(natctx)
+	require.Len(t, tasks, 2, "closed tasks of other users are hidden from non-viewers")
+	require.Equal(t, planned.ID, tasks[0].ID)
+	require.Equal(t, "nati", tasks[1].Title)
+	_, err := client.Task.Get(natctx, closed.ID)
+	require.True(t, ent.IsNotFound(err))
+	require.Len(t, client.Task.Query().AllX(a8mctx), 3)
+	require.Len(t, client.Task.Query().AllX(privacy.DecisionContext(natctx, privacy.Allow)), 3)
+
+	// Load policies are also evaluated on queries that do not return entities.
+	require.Equal(t, []int{planned.ID, tasks[1].ID}, client.Task.Query().Order(ent.Asc(task.FieldID)).IDsX(natctx))
+	require.False(t, client.Task.Query().Where(task.ID(closed.ID)).ExistX(natctx))
+	require.True(t, client.Task.Query().Where(task.ID(closed.ID)).ExistX(a8mctx))
+	_, err = client.Task.Query().Where(task.ID(closed.ID)).OnlyID(natctx)
+	require.True(t, ent.IsNotFound(err))
+	titles := client.Task.Query().Order(ent.Asc(task.FieldID)).Select(task.FieldTitle).StringsX(natctx)
+	require.Equal(t, []string{"planned", "nati"}, titles)
+
+	// Load policies are also evaluated on eager-loaded edges.
+	u := client.User.Query().Where(user.ID(a8m.ID)).WithTasks().OnlyX(natctx)
+	require.Len(t, u.Edges.Tasks, 1)
+	require.Equal(t, planned.ID, u.Edges.Tasks[0].ID)
+	u = client.User.Query().Where(user.ID(a8m.ID)).WithTasks().OnlyX(a8mctx)
+	require.Len(t, u.Edges.Tasks, 2)
+}
+
+func TestFieldPolicies(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:fields?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	ctx := context.Background()
+	admin := viewer.NewContext(ctx, viewer.AppViewer{
+		Role: viewer.Admin,
+	})
+	team := client.Team.Create().SetName("ent").SaveX(admin)
+	a8m := client.User.Create().SetName("a8m").SetPhone("+972-50-1234567").AddTeams(team).SaveX(admin)
+
+	// Read policies.
+	u := client.User.GetX(admin, a8m.ID)
+	require.Equal(t, "+972-50-1234567", u.Phone)
+	view := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View})
+	u = client.User.GetX(view, a8m.ID)
+	require.Equal(t, "***********4567", u.Phone, "phone is masked for viewers")
+	require.Equal(t, "a8m", u.Name)
+	edit := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.Edit})
+	u = client.User.GetX(edit, a8m.ID)
+	require.Empty(t, u.Phone, "phone is omitted for non-viewers")
+	u = client.User.GetX(privacy.DecisionContext(edit, privacy.Allow), a8m.ID)
+	require.Equal(t, "+972-50-1234567", u.Phone)
+	_, err := client.User.Query().Select(user.FieldPhone).Strings(view)
+	require.True(t, errors.Is(err, privacy.Deny), "masked fields cannot be selected")
+	names := client.User.Query().Select(user.FieldName).StringsX(view)
+	require.Equal(t, []string{"a8m"}, names)
+	phones := client.User.Query().Select(user.FieldPhone).StringsX(admin)
+	require.Equal(t, []string{"+972-50-1234567"}, phones)
+	u = a8m.Update().SetAge(30).SaveX(viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View | viewer.Edit}))
+	require.Equal(t, "***********4567", u.Phone, "phone is masked for viewers on update")
+	u = a8m.Update().SetAge(31).SaveX(edit)
+	require.Empty(t, u.Phone, "phone is omitted for non-viewers on update")
+	require.Equal(t, "+972-50-1234567", client.User.GetX(admin, a8m.ID).Phone)
+
+	// Mutation policies.
+	a8mctx := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View | viewer.Edit})
+	tk := client.Task.Create().SetTitle("task").AddTeams(team).SetOwner(a8m).SaveX(a8mctx)
+	_, err = client.Task.Create().SetTitle("task").AddTeams(team).SetOwner(a8m).SetUUID(uuid.New()).Save(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can set the task uuid")
+	_, err = tk.Update().SetUUID(uuid.New()).Save(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can change the task uuid")
+	tk = tk.Update().SetDescription("description").SaveX(a8mctx)
+	tk.Update().SetUUID(uuid.New()).ExecX(viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.Admin}))
+	// Appending to (or removing from) a JSON field is a change of the field.
+	err = tk.Update().AppendLabels([]string{"bug"}).Exec(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can append task labels")
+	err = client.Task.Update().RemoveLabels([]string{"bug"}).Exec(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can remove task labels")
+	// Fields that are changed by the ON CONFLICT clause of upserts are checked as well.
+	err = client.Task.Create().SetTitle("upsert").AddTeams(team).SetOwner(a8m).
+		OnConflictColumns(task.FieldID).
+		SetUUID(uuid.New()).
+		Exec(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can change the task uuid on conflict")
+	err = client.Task.CreateBulk(client.Task.Create().SetTitle("upsert").AddTeams(team).SetOwner(a8m)).
+		OnConflictColumns(task.FieldID).
+		UpdateNewValues().
+		ClearLabels().
+		Exec(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can clear task labels on conflict")
+	client.Task.Create().SetTitle("upsert").AddTeams(team).SetOwner(a8m).
+		OnConflictColumns(task.FieldID).
+		UpdateNewValues().
+		ExecX(a8mctx)
+	client.Task.Create().SetTitle("upsert").AddTeams(team).SetOwner(a8m).
+		OnConflictColumns(task.FieldID).
+		Ignore().
+		ExecX(a8mctx)
+}
+
+func TestTrace(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:trace?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	ctx := context.Background()
+	admin := viewer.NewContext(ctx, viewer.AppViewer{
+		Role: viewer.Admin,
+	})
+	team := client.Team.Create().SetName("ent").SaveX(admin)
+	a8m := client.User.Create().SetName("a8m").AddTeams(team).SaveX(admin)
+	view := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View})
+
+	trace := &privacy.Trace{}
+	_, err := client.Team.Create().SetName("entgo").Save(privacy.TraceContext(view, trace))
+	require.True(t, errors.Is(err, privacy.Deny))
+	evals := trace.Evaluations()
+	require.Len(t, evals, 5)
+	require.Equal(t, "schema.BaseMixin", evals[0].Policy)
+	require.Equal(t, "rule.DenyIfNoViewer.func1", evals[0].Rule)
+	require.True(t, errors.Is(evals[0].Decision, privacy.Skip))
+	require.Equal(t, "schema.Team", evals[3].Policy)
+	require.Equal(t, "rule.DenyIfNotAdmin.func1", evals[3].Rule)
+	require.Contains(t, evals[3].Source, "rule.go:")
+	require.True(t, errors.Is(evals[3].Decision, privacy.Deny))
+	require.Empty(t, evals[4].Rule, "policy decision")
+	require.Contains(t, trace.String(), "mutation schema.Team rule.DenyIfNotAdmin.func1")
+
+	// Filters applied by the rules are recorded.
+	trace = &privacy.Trace{}
+	client.Task.Query().AllX(privacy.TraceContext(view, trace))
+	var filters []string
+	for _, e := range trace.Evaluations() {
+		if e.Rule == "rule.FilterTeamRule.func1" {
+			filters = e.Filters
+		}
+	}
+	require.Equal(t, []string{"has_edge(teams, func_selector(func(*sql.Selector)))"}, filters)
+}
+
+func TestEdgeRules(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:edges?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	ctx := context.Background()
+	admin := viewer.NewContext(ctx, viewer.AppViewer{
+		Role: viewer.Admin,
+	})
+	teams := client.Team.CreateBulk(
+		client.Team.Create().SetName("ent"),
+		client.Team.Create().SetName("ent-contrib"),
+	).SaveX(admin)
+	a8m := client.User.Create().SetName("a8m").AddTeams(teams[0]).SaveX(admin)
+	nat := client.User.Create().SetName("nati").AddTeams(teams[0]).SaveX(admin)
+	a8mctx := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View | viewer.Edit})
+
+	_, err := client.Task.Create().SetTitle("task").AddTeams(teams...).SetOwner(a8m).Save(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "tasks can be added only to the teams of the viewer")
+	require.Contains(t, err.Error(), `adding 2 to edge "teams" is not allowed`)
+	tk := client.Task.Create().SetTitle("task").AddTeams(teams[0]).SetOwner(a8m).SaveX(a8mctx)
+	_, err = tk.Update().AddTeams(teams[1]).Save(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny))
+	_, err = tk.Update().SetOwner(nat).Save(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can change the task owner")
+	_, err = tk.Update().ClearOwner().Save(a8mctx)
+	require.True(t, errors.Is(err, privacy.Deny), "only admins can clear the task owner")
+	tk = tk.Update().SetTitle("title").SaveX(a8mctx)
+	tk.Update().AddTeams(teams[1]).SetOwner(nat).ExecX(admin)
+	require.Equal(t, nat.ID, tk.QueryOwner().OnlyIDX(admin))
+}
+
+func TestEdgePathRule(t *testing.T) {
+	client := enttest.Open(t, "sqlite3",
+		"file:paths?mode=memory&cache=shared&_fk=1",
+	)
+	defer client.Close()
+	ctx := context.Background()
+	admin := viewer.NewContext(ctx, viewer.AppViewer{
+		Role: viewer.Admin,
+	})
+	teams := client.Team.CreateBulk(
+		client.Team.Create().SetName("ent"),
+		client.Team.Create().SetName("ent-contrib"),
+	).SaveX(admin)
+	a8m := client.User.Create().SetName("a8m").AddTeams(teams[0]).SaveX(admin)
+	nat := client.User.Create().SetName("nati").AddTeams(teams...).SaveX(admin)
+	a8mctx := viewer.NewContext(ctx, &viewer.UserViewer{User: a8m, Role: viewer.View | viewer.Edit})
+	natctx := viewer.NewContext(ctx, &viewer.UserViewer{User: nat, Role: viewer.View | viewer.Edit})
+	t1 := client.Task.Create().SetTitle("t1").AddTeams(teams[0]).SetOwner(a8m).SaveX(a8mctx)
+	t2 := client.Task.Create().SetTitle("t2").AddTeams(teams[1]).SetOwner(nat).SaveX(natctx)
+
+	viewerID := func(ctx context.Context) (ent.Value, bool) {
+		if v, ok := viewer.FromContext(ctx).(*viewer.UserViewer); ok {
+			return v.User.ID, true
+		}
+		return nil, false
+	}
+	owner := privacy.EdgePathRule(viewerID, "owner")
+	member := privacy.EdgePathRule(viewerID, "owner", "teams.users")
+
+	q := client.Task.Query()
+	require.True(t, errors.Is(owner.EvalQuery(ctx, q), privacy.Deny), "viewer is required")
+	q = client.Task.Query()
+	require.True(t, errors.Is(owner.EvalQuery(a8mctx, q), privacy.Skip))
+	require.Equal(t, []int{t1.ID}, q.IDsX(a8mctx))
+	q = client.Task.Query().Order(ent.Asc(task.FieldID))
+	require.True(t, errors.Is(member.EvalQuery(natctx, q), privacy.Skip))
+	require.Equal(t, []int{t1.ID, t2.ID}, q.IDsX(natctx))
+	q = client.Task.Query()
+	require.True(t, errors.Is(member.EvalQuery(a8mctx, q), privacy.Skip))
+	require.Equal(t, []int{t1.ID}, q.IDsX(a8mctx))
+
+	m := client.Task.Create().SetTitle("t3").SetOwner(a8m).Mutation()
+	require.True(t, errors.Is(owner.EvalMutation(a8mctx, m), privacy.Skip))
+	err := owner.EvalMutation(natctx, m)
+	require.True(t, errors.Is(err, privacy.Deny))
+	require.Contains(t, err.Error(), "created Task is not connected to the viewer by owner")
+	m = client.Task.Create().SetTitle("t3").SetOwner(a8m).AddTeams(teams[1]).Mutation()
+	require.True(t, errors.Is(member.EvalMutation(natctx, m), privacy.Skip))
+	require.True(t, errors.Is(member.EvalMutation(a8mctx, m), privacy.Skip))
+	m = client.Task.Create().SetTitle("t3").SetOwner(nat).AddTeams(teams[1]).Mutation()
+	require.True(t, errors.Is(member.EvalMutation(a8mctx, m), privacy.Deny))
+
+	u := client.Task.Update().SetTitle("updated")
+	require.True(t, errors.Is(owner.EvalMutation(a8mctx, u.Mutation()), privacy.Skip))
+	require.Equal(t, 1, u.SaveX(admin))
+	require.Equal(t, "updated", client.Task.GetX(admin, t1.ID).Title)
+	require.Equal(t, "t2", client.Task.GetX(admin, t2.ID).Title)
+
+	err = privacy.EdgePathRule(viewerID, "owner.unknown").EvalQuery(a8mctx, client.Task.Query())
+	require.True(t, errors.Is(err, privacy.Deny))
+	require.Contains(t, err.Error(), `edge "unknown" was not found for User`)
+}
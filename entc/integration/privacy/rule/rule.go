@@ -7,6 +7,7 @@ package rule
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"entgo.io/ent/entc/integration/privacy/ent"
@@ -70,6 +71,21 @@ func AllowUserCreateIfAdmin() privacy.MutationRule {
 	return privacy.OnMutationOperation(rule, ent.OpCreate)
 }
 
+// AllowUserUpdateIfEditor is a rule that allows users with the edit role to update themselves.
+func AllowUserUpdateIfEditor() privacy.MutationRule {
+	rule := privacy.UserMutationRuleFunc(func(ctx context.Context, m *ent.UserMutation) error {
+		view, ok := viewer.FromContext(ctx).(*viewer.UserViewer)
+		if !ok || !view.Can(viewer.Edit) {
+			return privacy.Skip
+		}
+		if id, ok := m.ID(); ok && view.User != nil && view.User.ID == id {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+	return privacy.OnMutationOperation(rule, ent.OpUpdateOne)
+}
+
 // AllowTaskCreateIfOwner is a rule that allows creating task only if the creator is also the user.
 func AllowTaskCreateIfOwner() privacy.MutationRule {
 	rule := privacy.TaskMutationRuleFunc(func(ctx context.Context, m *ent.TaskMutation) error {
@@ -168,6 +184,113 @@ func AllowIfViewerInTheSameTeam() privacy.MutationRule {
 	return privacy.OnMutationOperation(policy, ent.OpUpdateOne)
 }
 
+// DenyTaskTeamsAddIfNotMember is a mutation rule that denies adding
+// tasks to teams that the viewer is not a member of.
+func DenyTaskTeamsAddIfNotMember() privacy.MutationRule {
+	return privacy.DenyEdgeAdd(task.EdgeTeams, func(ctx context.Context) ([]ent.Value, error) {
+		view, ok := viewer.FromContext(ctx).(*viewer.UserViewer)
+		// Skip if the viewer is an admin (or an app).
+		if !ok || view.Admin() {
+			return nil, privacy.Skip
+		}
+		ids, err := view.User.QueryTeams().IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]ent.Value, len(ids))
+		for i := range ids {
+			values[i] = ids[i]
+		}
+		return values, nil
+	})
+}
+
+// DenyTaskOwnerChangeIfNotAdmin is a mutation rule that denies
+// changing the owner of existing tasks, unless the viewer is an admin.
+func DenyTaskOwnerChangeIfNotAdmin() privacy.MutationRule {
+	rule := privacy.TaskEdgeOwnerRule(func(ctx context.Context, _ *ent.TaskMutation, _, _ []int, _ bool) error {
+		if view := viewer.FromContext(ctx); view != nil && view.Admin() {
+			return privacy.Skip
+		}
+		return privacy.Denyf("viewer is not allowed to change the task owner")
+	})
+	return privacy.OnMutationOperation(rule, ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// AllowTaskLoadIfOwner is a load rule that allows the viewer to see the tasks it owns. The
+// ownership of all loaded tasks is checked using one query in order to avoid N+1 queries.
+func AllowTaskLoadIfOwner() privacy.LoadRule {
+	return privacy.TaskLoadRuleFunc(func(ctx context.Context, tasks []*ent.Task) ([]error, error) {
+		view, ok := viewer.FromContext(ctx).(*viewer.UserViewer)
+		if !ok {
+			return nil, nil
+		}
+		ids := make([]int, len(tasks))
+		for i, t := range tasks {
+			ids[i] = t.ID
+		}
+		owned, err := view.User.QueryTasks().
+			Where(task.IDIn(ids...)).
+			IDs(privacy.DecisionContext(ctx, privacy.Allow))
+		if err != nil {
+			return nil, err
+		}
+		decisions := make([]error, len(tasks))
+		for i := range tasks {
+			for _, id := range owned {
+				if tasks[i].ID == id {
+					decisions[i] = privacy.Allow
+				}
+			}
+		}
+		return decisions, nil
+	})
+}
+
+// DenyClosedTaskLoadIfNotViewer is a load rule that hides
+// closed tasks from viewers without the view role.
+func DenyClosedTaskLoadIfNotViewer() privacy.LoadRule {
+	return privacy.TaskLoadRuleFunc(func(ctx context.Context, tasks []*ent.Task) ([]error, error) {
+		if view := viewer.FromContext(ctx); view != nil && view.Can(viewer.View) {
+			return nil, nil
+		}
+		decisions := make([]error, len(tasks))
+		for i, t := range tasks {
+			if t.Status == task.StatusClosed {
+				decisions[i] = privacy.Denyf("task %d is closed", t.ID)
+			}
+		}
+		return decisions, nil
+	})
+}
+
+// AllowReadIfAdmin is a read rule that returns allow decision if the viewer is admin.
+func AllowReadIfAdmin() privacy.ReadRule {
+	return privacy.ReadRuleFunc(func(ctx context.Context) error {
+		if view := viewer.FromContext(ctx); view != nil && view.Admin() {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// MaskIfViewer is a read rule that masks all characters of the field value except
+// the last four if the viewer is allowed to view, and omits the field otherwise.
+func MaskIfViewer() privacy.ReadRule {
+	return privacy.ReadRuleFunc(func(ctx context.Context) error {
+		if view := viewer.FromContext(ctx); view == nil || !view.Can(viewer.View) {
+			return privacy.Omit
+		}
+		return privacy.Mask(func(v ent.Value) ent.Value {
+			s := v.(string)
+			if len(s) <= 4 {
+				return s
+			}
+			return strings.Repeat("*", len(s)-4) + s[len(s)-4:]
+		})
+	})
+}
+
 var logger = struct {
 	logf func(string, ...interface{})
 	sync.RWMutex
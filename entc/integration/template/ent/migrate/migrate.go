@@ -25,6 +25,11 @@ var (
 	// If this option is enabled, ent migration will drop old columns
 	// that were used for both fields and edges. This defaults to false.
 	WithDropColumn = schema.WithDropColumn
+	// WithDropDeprecated sets the drop deprecated option to the migration.
+	// If this option is enabled, ent migration will drop the columns of
+	// deprecated fields, regardless of the WithDropColumn option.
+	// This defaults to false.
+	WithDropDeprecated = schema.WithDropDeprecated
 	// WithDropIndex sets the drop index option to the migration.
 	// If this option is enabled, ent migration will drop old indexes
 	// that were defined in the schema. This defaults to false.
@@ -19,6 +19,7 @@ import (
 	"entgo.io/ent/entc/integration/ent/role"
 	"entgo.io/ent/entc/integration/ent/schema"
 	"entgo.io/ent/entc/integration/ent/task"
+	"entgo.io/ent/schema/field"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -206,6 +207,18 @@ func Types(t *testing.T, client *ent.Client) {
 	require.EqualValues(100, ft.Int64, "UpdateDefault sets the value to 100")
 	require.EqualValues(100, ft.Duration, "UpdateDefault sets the value to 100ns")
 
+	balance, err := field.ParseRat("10.25")
+	require.NoError(err)
+	ft = client.FieldType.UpdateOne(ft).SetAmount("19.99").SetBalance(balance).SaveX(ctx)
+	require.Equal("19.99", ft.Amount)
+	require.Equal("10.25", ft.Balance.String())
+	ft = client.FieldType.UpdateOne(ft).AddBalance(balance).SaveX(ctx)
+	require.Equal("20.5", ft.Balance.String())
+	ft = client.FieldType.GetX(ctx, ft.ID)
+	require.Zero(ft.Balance.Cmp(balance.Add(balance)))
+	require.True(client.FieldType.Query().Where(fieldtype.AmountGT("10.5")).ExistX(ctx))
+	require.False(client.FieldType.Query().Where(fieldtype.AmountGT("20")).ExistX(ctx))
+
 	err = client.Task.CreateBulk(
 		client.Task.Create().SetPriority(schema.PriorityLow),
 		client.Task.Create().SetPriority(schema.PriorityMid),
@@ -0,0 +1,553 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/entc/integration/validation/ent/event"
+	"entgo.io/ent/entc/integration/validation/ent/predicate"
+	"entgo.io/ent/schema/field"
+)
+
+// EventUpdate is the builder for updating Event entities.
+type EventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// Where appends a list predicates to the EventUpdate builder.
+func (eu *EventUpdate) Where(ps ...predicate.Event) *EventUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetName sets the "name" field.
+func (eu *EventUpdate) SetName(s string) *EventUpdate {
+	eu.mutation.SetName(s)
+	return eu
+}
+
+// SetKind sets the "kind" field.
+func (eu *EventUpdate) SetKind(e event.Kind) *EventUpdate {
+	eu.mutation.SetKind(e)
+	return eu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableKind(e *event.Kind) *EventUpdate {
+	if e != nil {
+		eu.SetKind(*e)
+	}
+	return eu
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (eu *EventUpdate) SetStartsAt(t time.Time) *EventUpdate {
+	eu.mutation.SetStartsAt(t)
+	return eu
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (eu *EventUpdate) SetEndsAt(t time.Time) *EventUpdate {
+	eu.mutation.SetEndsAt(t)
+	return eu
+}
+
+// SetCanceled sets the "canceled" field.
+func (eu *EventUpdate) SetCanceled(b bool) *EventUpdate {
+	eu.mutation.SetCanceled(b)
+	return eu
+}
+
+// SetNillableCanceled sets the "canceled" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableCanceled(b *bool) *EventUpdate {
+	if b != nil {
+		eu.SetCanceled(*b)
+	}
+	return eu
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (eu *EventUpdate) SetCancelReason(s string) *EventUpdate {
+	eu.mutation.SetCancelReason(s)
+	return eu
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (eu *EventUpdate) SetNillableCancelReason(s *string) *EventUpdate {
+	if s != nil {
+		eu.SetCancelReason(*s)
+	}
+	return eu
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (eu *EventUpdate) ClearCancelReason() *EventUpdate {
+	eu.mutation.ClearCancelReason()
+	return eu
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (eu *EventUpdate) Mutation() *EventMutation {
+	return eu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *EventUpdate) Save(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(eu.hooks) == 0 {
+		if err = eu.check(ctx); err != nil {
+			return 0, err
+		}
+		affected, err = eu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = eu.check(ctx); err != nil {
+				return 0, err
+			}
+			eu.mutation = mutation
+			affected, err = eu.sqlSave(ctx)
+			mutation.done = true
+			return affected, err
+		})
+		for i := len(eu.hooks) - 1; i >= 0; i-- {
+			if eu.hooks[i] == nil {
+				return 0, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = eu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, eu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *EventUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *EventUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *EventUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
+func (eu *EventUpdate) check(ctx context.Context) error {
+	var errs ValidationErrors
+	if v, ok := eu.mutation.Name(); ok {
+		if err := event.NameValidator(v); err != nil {
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Event.name": %w`, err)))
+		}
+	}
+	if v, ok := eu.mutation.Kind(); ok {
+		if err := event.KindValidator(v); err != nil {
+			errs = append(errs, newValidationError("kind", "Enum", fmt.Errorf(`ent: validator failed for field "Event.kind": %w`, err), "meetup", "conference"))
+		}
+	}
+	rm := ent.NewResolvedMutation(ctx, eu.mutation)
+	if v := event.Validators[0]; v == nil {
+		return fmt.Errorf("ent: uninitialized event.Validators[0] (forgotten import ent/runtime?)")
+	} else if err := v.Validate(ctx, rm); err != nil {
+		if err := rm.Err(); err != nil {
+			return err
+		}
+		errs = append(errs, newValidationError("valid_range", "Check", fmt.Errorf(`ent: validator failed for "Event": %w`, err)))
+	}
+	if v := event.Validators[1]; v == nil {
+		return fmt.Errorf("ent: uninitialized event.Validators[1] (forgotten import ent/runtime?)")
+	} else if err := v.Validate(ctx, rm); err != nil {
+		if err := rm.Err(); err != nil {
+			return err
+		}
+		errs = append(errs, newValidationError("Event", "Validate", fmt.Errorf(`ent: validator failed for "Event": %w`, err)))
+	}
+	return errs.err()
+}
+
+func (eu *EventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+	}
+	if value, ok := eu.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: event.FieldKind,
+		})
+	}
+	if value, ok := eu.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: event.FieldStartsAt,
+		})
+	}
+	if value, ok := eu.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: event.FieldEndsAt,
+		})
+	}
+	if value, ok := eu.mutation.Canceled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: event.FieldCanceled,
+		})
+	}
+	if value, ok := eu.mutation.CancelReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldCancelReason,
+		})
+	}
+	if eu.mutation.CancelReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: event.FieldCancelReason,
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// EventUpdateOne is the builder for updating a single Event entity.
+type EventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventMutation
+}
+
+// SetName sets the "name" field.
+func (euo *EventUpdateOne) SetName(s string) *EventUpdateOne {
+	euo.mutation.SetName(s)
+	return euo
+}
+
+// SetKind sets the "kind" field.
+func (euo *EventUpdateOne) SetKind(e event.Kind) *EventUpdateOne {
+	euo.mutation.SetKind(e)
+	return euo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableKind(e *event.Kind) *EventUpdateOne {
+	if e != nil {
+		euo.SetKind(*e)
+	}
+	return euo
+}
+
+// SetStartsAt sets the "starts_at" field.
+func (euo *EventUpdateOne) SetStartsAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetStartsAt(t)
+	return euo
+}
+
+// SetEndsAt sets the "ends_at" field.
+func (euo *EventUpdateOne) SetEndsAt(t time.Time) *EventUpdateOne {
+	euo.mutation.SetEndsAt(t)
+	return euo
+}
+
+// SetCanceled sets the "canceled" field.
+func (euo *EventUpdateOne) SetCanceled(b bool) *EventUpdateOne {
+	euo.mutation.SetCanceled(b)
+	return euo
+}
+
+// SetNillableCanceled sets the "canceled" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableCanceled(b *bool) *EventUpdateOne {
+	if b != nil {
+		euo.SetCanceled(*b)
+	}
+	return euo
+}
+
+// SetCancelReason sets the "cancel_reason" field.
+func (euo *EventUpdateOne) SetCancelReason(s string) *EventUpdateOne {
+	euo.mutation.SetCancelReason(s)
+	return euo
+}
+
+// SetNillableCancelReason sets the "cancel_reason" field if the given value is not nil.
+func (euo *EventUpdateOne) SetNillableCancelReason(s *string) *EventUpdateOne {
+	if s != nil {
+		euo.SetCancelReason(*s)
+	}
+	return euo
+}
+
+// ClearCancelReason clears the value of the "cancel_reason" field.
+func (euo *EventUpdateOne) ClearCancelReason() *EventUpdateOne {
+	euo.mutation.ClearCancelReason()
+	return euo
+}
+
+// Mutation returns the EventMutation object of the builder.
+func (euo *EventUpdateOne) Mutation() *EventMutation {
+	return euo.mutation
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *EventUpdateOne) Select(field string, fields ...string) *EventUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Event entity.
+func (euo *EventUpdateOne) Save(ctx context.Context) (*Event, error) {
+	var (
+		err  error
+		node *Event
+	)
+	if len(euo.hooks) == 0 {
+		if err = euo.check(ctx); err != nil {
+			return nil, err
+		}
+		node, err = euo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*EventMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			if err = euo.check(ctx); err != nil {
+				return nil, err
+			}
+			euo.mutation = mutation
+			node, err = euo.sqlSave(ctx)
+			mutation.done = true
+			return node, err
+		})
+		for i := len(euo.hooks) - 1; i >= 0; i-- {
+			if euo.hooks[i] == nil {
+				return nil, fmt.Errorf("ent: uninitialized hook (forgotten import ent/runtime?)")
+			}
+			mut = euo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, euo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *EventUpdateOne) SaveX(ctx context.Context) *Event {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *EventUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *EventUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
+func (euo *EventUpdateOne) check(ctx context.Context) error {
+	var errs ValidationErrors
+	if v, ok := euo.mutation.Name(); ok {
+		if err := event.NameValidator(v); err != nil {
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Event.name": %w`, err)))
+		}
+	}
+	if v, ok := euo.mutation.Kind(); ok {
+		if err := event.KindValidator(v); err != nil {
+			errs = append(errs, newValidationError("kind", "Enum", fmt.Errorf(`ent: validator failed for field "Event.kind": %w`, err), "meetup", "conference"))
+		}
+	}
+	rm := ent.NewResolvedMutation(ctx, euo.mutation)
+	if v := event.Validators[0]; v == nil {
+		return fmt.Errorf("ent: uninitialized event.Validators[0] (forgotten import ent/runtime?)")
+	} else if err := v.Validate(ctx, rm); err != nil {
+		if err := rm.Err(); err != nil {
+			return err
+		}
+		errs = append(errs, newValidationError("valid_range", "Check", fmt.Errorf(`ent: validator failed for "Event": %w`, err)))
+	}
+	if v := event.Validators[1]; v == nil {
+		return fmt.Errorf("ent: uninitialized event.Validators[1] (forgotten import ent/runtime?)")
+	} else if err := v.Validate(ctx, rm); err != nil {
+		if err := rm.Err(); err != nil {
+			return err
+		}
+		errs = append(errs, newValidationError("Event", "Validate", fmt.Errorf(`ent: validator failed for "Event": %w`, err)))
+	}
+	return errs.err()
+}
+
+func (euo *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   event.Table,
+			Columns: event.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: event.FieldID,
+			},
+		},
+	}
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Event.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, event.FieldID)
+		for _, f := range fields {
+			if !event.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != event.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldName,
+		})
+	}
+	if value, ok := euo.mutation.Kind(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: event.FieldKind,
+		})
+	}
+	if value, ok := euo.mutation.StartsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: event.FieldStartsAt,
+		})
+	}
+	if value, ok := euo.mutation.EndsAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: event.FieldEndsAt,
+		})
+	}
+	if value, ok := euo.mutation.Canceled(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: event.FieldCanceled,
+		})
+	}
+	if value, ok := euo.mutation.CancelReason(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: event.FieldCancelReason,
+		})
+	}
+	if euo.mutation.CancelReasonCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: event.FieldCancelReason,
+		})
+	}
+	_node = &Event{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{event.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{err.Error(), err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+)
+
+// Event holds the schema definition for the Event entity.
+type Event struct {
+	ent.Schema
+}
+
+// Fields of the Event.
+func (Event) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(20),
+		field.Enum("kind").
+			Values("meetup", "conference").
+			Default("meetup"),
+		field.Time("starts_at"),
+		field.Time("ends_at"),
+		field.Bool("canceled").
+			Default(false),
+		field.String("cancel_reason").
+			Optional(),
+	}
+}
+
+// Validators of the Event.
+func (Event) Validators() []ent.Validator {
+	return []ent.Validator{
+		entsql.Check("valid_range", "starts_at < ends_at", func(_ context.Context, m ent.Mutation) error {
+			start, ok1 := m.Field("starts_at")
+			end, ok2 := m.Field("ends_at")
+			if ok1 && ok2 && !start.(time.Time).Before(end.(time.Time)) {
+				return errors.New("event must start before it ends")
+			}
+			return nil
+		}),
+		ent.ValidateFunc(func(_ context.Context, m ent.Mutation) error {
+			canceled, _ := m.Field("canceled")
+			reason, _ := m.Field("cancel_reason")
+			if canceled == true && (reason == nil || reason == "") {
+				return errors.New("canceled event requires a reason")
+			}
+			return nil
+		}),
+	}
+}
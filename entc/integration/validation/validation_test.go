@@ -0,0 +1,169 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/entc/integration/validation/ent"
+	"entgo.io/ent/entc/integration/validation/ent/event"
+	_ "entgo.io/ent/entc/integration/validation/ent/runtime"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidators(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	now := time.Now()
+	_, err = client.Event.Create().SetName("a8m").SetStartsAt(now).SetEndsAt(now.Add(-time.Hour)).Save(ctx)
+	require.True(t, ent.IsValidationError(err))
+	require.EqualError(t, err, `ent: validator failed for "Event": event must start before it ends`)
+	var verr *ent.ValidationError
+	require.ErrorAs(t, err, &verr)
+	require.Equal(t, "valid_range", verr.Name)
+
+	e := client.Event.Create().SetName("a8m").SetStartsAt(now).SetEndsAt(now.Add(time.Hour)).SaveX(ctx)
+
+	// Fields that were not changed by UpdateOne are resolved to their old values.
+	err = e.Update().SetEndsAt(now.Add(-time.Hour)).Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	err = e.Update().SetCanceled(true).Exec(ctx)
+	require.EqualError(t, err, `ent: validator failed for "Event": canceled event requires a reason`)
+	e = e.Update().SetCanceled(true).SetCancelReason("rain").SaveX(ctx)
+	err = e.Update().ClearCancelReason().Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	err = client.Event.UpdateOneID(e.ID).ClearCancelReason().Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	client.Event.UpdateOneID(e.ID).SetStartsAt(now.Add(-time.Hour)).ExecX(ctx)
+
+	// Bulk updates validate only the fields they set.
+	client.Event.Update().Where(event.ID(e.ID)).SetEndsAt(now.Add(time.Hour)).ExecX(ctx)
+	_, err = client.Event.Update().SetStartsAt(now).SetEndsAt(now).Save(ctx)
+	require.True(t, ent.IsValidationError(err))
+
+	// Bulk creation.
+	_, err = client.Event.CreateBulk(
+		client.Event.Create().SetName("a").SetStartsAt(now).SetEndsAt(now.Add(time.Hour)),
+		client.Event.Create().SetName("b").SetStartsAt(now).SetEndsAt(now),
+	).Save(ctx)
+	require.True(t, ent.IsValidationError(err))
+
+	// The "valid_range" validator is also enforced by the database.
+	_, err = drv.DB().ExecContext(ctx, "UPDATE `events` SET `ends_at` = `starts_at`")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "valid_range")
+}
+
+func TestValidationErrors(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:errors?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	now := time.Now()
+	_, err = client.Event.Create().SetName("").SetKind("party").SetStartsAt(now).Save(ctx)
+	var verrs ent.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	require.Len(t, verrs, 3)
+	require.Equal(t, "name", verrs[0].Path)
+	require.Equal(t, "MinLen", verrs[0].Rule)
+	require.Equal(t, []interface{}{1}, verrs[0].Params)
+	require.Equal(t, "kind", verrs[1].Name)
+	require.Equal(t, "Enum", verrs[1].Rule)
+	require.Equal(t, []interface{}{"meetup", "conference"}, verrs[1].Params)
+	require.Equal(t, "ends_at", verrs[2].Name)
+	require.Equal(t, "Required", verrs[2].Rule)
+	require.True(t, ent.IsValidationError(err))
+	require.EqualError(t, err, `ent: validator failed for field "Event.name": value is less than the required length; `+
+		`ent: validator failed for field "Event.kind": event: invalid enum value for kind field: "party"; `+
+		`ent: missing required field "Event.ends_at"`)
+
+	e := client.Event.Create().SetName("a8m").SetStartsAt(now).SetEndsAt(now.Add(time.Hour)).SaveX(ctx)
+	err = e.Update().SetName("entgo.io graph framework").SetEndsAt(now).Exec(ctx)
+	require.ErrorAs(t, err, &verrs)
+	require.Len(t, verrs, 2)
+	require.Equal(t, "MaxLen", verrs[0].Rule)
+	require.Equal(t, []interface{}{20}, verrs[0].Params)
+	require.Equal(t, "valid_range", verrs[1].Name)
+	require.Equal(t, "Check", verrs[1].Rule)
+
+	// Errors of all builders are returned in bulk creation.
+	_, err = client.Event.CreateBulk(
+		client.Event.Create().SetStartsAt(now).SetEndsAt(now.Add(time.Hour)),
+		client.Event.Create().SetName("b").SetStartsAt(now).SetEndsAt(now.Add(time.Hour)),
+		client.Event.Create().SetName("c").SetStartsAt(now).SetEndsAt(now).SetCanceled(true),
+	).Save(ctx)
+	require.ErrorAs(t, err, &verrs)
+	require.Len(t, verrs, 3)
+	require.Equal(t, []string{"[0].name", "[2].valid_range", "[2].Event"}, []string{verrs[0].Path, verrs[1].Path, verrs[2].Path})
+	require.Equal(t, 1, client.Event.Query().CountX(ctx))
+}
+
+func TestUpsert(t *testing.T) {
+	drv, err := sql.Open(dialect.SQLite, "file:upsert?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	client := ent.NewClient(ent.Driver(drv))
+	defer client.Close()
+	ctx := context.Background()
+	require.NoError(t, client.Schema.Create(ctx))
+
+	now := time.Now()
+	create := func() *ent.EventCreate {
+		return client.Event.Create().SetName("a8m").SetStartsAt(now).SetEndsAt(now.Add(time.Hour))
+	}
+	// Values set on conflict are validated by the field validators.
+	err = create().
+		OnConflictColumns(event.FieldID).
+		Update(func(u *ent.EventUpsert) {
+			u.SetName("")
+		}).
+		Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	var verrs ent.ValidationErrors
+	require.ErrorAs(t, err, &verrs)
+	require.Equal(t, "MinLen", verrs[0].Rule)
+	err = create().OnConflictColumns(event.FieldID).SetName("entgo.io graph framework").Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	err = create().OnConflictColumns(event.FieldID).SetKind("party").Exec(ctx)
+	require.EqualError(t, err, `ent: validator failed for field "Event.kind": event: invalid enum value for kind field: "party"`)
+	// All invalid values are reported.
+	err = create().
+		OnConflictColumns(event.FieldID).
+		SetName("").
+		SetKind("party").
+		Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	require.ErrorAs(t, err, &verrs)
+	require.Len(t, verrs, 2)
+	require.Equal(t, []string{"MinLen", "Enum"}, []string{verrs[0].Rule, verrs[1].Rule})
+	err = client.Event.CreateBulk(create(), create()).
+		OnConflictColumns(event.FieldID).
+		Update(func(u *ent.EventUpsert) {
+			u.SetName("")
+		}).
+		Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+
+	// New values are validated on creation.
+	err = create().SetName("").OnConflictColumns(event.FieldID).UpdateNewValues().Exec(ctx)
+	require.True(t, ent.IsValidationError(err))
+	require.Zero(t, client.Event.Query().CountX(ctx))
+
+	create().OnConflictColumns(event.FieldID).SetName("ent").ExecX(ctx)
+	require.Equal(t, 1, client.Event.Query().CountX(ctx))
+}
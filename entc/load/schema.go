@@ -10,6 +10,7 @@ import (
 	"reflect"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -25,6 +26,7 @@ type Schema struct {
 	Indexes     []*Index               `json:"indexes,omitempty"`
 	Hooks       []*Position            `json:"hooks,omitempty"`
 	Policy      []*Position            `json:"policy,omitempty"`
+	Validators  []*Validator           `json:"validators,omitempty"`
 	Annotations map[string]interface{} `json:"annotations,omitempty"`
 }
 
@@ -35,28 +37,42 @@ type Position struct {
 	MixinIndex int  // Mixin index in the mixin list.
 }
 
+// Validator represents an entity-level ent.Validator that was loaded from a complied
+// user package. The Name and Check are set for validators that are translated to
+// CHECK constraints (created using entsql.Check).
+type Validator struct {
+	Name  string `json:"name,omitempty"`
+	Check string `json:"check,omitempty"`
+}
+
 // Field represents an ent.Field that was loaded from a complied user package.
 type Field struct {
-	Name          string                  `json:"name,omitempty"`
-	Info          *field.TypeInfo         `json:"type,omitempty"`
-	Tag           string                  `json:"tag,omitempty"`
-	Size          *int64                  `json:"size,omitempty"`
-	Enums         []struct{ N, V string } `json:"enums,omitempty"`
-	Unique        bool                    `json:"unique,omitempty"`
-	Nillable      bool                    `json:"nillable,omitempty"`
-	Optional      bool                    `json:"optional,omitempty"`
-	Default       bool                    `json:"default,omitempty"`
-	DefaultValue  interface{}             `json:"default_value,omitempty"`
-	DefaultKind   reflect.Kind            `json:"default_kind,omitempty"`
-	UpdateDefault bool                    `json:"update_default,omitempty"`
-	Immutable     bool                    `json:"immutable,omitempty"`
-	Validators    int                     `json:"validators,omitempty"`
-	StorageKey    string                  `json:"storage_key,omitempty"`
-	Position      *Position               `json:"position,omitempty"`
-	Sensitive     bool                    `json:"sensitive,omitempty"`
-	SchemaType    map[string]string       `json:"schema_type,omitempty"`
-	Annotations   map[string]interface{}  `json:"annotations,omitempty"`
-	Comment       string                  `json:"comment,omitempty"`
+	Name             string                  `json:"name,omitempty"`
+	Info             *field.TypeInfo         `json:"type,omitempty"`
+	Tag              string                  `json:"tag,omitempty"`
+	Size             *int64                  `json:"size,omitempty"`
+	Precision        int64                   `json:"precision,omitempty"`
+	Scale            int64                   `json:"scale,omitempty"`
+	Enums            []struct{ N, V string } `json:"enums,omitempty"`
+	Unique           bool                    `json:"unique,omitempty"`
+	Nillable         bool                    `json:"nillable,omitempty"`
+	Optional         bool                    `json:"optional,omitempty"`
+	Default          bool                    `json:"default,omitempty"`
+	DefaultValue     interface{}             `json:"default_value,omitempty"`
+	DefaultKind      reflect.Kind            `json:"default_kind,omitempty"`
+	UpdateDefault    bool                    `json:"update_default,omitempty"`
+	Immutable        bool                    `json:"immutable,omitempty"`
+	Validators       int                     `json:"validators,omitempty"`
+	StorageKey       string                  `json:"storage_key,omitempty"`
+	Position         *Position               `json:"position,omitempty"`
+	Sensitive        bool                    `json:"sensitive,omitempty"`
+	Encrypted        bool                    `json:"encrypted,omitempty"`
+	Deterministic    bool                    `json:"deterministic,omitempty"`
+	SchemaType       map[string]string       `json:"schema_type,omitempty"`
+	Annotations      map[string]interface{}  `json:"annotations,omitempty"`
+	Comment          string                  `json:"comment,omitempty"`
+	Deprecated       bool                    `json:"deprecated,omitempty"`
+	DeprecatedReason string                  `json:"deprecated_reason,omitempty"`
 }
 
 // Edge represents an ent.Edge that was loaded from a complied user package.
@@ -113,22 +129,26 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 		return nil, fmt.Errorf("field %q: %v", fd.Name, fd.Err)
 	}
 	sf := &Field{
-		Name:          fd.Name,
-		Info:          fd.Info,
-		Tag:           fd.Tag,
-		Enums:         fd.Enums,
-		Unique:        fd.Unique,
-		Nillable:      fd.Nillable,
-		Optional:      fd.Optional,
-		Default:       fd.Default != nil,
-		UpdateDefault: fd.UpdateDefault != nil,
-		Immutable:     fd.Immutable,
-		StorageKey:    fd.StorageKey,
-		Validators:    len(fd.Validators),
-		Sensitive:     fd.Sensitive,
-		SchemaType:    fd.SchemaType,
-		Annotations:   make(map[string]interface{}),
-		Comment:       fd.Comment,
+		Name:             fd.Name,
+		Info:             fd.Info,
+		Tag:              fd.Tag,
+		Enums:            fd.Enums,
+		Precision:        int64(fd.Precision),
+		Scale:            int64(fd.Scale),
+		Unique:           fd.Unique,
+		Nillable:         fd.Nillable,
+		Optional:         fd.Optional,
+		Default:          fd.Default != nil,
+		UpdateDefault:    fd.UpdateDefault != nil,
+		Immutable:        fd.Immutable,
+		StorageKey:       fd.StorageKey,
+		Validators:       len(fd.Validators),
+		Sensitive:        fd.Sensitive,
+		SchemaType:       fd.SchemaType,
+		Annotations:      make(map[string]interface{}),
+		Comment:          fd.Comment,
+		Deprecated:       fd.Deprecated,
+		DeprecatedReason: fd.DeprecatedReason,
 	}
 	for _, at := range fd.Annotations {
 		sf.addAnnotation(at)
@@ -136,6 +156,10 @@ func NewField(fd *field.Descriptor) (*Field, error) {
 	if sf.Info == nil {
 		return nil, fmt.Errorf("missing type info for field %q", sf.Name)
 	}
+	if fd.Encrypter != nil {
+		sf.Encrypted = true
+		sf.Deterministic = fd.Encrypter.Deterministic()
+	}
 	if size := int64(fd.Size); size != 0 {
 		sf.Size = &size
 	}
@@ -203,6 +227,9 @@ func MarshalSchema(schema ent.Interface) (b []byte, err error) {
 	if err := s.loadPolicy(schema); err != nil {
 		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
 	}
+	if err := s.loadValidators(schema); err != nil {
+		return nil, fmt.Errorf("schema %q: %w", s.Name, err)
+	}
 	return json.Marshal(s)
 }
 
@@ -328,6 +355,24 @@ func (s *Schema) loadPolicy(schema ent.Interface) error {
 	return nil
 }
 
+func (s *Schema) loadValidators(schema ent.Interface) error {
+	validators, err := safeValidators(schema)
+	if err != nil {
+		return err
+	}
+	for i, v := range validators {
+		switch v := v.(type) {
+		case nil:
+			return fmt.Errorf("validator %d is nil", i)
+		case *entsql.CheckValidator:
+			s.Validators = append(s.Validators, &Validator{Name: v.Name, Check: v.Expr})
+		default:
+			s.Validators = append(s.Validators, &Validator{})
+		}
+	}
+	return nil
+}
+
 func (s *Schema) addAnnotation(an schema.Annotation) {
 	curr, ok := s.Annotations[an.Name()]
 	if !ok {
@@ -363,7 +408,7 @@ func addAnnotation(annotations map[string]interface{}, an schema.Annotation) {
 }
 
 func (f *Field) defaults() error {
-	if !f.Default || !f.Info.Numeric() || f.DefaultKind == reflect.Func {
+	if !f.Default || !f.Info.Numeric() || f.Info.Type == field.TypeDecimal || f.DefaultKind == reflect.Func {
 		return nil
 	}
 	n, ok := f.DefaultValue.(float64)
@@ -445,6 +490,17 @@ func safePolicy(schema interface{ Policy() ent.Policy }) (policy ent.Policy, err
 	return schema.Policy(), nil
 }
 
+// safeValidators wraps the schema.Validators method with recover to ensure no panics in marshaling.
+func safeValidators(schema interface{ Validators() []ent.Validator }) (validators []ent.Validator, err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = fmt.Errorf("schema.Validators panics: %v", v)
+			validators = nil
+		}
+	}()
+	return schema.Validators(), nil
+}
+
 func indirect(t reflect.Type) reflect.Type {
 	for t.Kind() == reflect.Ptr {
 		t = t.Elem()
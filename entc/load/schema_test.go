@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -277,6 +278,57 @@ func TestMarshalFails(t *testing.T) {
 	require.EqualError(t, err, `schema "InvalidUUID": field "invalid": expect type (func() uuid.UUID) for uuid default value`)
 }
 
+type WithEncryption struct {
+	ent.Schema
+}
+
+type encrypter struct{ deterministic bool }
+
+func (encrypter) Encrypt(b []byte) ([]byte, error) { return b, nil }
+func (encrypter) Decrypt(b []byte) ([]byte, error) { return b, nil }
+func (e encrypter) Deterministic() bool            { return e.deterministic }
+
+func (WithEncryption) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("ssn").
+			Encrypted(encrypter{deterministic: true}),
+		field.Bytes("notes").
+			Encrypted(encrypter{}),
+		field.String("name"),
+	}
+}
+
+func TestMarshalEncryption(t *testing.T) {
+	buf, err := MarshalSchema(WithEncryption{})
+	require.NoError(t, err)
+	schema, err := UnmarshalSchema(buf)
+	require.NoError(t, err)
+	require.True(t, schema.Fields[0].Encrypted)
+	require.True(t, schema.Fields[0].Deterministic)
+	require.True(t, schema.Fields[1].Encrypted)
+	require.False(t, schema.Fields[1].Deterministic)
+	require.False(t, schema.Fields[2].Encrypted)
+}
+
+type WithValidators struct {
+	ent.Schema
+}
+
+func (WithValidators) Validators() []ent.Validator {
+	return []ent.Validator{
+		ent.ValidateFunc(func(context.Context, ent.Mutation) error { return nil }),
+		entsql.Check("valid_range", "start < end", nil),
+	}
+}
+
+func TestMarshalValidators(t *testing.T) {
+	buf, err := MarshalSchema(WithValidators{})
+	require.NoError(t, err)
+	schema, err := UnmarshalSchema(buf)
+	require.NoError(t, err)
+	require.Equal(t, []*Validator{{}, {Name: "valid_range", Check: "start < end"}}, schema.Validators)
+}
+
 type WithDefaults struct {
 	ent.Schema
 }
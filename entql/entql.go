@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // An Op represents a predicate operator.
@@ -63,6 +64,15 @@ const (
 	FuncHasPrefix    Func = "has_prefix"    // startingWith
 	FuncHasSuffix    Func = "has_suffix"    // endingWith
 	FuncHasEdge      Func = "has_edge"      // HasEdge
+	FuncLike         Func = "like"          // LIKE pattern
+	FuncRegexp       Func = "regexp"        // regular expression
+	FuncJSONHasKey   Func = "json_has_key"  // JSON key exists
+	FuncJSONContains Func = "json_contains" // JSON value contains
+	FuncJSONValue    Func = "json_value"    // JSON value in path
+	FuncEdgeCount    Func = "edge_count"    // number of neighbors
+	FuncEdgeField    Func = "edge_field"    // field of neighbors
+	FuncDateTrunc    Func = "date_trunc"    // time truncated to unit
+	FuncDateAdd      Func = "date_add"      // time with added duration
 )
 
 type (
@@ -364,6 +374,103 @@ func HasEdgeWith(name string, p ...P) P {
 	}
 }
 
+// FieldLike returns a predicate to check if the field matches the given LIKE pattern.
+func FieldLike(name, pattern string) P {
+	return &CallExpr{
+		Func: FuncLike,
+		Args: []Expr{&Field{Name: name}, &Value{V: pattern}},
+	}
+}
+
+// FieldRegexp returns a predicate to check if the field matches the given regular expression.
+// Note that the syntax of the expression is defined by the underlying database.
+func FieldRegexp(name, expr string) P {
+	return &CallExpr{
+		Func: FuncRegexp,
+		Args: []Expr{&Field{Name: name}, &Value{V: expr}},
+	}
+}
+
+// FieldJSONHasKey returns a predicate to check if the given path (e.g. "a.b[1].c")
+// exists in the JSON field, and its value is not null.
+func FieldJSONHasKey(name, path string) P {
+	return &CallExpr{
+		Func: FuncJSONHasKey,
+		Args: []Expr{&Field{Name: name}, &Value{V: path}},
+	}
+}
+
+// FieldJSONContains returns a predicate to check if the JSON value in the given path of the
+// field contains the given value. An empty path checks the top-level value of the field.
+func FieldJSONContains(name, path string, v interface{}) P {
+	return &CallExpr{
+		Func: FuncJSONContains,
+		Args: []Expr{&Field{Name: name}, &Value{V: path}, &Value{V: v}},
+	}
+}
+
+// JSONValue returns an expression for the JSON value in the given path of the field.
+// It can be compared with values using the comparison predicates. For example:
+//
+//	entql.EQ(entql.JSONValue("labels", "owner.name"), &entql.Value{V: "a8m"})
+//
+func JSONValue(name, path string) *CallExpr {
+	return &CallExpr{
+		Func: FuncJSONValue,
+		Args: []Expr{&Field{Name: name}, &Value{V: path}},
+	}
+}
+
+// EdgeCount returns an expression for the number of neighbors that are connected to the edge,
+// and match the (optional) provided predicates. It can be compared with values using the
+// comparison predicates. For example:
+//
+//	entql.GT(entql.EdgeCount("pets", entql.FieldEQ("name", "pedro")), &entql.Value{V: 1})
+//
+func EdgeCount(name string, p ...P) *CallExpr {
+	return &CallExpr{
+		Func: FuncEdgeCount,
+		Args: append([]Expr{&Edge{Name: name}}, p2expr(p)...),
+	}
+}
+
+// EdgeField returns an expression for a field of the neighbors that are connected to the edge.
+// It can be compared with fields of the node, and a comparison is true if it holds for one of
+// the neighbors. For example, tasks that are assigned to a user of another team:
+//
+//	entql.NEQ(entql.F("team_id"), entql.EdgeField("assignee", "team_id"))
+//
+func EdgeField(edge, field string) *CallExpr {
+	return &CallExpr{
+		Func: FuncEdgeField,
+		Args: []Expr{&Edge{Name: edge}, &Field{Name: field}},
+	}
+}
+
+// DateTrunc returns an expression for a time expression (e.g. a field) truncated to the given
+// unit. The supported units are: "year", "month", "day", "hour" and "minute". For example:
+//
+//	entql.EQ(entql.DateTrunc("day", entql.F("created_at")), entql.DateTrunc("day", entql.F("updated_at")))
+//
+func DateTrunc(unit string, x Expr) *CallExpr {
+	return &CallExpr{
+		Func: FuncDateTrunc,
+		Args: []Expr{&Value{V: unit}, x},
+	}
+}
+
+// DateAdd returns an expression for a time expression (e.g. a field) with the given
+// duration added to it. For example, entities that expire more than a day from now:
+//
+//	entql.GT(entql.DateAdd(entql.F("expired_at"), -24*time.Hour), &entql.Value{V: time.Now()})
+//
+func DateAdd(x Expr, d time.Duration) *CallExpr {
+	return &CallExpr{
+		Func: FuncDateAdd,
+		Args: []Expr{x, &Value{V: d.String()}},
+	}
+}
+
 // Negate negates the predicate.
 func (e *BinaryExpr) Negate() P {
 	return Not(e)
@@ -386,7 +493,16 @@ func (e *CallExpr) Negate() P {
 
 // String returns the text representation of a binary expression.
 func (e *BinaryExpr) String() string {
-	return fmt.Sprintf("%s %s %s", e.X, e.Op, e.Y)
+	return fmt.Sprintf("%s %s %s", operand(e.Op, e.X), e.Op, operand(e.Op, e.Y))
+}
+
+// operand returns the text representation of an operand of the given operator,
+// and wraps disjunctions that are operands of conjunctions with parentheses.
+func operand(op Op, x Expr) string {
+	if b, ok := x.(*BinaryExpr); ok && op == OpAnd && b.Op == OpOr {
+		return "(" + b.String() + ")"
+	}
+	return x.String()
 }
 
 // String returns the text representation of a unary expression.
@@ -404,7 +520,7 @@ func (e *NaryExpr) String() string {
 			s.WriteString(e.Op.String())
 			s.WriteByte(' ')
 		}
-		s.WriteString(x.String())
+		s.WriteString(operand(e.Op, x))
 	}
 	s.WriteByte(')')
 	return s.String()
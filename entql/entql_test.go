@@ -7,6 +7,7 @@ package entql_test
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"entgo.io/ent/entql"
 
@@ -71,6 +72,32 @@ func TestPString(t *testing.T) {
 			P: entql.EQ(entql.F("current"), entql.F("total")).Negate(),
 			S: `!(current == total)`,
 		},
+		{
+			P: entql.And(
+				entql.FieldLike("name", "a_%"),
+				entql.FieldRegexp("name", "^a+$"),
+			),
+			S: `like(name, "a_%") && regexp(name, "^a+$")`,
+		},
+		{
+			P: entql.Or(
+				entql.FieldJSONHasKey("labels", "owner.name"),
+				entql.FieldJSONContains("labels", "tags", "a"),
+				entql.EQ(entql.JSONValue("labels", "size"), &entql.Value{V: 1}),
+			),
+			S: `(json_has_key(labels, "owner.name") || json_contains(labels, "tags", "a") || json_value(labels, "size") == 1)`,
+		},
+		{
+			P: entql.And(
+				entql.GT(entql.EdgeCount("pets", entql.FieldEQ("name", "pedro")), &entql.Value{V: 1}),
+				entql.NEQ(entql.F("team_id"), entql.EdgeField("owner", "team_id")),
+			),
+			S: `edge_count(pets, name == "pedro") > 1 && team_id != edge_field(owner, team_id)`,
+		},
+		{
+			P: entql.LT(entql.DateTrunc("day", entql.F("created_at")), entql.DateAdd(entql.F("updated_at"), -90*time.Minute)),
+			S: `date_trunc("day", created_at) < date_add(updated_at, "-1h30m0s")`,
+		},
 	}
 	for i := range tests {
 		t.Run(strconv.Itoa(i), func(t *testing.T) {
@@ -79,3 +106,19 @@ func TestPString(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryString(t *testing.T) {
+	q := &entql.Query{
+		Where:  entql.FieldGT("age", 30),
+		Order:  []*entql.Order{entql.Desc("age"), entql.Asc("name")},
+		Limit:  10,
+		Offset: 20,
+		Select: []string{"name", "age"},
+		With: map[string]*entql.Query{
+			"pets":   {Where: entql.HasEdge("owner"), Limit: 1},
+			"groups": nil,
+		},
+	}
+	assert.Equal(t, `where age > 30 order by age desc, name asc limit 10 offset 20 select name, age with groups with pets(where has_edge(owner) limit 1)`, q.String())
+	assert.Empty(t, (&entql.Query{}).String())
+}
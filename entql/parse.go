@@ -0,0 +1,447 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entql
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// A Checker validates the fields and edges that are used by parsed predicates.
+// Node types are identified by the names that were passed to ParseWith, and by
+// the names that were returned by CheckEdge for the neighbors of edges.
+type Checker interface {
+	// CheckField returns an error if the field cannot be used
+	// in predicates of the given node type.
+	CheckField(node, field string) error
+	// CheckEdge returns the node type of the edge neighbors, or an
+	// error if the edge cannot be used by the given node type.
+	CheckEdge(node, edge string) (string, error)
+}
+
+// MaxDepth is the maximum nesting depth of negations, parentheses
+// and edge predicates that is accepted by the parser.
+const MaxDepth = 100
+
+// ParseError describes a failure to parse (or validate) a textual predicate.
+type ParseError struct {
+	// Pos is the byte offset in the input where the error occurred.
+	Pos int
+	// Msg describes the error.
+	Msg string
+	// Err is the underlying error that was returned by the Checker, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("entql: parse error at position %d: %s", e.Pos, e.Msg)
+}
+
+// Unwrap returns the underlying error that was returned by the Checker.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses the textual representation of a predicate, as returned by its String
+// method, and returns the predicate. Values are written as JSON literals (or nil).
+// For example:
+//
+//	p, err := entql.Parse(`name == "a8m" && age > 30 && has_edge(pets, has_prefix(name, "p"))`)
+//
+// Note that Parse checks only the syntax of the predicate. Use ParseWith for
+// validating the fields and edges it uses, before applying it on a query.
+func Parse(input string) (P, error) {
+	return parse(input, "", nil)
+}
+
+// ParseWith parses the textual representation of a predicate of the given node type,
+// and validates its fields and edges using the given Checker.
+func ParseWith(input, node string, c Checker) (P, error) {
+	return parse(input, node, c)
+}
+
+func parse(input, node string, c Checker) (p P, err error) {
+	ps := &parser{input: input, checker: c}
+	defer func() {
+		if e := recover(); e != nil {
+			perr, ok := e.(*ParseError)
+			if !ok {
+				panic(e)
+			}
+			p, err = nil, perr
+		}
+	}()
+	p = ps.or(node)
+	if ps.skipSpace(); ps.pos < len(ps.input) {
+		ps.errorf(ps.pos, "unexpected %q", ps.input[ps.pos:])
+	}
+	return p, nil
+}
+
+// parser is a recursive-descent parser for textual predicates.
+// Errors are reported by panicking with a *ParseError.
+type parser struct {
+	input   string
+	pos     int
+	depth   int
+	checker Checker
+}
+
+// or parses a disjunction of conjunctions.
+func (p *parser) or(node string) P {
+	xs := []P{p.and(node)}
+	for p.accept("||") {
+		xs = append(xs, p.and(node))
+	}
+	return compose(OpOr, xs)
+}
+
+// and parses a conjunction of unary predicates.
+func (p *parser) and(node string) P {
+	xs := []P{p.unary(node)}
+	for p.accept("&&") {
+		xs = append(xs, p.unary(node))
+	}
+	return compose(OpAnd, xs)
+}
+
+// unary parses a negation, a parenthesized predicate or a basic predicate.
+func (p *parser) unary(node string) P {
+	if p.depth++; p.depth > MaxDepth {
+		p.errorf(p.pos, "maximum nesting depth (%d) exceeded", MaxDepth)
+	}
+	defer func() { p.depth-- }()
+	switch {
+	case p.peek("!") && !p.peek("!="):
+		p.pos++
+		return Not(p.unary(node))
+	case p.accept("("):
+		x := p.or(node)
+		p.expect(")")
+		return x
+	default:
+		return p.predicate(node)
+	}
+}
+
+// predicate parses a comparison, or a call to a predicate function.
+func (p *parser) predicate(node string) P {
+	p.skipSpace()
+	start := p.pos
+	x := p.operand(node)
+	op, ok := p.op()
+	if !ok {
+		call, ok := x.(*CallExpr)
+		if !ok || !isPredicate(call.Func) {
+			p.errorf(start, "expect predicate")
+		}
+		return call
+	}
+	if _, ok := x.(*Value); ok {
+		p.errorf(start, "the left operand of %s cannot be a value", op)
+	}
+	p.skipSpace()
+	ystart := p.pos
+	y := p.operand(node)
+	switch v, isValue := y.(*Value); {
+	case (op == OpIn || op == OpNotIn) && !isList(y):
+		p.errorf(ystart, "expect list of values for %s", op)
+	case isValue && v == nil && op != OpEQ && op != OpNEQ:
+		p.errorf(ystart, "nil cannot be compared using %s", op)
+	}
+	return &BinaryExpr{Op: op, X: x, Y: y}
+}
+
+// operand parses a field, a value or a function call.
+func (p *parser) operand(node string) Expr {
+	p.skipSpace()
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.errorf(start, "unexpected end of input")
+	}
+	if !isLetter(p.input[p.pos]) {
+		return p.value()
+	}
+	switch name := p.ident(); {
+	case name == "nil":
+		return (*Value)(nil)
+	case name == "true" || name == "false":
+		return &Value{V: name == "true"}
+	case p.accept("("):
+		return p.call(node, Func(name), start)
+	default:
+		p.checkField(node, name, start)
+		return &Field{Name: name}
+	}
+}
+
+// call parses the arguments of a function call.
+func (p *parser) call(node string, fn Func, start int) *CallExpr {
+	call := &CallExpr{Func: fn}
+	switch fn {
+	case FuncHasEdge, FuncEdgeCount:
+		edge, to := p.edge(node)
+		call.Args = append(call.Args, edge)
+		for p.accept(",") {
+			call.Args = append(call.Args, p.or(to))
+		}
+	case FuncEdgeField:
+		edge, to := p.edge(node)
+		p.expect(",")
+		call.Args = append(call.Args, edge, p.field(to))
+	case FuncEqualFold, FuncContains, FuncContainsFold, FuncHasPrefix, FuncHasSuffix, FuncLike, FuncRegexp, FuncJSONHasKey, FuncJSONValue:
+		f := p.field(node)
+		p.expect(",")
+		call.Args = append(call.Args, f, p.stringValue())
+	case FuncJSONContains:
+		f := p.field(node)
+		p.expect(",")
+		path := p.stringValue()
+		p.expect(",")
+		call.Args = append(call.Args, f, path, p.value())
+	case FuncDateTrunc:
+		unit := p.stringValue()
+		p.expect(",")
+		call.Args = append(call.Args, unit, p.operand(node))
+	case FuncDateAdd:
+		x := p.operand(node)
+		p.expect(",")
+		p.skipSpace()
+		vstart := p.pos
+		d := p.stringValue()
+		if _, err := time.ParseDuration(d.V.(string)); err != nil {
+			p.errorf(vstart, "invalid duration %q", d.V)
+		}
+		call.Args = append(call.Args, x, d)
+	default:
+		p.errorf(start, "unknown function %q", fn)
+	}
+	p.expect(")")
+	return call
+}
+
+// field parses a field name and validates it.
+func (p *parser) field(node string) *Field {
+	p.skipSpace()
+	start := p.pos
+	if p.pos >= len(p.input) || !isLetter(p.input[p.pos]) {
+		p.errorf(start, "expect field name")
+	}
+	name := p.ident()
+	p.checkField(node, name, start)
+	return &Field{Name: name}
+}
+
+// edge parses an edge name, validates it and returns the node type of its neighbors.
+func (p *parser) edge(node string) (*Edge, string) {
+	p.skipSpace()
+	start := p.pos
+	if p.pos >= len(p.input) || !isLetter(p.input[p.pos]) {
+		p.errorf(start, "expect edge name")
+	}
+	name := p.ident()
+	if p.checker == nil {
+		return &Edge{Name: name}, ""
+	}
+	to, err := p.checker.CheckEdge(node, name)
+	if err != nil {
+		panic(&ParseError{Pos: start, Msg: err.Error(), Err: err})
+	}
+	return &Edge{Name: name}, to
+}
+
+// checkField validates the field using the checker.
+func (p *parser) checkField(node, name string, pos int) {
+	if p.checker == nil {
+		return
+	}
+	if err := p.checker.CheckField(node, name); err != nil {
+		panic(&ParseError{Pos: pos, Msg: err.Error(), Err: err})
+	}
+}
+
+// value parses a JSON literal.
+func (p *parser) value() *Value {
+	p.skipSpace()
+	dec := json.NewDecoder(strings.NewReader(p.input[p.pos:]))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		p.errorf(p.pos, "invalid value: %v", err)
+	}
+	v, err := jsonValue(v)
+	if err != nil {
+		p.errorf(p.pos, "invalid value: %v", err)
+	}
+	p.pos += int(dec.InputOffset())
+	return &Value{V: v}
+}
+
+// stringValue parses a JSON string literal.
+func (p *parser) stringValue() *Value {
+	p.skipSpace()
+	start := p.pos
+	if !p.peek(`"`) {
+		p.errorf(start, "expect string value")
+	}
+	return p.value()
+}
+
+// op parses a binary comparison operator.
+func (p *parser) op() (Op, bool) {
+	for _, op := range []Op{OpEQ, OpNEQ, OpGTE, OpLTE, OpGT, OpLT} {
+		if p.accept(op.String()) {
+			return op, true
+		}
+	}
+	switch {
+	case p.acceptWord("in"):
+		return OpIn, true
+	case p.peekWord("not"):
+		pos := p.pos
+		if p.acceptWord("not") && p.acceptWord("in") {
+			return OpNotIn, true
+		}
+		p.pos = pos
+	}
+	return 0, false
+}
+
+// ident parses an identifier. The current character is expected to be a letter.
+func (p *parser) ident() string {
+	start := p.pos
+	for p.pos < len(p.input) && (isLetter(p.input[p.pos]) || isDigit(p.input[p.pos])) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// skipSpace skips whitespace characters.
+func (p *parser) skipSpace() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\r\n", p.input[p.pos]) != -1 {
+		p.pos++
+	}
+}
+
+// peek reports if the remaining input starts with s (after whitespace).
+func (p *parser) peek(s string) bool {
+	p.skipSpace()
+	return strings.HasPrefix(p.input[p.pos:], s)
+}
+
+// accept consumes s if the remaining input starts with it.
+func (p *parser) accept(s string) bool {
+	if !p.peek(s) {
+		return false
+	}
+	p.pos += len(s)
+	return true
+}
+
+// peekWord reports if the remaining input starts with the given word.
+func (p *parser) peekWord(w string) bool {
+	if !p.peek(w) {
+		return false
+	}
+	end := p.pos + len(w)
+	return end == len(p.input) || !isLetter(p.input[end]) && !isDigit(p.input[end])
+}
+
+// acceptWord consumes the given word if the remaining input starts with it.
+func (p *parser) acceptWord(w string) bool {
+	if !p.peekWord(w) {
+		return false
+	}
+	p.pos += len(w)
+	return true
+}
+
+// expect consumes s, or fails if the remaining input does not start with it.
+func (p *parser) expect(s string) {
+	if !p.accept(s) {
+		if p.pos >= len(p.input) {
+			p.errorf(p.pos, "expect %q, got end of input", s)
+		}
+		p.errorf(p.pos, "expect %q", s)
+	}
+}
+
+// errorf fails the parsing with an error at the given position.
+func (p *parser) errorf(pos int, format string, args ...interface{}) {
+	panic(&ParseError{Pos: pos, Msg: fmt.Sprintf(format, args...)})
+}
+
+// compose composes the predicates with the given logical operator.
+func compose(op Op, xs []P) P {
+	switch len(xs) {
+	case 1:
+		return xs[0]
+	case 2:
+		return &BinaryExpr{Op: op, X: xs[0], Y: xs[1]}
+	default:
+		return &NaryExpr{Op: op, Xs: p2expr(xs)}
+	}
+}
+
+// jsonValue converts the numbers of a decoded JSON value to int or float64.
+// An error is returned for numbers that cannot be represented as finite float64.
+func jsonValue(v interface{}) (interface{}, error) {
+	var err error
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("number %s is out of range", v)
+		}
+		return f, nil
+	case []interface{}:
+		for i := range v {
+			if v[i], err = jsonValue(v[i]); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for k := range v {
+			if v[k], err = jsonValue(v[k]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return v, nil
+}
+
+// isPredicate reports if the function can be used as a predicate.
+func isPredicate(fn Func) bool {
+	switch fn {
+	case FuncJSONValue, FuncEdgeCount, FuncEdgeField, FuncDateTrunc, FuncDateAdd:
+		return false
+	}
+	return true
+}
+
+// isList reports if the expression is a list of values.
+func isList(x Expr) bool {
+	v, ok := x.(*Value)
+	if !ok || v == nil {
+		return false
+	}
+	_, ok = v.V.([]interface{})
+	return ok
+}
+
+func isLetter(c byte) bool {
+	return c == '_' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
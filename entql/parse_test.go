@@ -0,0 +1,168 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entql_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entql"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []string{
+		`name == "a8m" && org in ["fb","ent"]`,
+		`!(name == "mashraki") || org in ["fb","ent"]`,
+		`has_edge(groups, has_edge(admins, !(name == "a8m")))`,
+		`age > 30 && contains(workplace, "fb")`,
+		`!(score < 32.23)`,
+		`active == nil && name != nil`,
+		`id not in [1,2,3] || has_suffix(name, "admin")`,
+		`!(current == total)`,
+		`like(name, "a_%") && regexp(name, "^a+$")`,
+		`(json_has_key(labels, "owner.name") || json_contains(labels, "tags", "a") || json_value(labels, "size") == 1)`,
+		`edge_count(pets, name == "pedro") > 1 && team_id != edge_field(owner, team_id)`,
+		`date_trunc("day", created_at) < date_add(updated_at, "-1h30m0s")`,
+		`(a == 1 || b == 2) && c == true`,
+		`(a == 1 && b >= 2 && c <= 3)`,
+		`(a == 1 && (b == 2 || c == 3) && d == 4)`,
+		`(a == 1 || b == 2 && c == 3 || d == 4)`,
+		`has_edge(pets) && json_contains(labels, "tags", {"a":[1,2.5,"b"]})`,
+	}
+	for _, s := range tests {
+		t.Run(s, func(t *testing.T) {
+			p, err := entql.Parse(s)
+			require.NoError(t, err)
+			assert.Equal(t, s, p.String())
+		})
+	}
+}
+
+func TestParse_RoundTrip(t *testing.T) {
+	a, b, c, d := entql.FieldEQ("a", 1), entql.FieldEQ("b", 2), entql.FieldEQ("c", 3), entql.FieldEQ("d", 4)
+	tests := []entql.P{
+		entql.And(a, entql.Or(b, c), d),
+		entql.Or(a, entql.And(b, c), d),
+		entql.And(entql.Or(a, b), entql.Or(c, d)),
+		entql.And(a, entql.Or(b, c, d)),
+	}
+	for _, p := range tests {
+		t.Run(p.String(), func(t *testing.T) {
+			parsed, err := entql.Parse(p.String())
+			require.NoError(t, err)
+			assert.Equal(t, p.String(), parsed.String())
+			assert.Equal(t, p, parsed)
+		})
+	}
+}
+
+func TestParse_Values(t *testing.T) {
+	p, err := entql.Parse(` age in [1, 2.5]  &&name=="a\"b"`)
+	require.NoError(t, err)
+	assert.Equal(t, entql.And(
+		entql.FieldIn("age", 1, 2.5),
+		entql.FieldEQ("name", `a"b`),
+	), p)
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		input string
+		pos   int
+		msg   string
+	}{
+		{input: ``, pos: 0, msg: "unexpected end of input"},
+		{input: `name ==`, pos: 7, msg: "unexpected end of input"},
+		{input: `name == "a8m" name`, pos: 14, msg: `unexpected "name"`},
+		{input: `name`, pos: 0, msg: "expect predicate"},
+		{input: `"a8m" == name`, pos: 0, msg: "the left operand of == cannot be a value"},
+		{input: `age in 1`, pos: 7, msg: "expect list of values for in"},
+		{input: `age > nil`, pos: 6, msg: "nil cannot be compared using >"},
+		{input: `(age > 1`, pos: 8, msg: `expect ")", got end of input`},
+		{input: `age > 1 && unknown(name)`, pos: 11, msg: `unknown function "unknown"`},
+		{input: `has_prefix(name, 1)`, pos: 17, msg: "expect string value"},
+		{input: `date_add(created_at, "1y") > 1`, pos: 21, msg: `invalid duration "1y"`},
+		{input: `age > 1 &&`, pos: 10, msg: "unexpected end of input"},
+		{input: `!active || deleted_at == nil`, pos: 1, msg: "expect predicate"},
+		{input: `age > [1,`, pos: 6, msg: "invalid value: unexpected EOF"},
+		{input: `name == 1e400`, pos: 8, msg: "invalid value: number 1e400 is out of range"},
+		{input: `age in [1, -1e400]`, pos: 7, msg: "invalid value: number -1e400 is out of range"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := entql.Parse(tt.input)
+			var perr *entql.ParseError
+			require.True(t, errors.As(err, &perr))
+			assert.Equal(t, tt.pos, perr.Pos)
+			assert.Equal(t, tt.msg, perr.Msg)
+		})
+	}
+}
+
+func TestParse_Depth(t *testing.T) {
+	nested := func(n int) string {
+		return strings.Repeat("(", n) + "age > 1" + strings.Repeat(")", n)
+	}
+	_, err := entql.Parse(nested(entql.MaxDepth - 1))
+	require.NoError(t, err)
+	for _, input := range []string{nested(entql.MaxDepth + 1), strings.Repeat("!", 2000000) + "active", nested(2000000)} {
+		_, err := entql.Parse(input)
+		var perr *entql.ParseError
+		require.True(t, errors.As(err, &perr))
+		assert.Equal(t, entql.MaxDepth, perr.Pos)
+		assert.Equal(t, "maximum nesting depth (100) exceeded", perr.Msg)
+	}
+}
+
+// checker allows the fields and edges of a simple users/pets graph.
+type checker map[string]map[string]string
+
+func (c checker) CheckField(node, field string) error {
+	if _, ok := c[node][field]; !ok {
+		return fmt.Errorf("unknown field %q for type %q", field, node)
+	}
+	return nil
+}
+
+func (c checker) CheckEdge(node, edge string) (string, error) {
+	if to := c[node][edge]; to != "" {
+		return to, nil
+	}
+	return "", fmt.Errorf("unknown edge %q for type %q", edge, node)
+}
+
+func TestParseWith(t *testing.T) {
+	errDenied := errors.New("denied")
+	c := checker{
+		"User": {"name": "", "age": "", "pets": "Pet"},
+		"Pet":  {"name": "", "owner": "User"},
+	}
+	p, err := entql.ParseWith(`age > 30 && has_edge(pets, has_edge(owner, name == "a8m"))`, "User", c)
+	require.NoError(t, err)
+	assert.Equal(t, `age > 30 && has_edge(pets, has_edge(owner, name == "a8m"))`, p.String())
+
+	_, err = entql.ParseWith(`has_edge(pets, age > 1)`, "User", c)
+	var perr *entql.ParseError
+	require.True(t, errors.As(err, &perr))
+	assert.Equal(t, 15, perr.Pos)
+	assert.EqualError(t, err, `entql: parse error at position 15: unknown field "age" for type "Pet"`)
+
+	_, err = entql.ParseWith(`name == "a" || has_edge(friends)`, "User", c)
+	require.True(t, errors.As(err, &perr))
+	assert.Equal(t, 24, perr.Pos)
+
+	_, err = entql.ParseWith(`name == "a"`, "User", denyChecker{errDenied})
+	assert.True(t, errors.Is(err, errDenied))
+}
+
+type denyChecker struct{ err error }
+
+func (c denyChecker) CheckField(string, string) error          { return c.err }
+func (c denyChecker) CheckEdge(string, string) (string, error) { return "", c.err }
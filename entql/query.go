@@ -0,0 +1,102 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package entql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A Query describes a dynamic query of a node type, and it is used by generic
+// tools that query the graph by type, field and edge names. For example:
+//
+//	&entql.Query{
+//		Where:  entql.FieldGT("age", 30),
+//		Order:  []*entql.Order{entql.Desc("age"), entql.Asc("name")},
+//		Limit:  10,
+//		Select: []string{"name", "age"},
+//		With: map[string]*entql.Query{
+//			"pets": {Order: []*entql.Order{entql.Asc("name")}},
+//		},
+//	}
+//
+type Query struct {
+	// Where holds the predicate for filtering the nodes. Optional.
+	Where P
+	// Order holds the fields for ordering the nodes.
+	Order []*Order
+	// Limit and Offset paginate the nodes. A zero Limit means no limit.
+	Limit  int
+	Offset int
+	// Select holds the fields to project. An empty list selects all fields.
+	Select []string
+	// With maps edge names to the queries that are used for
+	// eager-loading their neighbors (nil queries are allowed).
+	// Note that, the pagination of eager-loading queries is
+	// applied on all neighbors, and not per node.
+	With map[string]*Query
+}
+
+// Order describes the ordering of the nodes by one of their fields.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// Asc returns an ascending ordering by the given field.
+func Asc(field string) *Order {
+	return &Order{Field: field}
+}
+
+// Desc returns a descending ordering by the given field.
+func Desc(field string) *Order {
+	return &Order{Field: field, Desc: true}
+}
+
+// String returns the text representation of an ordering.
+func (o *Order) String() string {
+	if o.Desc {
+		return o.Field + " desc"
+	}
+	return o.Field + " asc"
+}
+
+// String returns the text representation of a query.
+func (q *Query) String() string {
+	var parts []string
+	if q.Where != nil {
+		parts = append(parts, "where "+q.Where.String())
+	}
+	if len(q.Order) > 0 {
+		order := make([]string, len(q.Order))
+		for i := range q.Order {
+			order[i] = q.Order[i].String()
+		}
+		parts = append(parts, "order by "+strings.Join(order, ", "))
+	}
+	if q.Limit > 0 {
+		parts = append(parts, fmt.Sprintf("limit %d", q.Limit))
+	}
+	if q.Offset > 0 {
+		parts = append(parts, fmt.Sprintf("offset %d", q.Offset))
+	}
+	if len(q.Select) > 0 {
+		parts = append(parts, "select "+strings.Join(q.Select, ", "))
+	}
+	edges := make([]string, 0, len(q.With))
+	for name := range q.With {
+		edges = append(edges, name)
+	}
+	sort.Strings(edges)
+	for _, name := range edges {
+		if wq := q.With[name]; wq != nil && wq.String() != "" {
+			parts = append(parts, fmt.Sprintf("with %s(%s)", name, wq))
+		} else {
+			parts = append(parts, "with "+name)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -118,11 +118,13 @@ func (sc *StreetCreate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (sc *StreetCreate) check() error {
+	var errs ValidationErrors
 	if _, ok := sc.mutation.Name(); !ok {
-		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Street.name"`)}
+		errs = append(errs, newValidationError("name", "Required", errors.New(`ent: missing required field "Street.name"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (sc *StreetCreate) sqlSave(ctx context.Context) (*Street, error) {
@@ -191,6 +193,9 @@ func (scb *StreetCreateBulk) Save(ctx context.Context) ([]*Street, error) {
 	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
 	nodes := make([]*Street, len(scb.builders))
 	mutators := make([]Mutator, len(scb.builders))
+	// Validation errors of all builders are collected
+	// and returned before executing the operation.
+	var errs ValidationErrors
 	for i := range scb.builders {
 		func(i int, root context.Context) {
 			builder := scb.builders[i]
@@ -200,13 +205,20 @@ func (scb *StreetCreateBulk) Save(ctx context.Context) ([]*Street, error) {
 					return nil, fmt.Errorf("unexpected mutation type %T", m)
 				}
 				if err := builder.check(); err != nil {
-					return nil, err
+					verrs, ok := err.(ValidationErrors)
+					if !ok {
+						return nil, err
+					}
+					verrs.prefix(i)
+					errs = append(errs, verrs...)
 				}
 				builder.mutation = mutation
 				nodes[i], specs[i] = builder.createSpec()
 				var err error
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else if len(errs) > 0 {
+					err = errs
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
 					// Invoke the actual operation on the latest mutation in the chain.
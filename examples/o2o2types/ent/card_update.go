@@ -128,11 +128,13 @@ func (cu *CardUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cu *CardUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := cu.mutation.OwnerID(); cu.mutation.OwnerCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Card.owner"`)
+		errs = append(errs, newValidationError("owner", "Required", errors.New(`ent: clearing a required unique edge "Card.owner"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (cu *CardUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -323,11 +325,13 @@ func (cuo *CardUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (cuo *CardUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := cuo.mutation.OwnerID(); cuo.mutation.OwnerCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Card.owner"`)
+		errs = append(errs, newValidationError("owner", "Required", errors.New(`ent: clearing a required unique edge "Card.owner"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (cuo *CardUpdateOne) sqlSave(ctx context.Context) (_node *Card, err error) {
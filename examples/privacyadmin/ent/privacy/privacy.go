@@ -55,6 +55,14 @@ func DecisionFromContext(ctx context.Context) (error, bool) {
 	return privacy.DecisionFromContext(ctx)
 }
 
+// Trace records the evaluation of privacy policies and their rules.
+type Trace = privacy.Trace
+
+// TraceContext returns a new context from the given parent context with the trace attached to it.
+func TraceContext(parent context.Context, t *Trace) context.Context {
+	return privacy.TraceContext(parent, t)
+}
+
 type (
 	// QueryRule defines the interface deciding whether a
 	// query is allowed and optionally modify it.
@@ -89,10 +97,22 @@ func (f MutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) erro
 	return f(ctx, m)
 }
 
-// Policy groups query and mutation policies.
+type (
+	// LoadRule defines the interface deciding whether the
+	// loaded entities are visible to the viewer.
+	LoadRule = privacy.LoadRule
+	// LoadPolicy combines multiple load rules into a single policy.
+	LoadPolicy = privacy.LoadPolicy
+	// LoadRuleFunc type is an adapter to allow the use of
+	// ordinary functions as load rules.
+	LoadRuleFunc = privacy.LoadRuleFunc
+)
+
+// Policy groups query, mutation and load policies.
 type Policy struct {
 	Query    QueryPolicy
 	Mutation MutationPolicy
+	Load     LoadPolicy
 }
 
 // EvalQuery forwards evaluation to query a policy.
@@ -105,6 +125,45 @@ func (policy Policy) EvalMutation(ctx context.Context, m ent.Mutation) error {
 	return policy.Mutation.EvalMutation(ctx, m)
 }
 
+// EvalLoad forwards evaluation to load a policy.
+func (policy Policy) EvalLoad(ctx context.Context, nodes []ent.Value) ([]error, error) {
+	return policy.Load.EvalLoad(ctx, nodes)
+}
+
+// HasLoadRules reports if the policy has load rules.
+func (policy Policy) HasLoadRules() bool {
+	return policy.Load.HasLoadRules()
+}
+
+var (
+	// Omit may be returned by read rules to indicate that
+	// the field should be omitted from the query.
+	Omit = privacy.Omit
+
+	// Null may be returned by read rules to indicate that
+	// the field should be returned as null.
+	Null = privacy.Null
+)
+
+// Mask returns a read decision for masking the value of
+// the field using the given function.
+func Mask(fn func(ent.Value) ent.Value) error {
+	return privacy.Mask(fn)
+}
+
+type (
+	// ReadRule defines the interface deciding how a field is read.
+	ReadRule = privacy.ReadRule
+	// ReadPolicy combines multiple read rules into a single policy.
+	ReadPolicy = privacy.ReadPolicy
+	// ReadRuleFunc type is an adapter to allow the use of
+	// ordinary functions as read rules.
+	ReadRuleFunc = privacy.ReadRuleFunc
+	// FieldPolicy is a field annotation for defining
+	// field-level read and mutation policies.
+	FieldPolicy = privacy.FieldPolicy
+)
+
 // QueryMutationRule is an interface which groups query and mutation rules.
 type QueryMutationRule interface {
 	QueryRule
@@ -160,6 +219,27 @@ func OnMutationOperation(rule MutationRule, op ent.Op) MutationRule {
 	})
 }
 
+// EdgeChange describes the changes of a mutation to an edge.
+type EdgeChange = privacy.EdgeChange
+
+// EdgeRule returns a mutation rule that calls the given function only if the
+// mutation changes the given edge. Other mutations are skipped.
+func EdgeRule(edge string, fn func(context.Context, ent.Mutation, EdgeChange) error) MutationRule {
+	return privacy.EdgeRule(edge, fn)
+}
+
+// DenyEdgeAdd returns a mutation rule that denies adding entities to the
+// given edge, unless their IDs are returned by the allowed function.
+func DenyEdgeAdd(edge string, allowed func(context.Context) ([]ent.Value, error)) MutationRule {
+	return privacy.DenyEdgeAdd(edge, allowed)
+}
+
+// DenyEdgeRemove returns a mutation rule that denies removing entities from
+// the given edge, unless their IDs are returned by the allowed function.
+func DenyEdgeRemove(edge string, allowed func(context.Context) ([]ent.Value, error)) MutationRule {
+	return privacy.DenyEdgeRemove(edge, allowed)
+}
+
 // DenyMutationOperationRule returns a rule denying specified mutation operation.
 func DenyMutationOperationRule(op ent.Op) MutationRule {
 	rule := MutationRuleFunc(func(_ context.Context, m ent.Mutation) error {
@@ -191,3 +271,20 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	}
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
 }
+
+// The UserLoadRuleFunc type is an adapter to allow the use of ordinary
+// functions as a load rule.
+type UserLoadRuleFunc func(context.Context, []*ent.User) ([]error, error)
+
+// EvalLoad calls f(ctx, nodes).
+func (f UserLoadRuleFunc) EvalLoad(ctx context.Context, values []ent.Value) ([]error, error) {
+	nodes := make([]*ent.User, len(values))
+	for i, v := range values {
+		n, ok := v.(*ent.User)
+		if !ok {
+			return nil, Denyf("ent/privacy: unexpected node type %T, expect *ent.User", v)
+		}
+		nodes[i] = n
+	}
+	return f(ctx, nodes)
+}
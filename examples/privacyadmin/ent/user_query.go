@@ -12,10 +12,12 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/privacyadmin/ent/predicate"
 	"entgo.io/ent/examples/privacyadmin/ent/user"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
 )
 
@@ -183,6 +185,18 @@ func (uq *UserQuery) AllX(ctx context.Context) []*User {
 
 // IDs executes the query and returns a list of User IDs.
 func (uq *UserQuery) IDs(ctx context.Context) ([]int, error) {
+	// Load rules are evaluated on the loaded entities.
+	if privacy.HasLoadRules(user.Policy) {
+		nodes, err := uq.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		return ids, nil
+	}
 	var ids []int
 	if err := uq.Select(user.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
@@ -218,6 +232,13 @@ func (uq *UserQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (uq *UserQuery) Exist(ctx context.Context) (bool, error) {
+	if privacy.HasLoadRules(user.Policy) {
+		ids, err := uq.IDs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(ids) > 0, nil
+	}
 	if err := uq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
@@ -341,9 +362,36 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	nodes, err := uq.evalLoad(ctx, nodes)
+	if err != nil || len(nodes) == 0 {
+		return nodes, err
+	}
 	return nodes, nil
 }
 
+// evalLoad evaluates the load policy of the User type on
+// the loaded nodes, and returns the nodes that are visible to the viewer.
+func (uq *UserQuery) evalLoad(ctx context.Context, nodes []*User) ([]*User, error) {
+	values := make([]ent.Value, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i]
+	}
+	visible, err := privacy.EvalLoad(ctx, user.Policy, values)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return nodes, nil
+	}
+	filtered := nodes[:0]
+	for i := range nodes {
+		if visible[i] {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered, nil
+}
+
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
 	_spec.Node.Columns = uq.fields
@@ -720,6 +768,15 @@ func (us *UserSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := us.prepareQuery(ctx); err != nil {
 		return err
 	}
+	// Load rules are evaluated on the loaded entities. Hence, the
+	// selection is limited to the IDs of the visible entities.
+	if privacy.HasLoadRules(user.Policy) {
+		ids, err := us.UserQuery.Clone().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		us.Where(user.IDIn(ids...))
+	}
 	us.sql = us.UserQuery.sqlQuery(ctx)
 	return us.sqlScan(ctx, v)
 }
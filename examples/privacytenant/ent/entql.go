@@ -128,7 +128,52 @@ func (gq *GroupQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the GroupQuery builder.
 func (gq *GroupQuery) Filter() *GroupFilter {
-	return &GroupFilter{gq}
+	return &GroupFilter{predicateAdder: gq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// GroupQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (gq *GroupQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[0].Type, q, allow); err != nil {
+		return err
+	}
+	gq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the GroupQuery builder.
+func (gq *GroupQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		gq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			gq.Order(Desc(o.Field))
+		} else {
+			gq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		gq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		gq.Offset(q.Offset)
+	}
+	gq.fields = append(gq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case group.EdgeTenant:
+			gq.WithTenant(func(query *TenantQuery) { query.applyQuery(wq) })
+		case group.EdgeUsers:
+			gq.WithUsers(func(query *UserQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -138,16 +183,26 @@ func (m *GroupMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the GroupMutation builder.
 func (m *GroupMutation) Filter() *GroupFilter {
-	return &GroupFilter{m}
+	return &GroupFilter{predicateAdder: m}
 }
 
 // GroupFilter provides a generic filtering capability at runtime for GroupQuery.
 type GroupFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *GroupFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *GroupFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
 			s.AddError(err)
@@ -155,6 +210,19 @@ func (f *GroupFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *GroupFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[0].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *GroupFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(group.FieldID))
@@ -200,7 +268,43 @@ func (tq *TenantQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the TenantQuery builder.
 func (tq *TenantQuery) Filter() *TenantFilter {
-	return &TenantFilter{tq}
+	return &TenantFilter{predicateAdder: tq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// TenantQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (tq *TenantQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[1].Type, q, allow); err != nil {
+		return err
+	}
+	tq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the TenantQuery builder.
+func (tq *TenantQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		tq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			tq.Order(Desc(o.Field))
+		} else {
+			tq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		tq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		tq.Offset(q.Offset)
+	}
+	tq.fields = append(tq.fields, q.Select...)
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -210,16 +314,26 @@ func (m *TenantMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the TenantMutation builder.
 func (m *TenantMutation) Filter() *TenantFilter {
-	return &TenantFilter{m}
+	return &TenantFilter{predicateAdder: m}
 }
 
 // TenantFilter provides a generic filtering capability at runtime for TenantQuery.
 type TenantFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *TenantFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *TenantFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
 			s.AddError(err)
@@ -227,6 +341,19 @@ func (f *TenantFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *TenantFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[1].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *TenantFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(tenant.FieldID))
@@ -244,7 +371,52 @@ func (uq *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns a Filter implementation to apply filters on the UserQuery builder.
 func (uq *UserQuery) Filter() *UserFilter {
-	return &UserFilter{uq}
+	return &UserFilter{predicateAdder: uq}
+}
+
+// ApplyQuery validates the dynamic entql query against the schema graph and the allow-list,
+// and applies its predicate, ordering, pagination, projection and eager-loading on the
+// UserQuery builder. A nil allow-list permits all fields and edges, and should be
+// used only for trusted input.
+func (uq *UserQuery) ApplyQuery(q *entql.Query, allow sqlgraph.AllowList) error {
+	if err := schemaGraph.CheckQuery(schemaGraph.Nodes[2].Type, q, allow); err != nil {
+		return err
+	}
+	uq.applyQuery(q)
+	return nil
+}
+
+// applyQuery applies a validated entql query on the UserQuery builder.
+func (uq *UserQuery) applyQuery(q *entql.Query) {
+	if q == nil {
+		return
+	}
+	if q.Where != nil {
+		uq.Filter().Where(q.Where)
+	}
+	for _, o := range q.Order {
+		if o.Desc {
+			uq.Order(Desc(o.Field))
+		} else {
+			uq.Order(Asc(o.Field))
+		}
+	}
+	if q.Limit > 0 {
+		uq.Limit(q.Limit)
+	}
+	if q.Offset > 0 {
+		uq.Offset(q.Offset)
+	}
+	uq.fields = append(uq.fields, q.Select...)
+	for name, wq := range q.With {
+		wq := wq
+		switch name {
+		case user.EdgeTenant:
+			uq.WithTenant(func(query *TenantQuery) { query.applyQuery(wq) })
+		case user.EdgeGroups:
+			uq.WithGroups(func(query *GroupQuery) { query.applyQuery(wq) })
+		}
+	}
 }
 
 // addPredicate implements the predicateAdder interface.
@@ -254,16 +426,26 @@ func (m *UserMutation) addPredicate(pred func(s *sql.Selector)) {
 
 // Filter returns an entql.Where implementation to apply filters on the UserMutation builder.
 func (m *UserMutation) Filter() *UserFilter {
-	return &UserFilter{m}
+	return &UserFilter{predicateAdder: m}
 }
 
 // UserFilter provides a generic filtering capability at runtime for UserQuery.
 type UserFilter struct {
 	predicateAdder
+	onWhere func(entql.P)
+}
+
+// OnWhere registers a function that is called with each entql predicate
+// applied on the filter. It is used by the privacy package for tracing.
+func (f *UserFilter) OnWhere(fn func(entql.P)) {
+	f.onWhere = fn
 }
 
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
+	if f.onWhere != nil {
+		f.onWhere(p)
+	}
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
@@ -271,6 +453,19 @@ func (f *UserFilter) Where(p entql.P) {
 	})
 }
 
+// WhereExpr parses the textual entql predicate, validates its fields and edges
+// against the schema graph and the allow-list, and applies it on the query filter.
+// A nil allow-list permits all fields and edges, and should be used only for trusted
+// input. Errors are returned as *entql.ParseError.
+func (f *UserFilter) WhereExpr(expr string, allow sqlgraph.AllowList) error {
+	p, err := schemaGraph.ParseP(schemaGraph.Nodes[2].Type, expr, allow)
+	if err != nil {
+		return err
+	}
+	f.Where(p)
+	return nil
+}
+
 // WhereID applies the entql int predicate on the id field.
 func (f *UserFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(user.FieldID))
@@ -13,12 +13,14 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/privacytenant/ent/group"
 	"entgo.io/ent/examples/privacytenant/ent/predicate"
 	"entgo.io/ent/examples/privacytenant/ent/tenant"
 	"entgo.io/ent/examples/privacytenant/ent/user"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
 )
 
@@ -234,6 +236,18 @@ func (gq *GroupQuery) AllX(ctx context.Context) []*Group {
 
 // IDs executes the query and returns a list of Group IDs.
 func (gq *GroupQuery) IDs(ctx context.Context) ([]int, error) {
+	// Load rules are evaluated on the loaded entities.
+	if privacy.HasLoadRules(group.Policy) {
+		nodes, err := gq.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		return ids, nil
+	}
 	var ids []int
 	if err := gq.Select(group.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
@@ -269,6 +283,13 @@ func (gq *GroupQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (gq *GroupQuery) Exist(ctx context.Context) (bool, error) {
+	if privacy.HasLoadRules(group.Policy) {
+		ids, err := gq.IDs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(ids) > 0, nil
+	}
 	if err := gq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
@@ -428,6 +449,10 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	nodes, err := gq.evalLoad(ctx, nodes)
+	if err != nil || len(nodes) == 0 {
+		return nodes, err
+	}
 
 	if query := gq.withTenant; query != nil {
 		ids := make([]int, 0, len(nodes))
@@ -526,6 +551,29 @@ func (gq *GroupQuery) sqlAll(ctx context.Context) ([]*Group, error) {
 	return nodes, nil
 }
 
+// evalLoad evaluates the load policy of the Group type on
+// the loaded nodes, and returns the nodes that are visible to the viewer.
+func (gq *GroupQuery) evalLoad(ctx context.Context, nodes []*Group) ([]*Group, error) {
+	values := make([]ent.Value, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i]
+	}
+	visible, err := privacy.EvalLoad(ctx, group.Policy, values)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return nodes, nil
+	}
+	filtered := nodes[:0]
+	for i := range nodes {
+		if visible[i] {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered, nil
+}
+
 func (gq *GroupQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
 	_spec.Node.Columns = gq.fields
@@ -902,6 +950,15 @@ func (gs *GroupSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := gs.prepareQuery(ctx); err != nil {
 		return err
 	}
+	// Load rules are evaluated on the loaded entities. Hence, the
+	// selection is limited to the IDs of the visible entities.
+	if privacy.HasLoadRules(group.Policy) {
+		ids, err := gs.GroupQuery.Clone().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		gs.Where(group.IDIn(ids...))
+	}
 	gs.sql = gs.GroupQuery.sqlQuery(ctx)
 	return gs.sqlScan(ctx, v)
 }
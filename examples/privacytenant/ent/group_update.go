@@ -166,11 +166,13 @@ func (gu *GroupUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (gu *GroupUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := gu.mutation.TenantID(); gu.mutation.TenantCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.tenant"`)
+		errs = append(errs, newValidationError("tenant", "Required", errors.New(`ent: clearing a required unique edge "Group.tenant"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (gu *GroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -446,11 +448,13 @@ func (guo *GroupUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (guo *GroupUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := guo.mutation.TenantID(); guo.mutation.TenantCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Group.tenant"`)
+		errs = append(errs, newValidationError("tenant", "Required", errors.New(`ent: clearing a required unique edge "Group.tenant"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (guo *GroupUpdateOne) sqlSave(ctx context.Context) (_node *Group, err error) {
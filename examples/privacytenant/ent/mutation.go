@@ -816,6 +816,8 @@ type UserMutation struct {
 	id            *int
 	name          *string
 	foods         *[]string
+	appendfoods   []string
+	removefoods   []string
 	clearedFields map[string]struct{}
 	tenant        *int
 	clearedtenant bool
@@ -964,6 +966,8 @@ func (m *UserMutation) ResetName() {
 // SetFoods sets the "foods" field.
 func (m *UserMutation) SetFoods(s []string) {
 	m.foods = &s
+	m.appendfoods = nil
+	m.removefoods = nil
 }
 
 // Foods returns the value of the "foods" field in the mutation.
@@ -992,9 +996,68 @@ func (m *UserMutation) OldFoods(ctx context.Context) (v []string, err error) {
 	return oldValue.Foods, nil
 }
 
+// AppendFoods adds v to the "foods" field.
+func (m *UserMutation) AppendFoods(v []string) {
+	if m.foods != nil {
+		*m.foods = append(append([]string(nil), *m.foods...), v...)
+		return
+	}
+	if m.FoodsCleared() {
+		delete(m.clearedFields, user.FieldFoods)
+		vs := append([]string(nil), v...)
+		m.foods = &vs
+		return
+	}
+	m.appendfoods = append(m.appendfoods, v...)
+}
+
+// AppendedFoods returns the list of values that were appended to the "foods" field in this mutation.
+func (m *UserMutation) AppendedFoods() ([]string, bool) {
+	if len(m.appendfoods) == 0 {
+		return nil, false
+	}
+	return m.appendfoods, true
+}
+
+// RemoveFoods removes all occurrences of v from the "foods" field.
+func (m *UserMutation) RemoveFoods(v []string) {
+	without := func(vs []string) []string {
+		r := make([]string, 0, len(vs))
+		for i := range vs {
+			keep := true
+			for j := 0; keep && j < len(v); j++ {
+				keep = vs[i] != v[j]
+			}
+			if keep {
+				r = append(r, vs[i])
+			}
+		}
+		return r
+	}
+	if m.foods != nil {
+		*m.foods = without(*m.foods)
+		return
+	}
+	if m.FoodsCleared() {
+		return
+	}
+	m.appendfoods = without(m.appendfoods)
+	m.removefoods = append(m.removefoods, v...)
+}
+
+// RemovedFoods returns the list of values that were removed from the "foods" field in this mutation.
+func (m *UserMutation) RemovedFoods() ([]string, bool) {
+	if len(m.removefoods) == 0 {
+		return nil, false
+	}
+	return m.removefoods, true
+}
+
 // ClearFoods clears the value of the "foods" field.
 func (m *UserMutation) ClearFoods() {
 	m.foods = nil
+	m.appendfoods = nil
+	m.removefoods = nil
 	m.clearedFields[user.FieldFoods] = struct{}{}
 }
 
@@ -1007,6 +1070,8 @@ func (m *UserMutation) FoodsCleared() bool {
 // ResetFoods resets all changes to the "foods" field.
 func (m *UserMutation) ResetFoods() {
 	m.foods = nil
+	m.appendfoods = nil
+	m.removefoods = nil
 	delete(m.clearedFields, user.FieldFoods)
 }
 
@@ -1121,12 +1186,16 @@ func (m *UserMutation) Type() string {
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
+//
+// Fields that values were only appended to or removed from are also returned,
+// but their values are available only using the Appended<F> and Removed<F>
+// methods, and not using the Field method.
 func (m *UserMutation) Fields() []string {
 	fields := make([]string, 0, 2)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
-	if m.foods != nil {
+	if m.foods != nil || len(m.appendfoods) > 0 || len(m.removefoods) > 0 {
 		fields = append(fields, user.FieldFoods)
 	}
 	return fields
@@ -12,10 +12,12 @@ import (
 	"fmt"
 	"math"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/examples/privacytenant/ent/predicate"
 	"entgo.io/ent/examples/privacytenant/ent/tenant"
+	"entgo.io/ent/privacy"
 	"entgo.io/ent/schema/field"
 )
 
@@ -183,6 +185,18 @@ func (tq *TenantQuery) AllX(ctx context.Context) []*Tenant {
 
 // IDs executes the query and returns a list of Tenant IDs.
 func (tq *TenantQuery) IDs(ctx context.Context) ([]int, error) {
+	// Load rules are evaluated on the loaded entities.
+	if privacy.HasLoadRules(tenant.Policy) {
+		nodes, err := tq.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]int, len(nodes))
+		for i := range nodes {
+			ids[i] = nodes[i].ID
+		}
+		return ids, nil
+	}
 	var ids []int
 	if err := tq.Select(tenant.FieldID).Scan(ctx, &ids); err != nil {
 		return nil, err
@@ -218,6 +232,13 @@ func (tq *TenantQuery) CountX(ctx context.Context) int {
 
 // Exist returns true if the query has elements in the graph.
 func (tq *TenantQuery) Exist(ctx context.Context) (bool, error) {
+	if privacy.HasLoadRules(tenant.Policy) {
+		ids, err := tq.IDs(ctx)
+		if err != nil {
+			return false, err
+		}
+		return len(ids) > 0, nil
+	}
 	if err := tq.prepareQuery(ctx); err != nil {
 		return false, err
 	}
@@ -341,9 +362,36 @@ func (tq *TenantQuery) sqlAll(ctx context.Context) ([]*Tenant, error) {
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	nodes, err := tq.evalLoad(ctx, nodes)
+	if err != nil || len(nodes) == 0 {
+		return nodes, err
+	}
 	return nodes, nil
 }
 
+// evalLoad evaluates the load policy of the Tenant type on
+// the loaded nodes, and returns the nodes that are visible to the viewer.
+func (tq *TenantQuery) evalLoad(ctx context.Context, nodes []*Tenant) ([]*Tenant, error) {
+	values := make([]ent.Value, len(nodes))
+	for i := range nodes {
+		values[i] = nodes[i]
+	}
+	visible, err := privacy.EvalLoad(ctx, tenant.Policy, values)
+	if err != nil {
+		return nil, err
+	}
+	if visible == nil {
+		return nodes, nil
+	}
+	filtered := nodes[:0]
+	for i := range nodes {
+		if visible[i] {
+			filtered = append(filtered, nodes[i])
+		}
+	}
+	return filtered, nil
+}
+
 func (tq *TenantQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
 	_spec.Node.Columns = tq.fields
@@ -720,6 +768,15 @@ func (ts *TenantSelect) Scan(ctx context.Context, v interface{}) error {
 	if err := ts.prepareQuery(ctx); err != nil {
 		return err
 	}
+	// Load rules are evaluated on the loaded entities. Hence, the
+	// selection is limited to the IDs of the visible entities.
+	if privacy.HasLoadRules(tenant.Policy) {
+		ids, err := ts.TenantQuery.Clone().IDs(ctx)
+		if err != nil {
+			return err
+		}
+		ts.Where(tenant.IDIn(ids...))
+	}
 	ts.sql = ts.TenantQuery.sqlQuery(ctx)
 	return ts.sqlScan(ctx, v)
 }
@@ -103,13 +103,15 @@ func (tu *TenantUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tu *TenantUpdate) check() error {
+	var errs ValidationErrors
 	if v, ok := tu.mutation.Name(); ok {
 		if err := tenant.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tu *TenantUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -235,13 +237,15 @@ func (tuo *TenantUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (tuo *TenantUpdateOne) check() error {
+	var errs ValidationErrors
 	if v, ok := tuo.mutation.Name(); ok {
 		if err := tenant.NameValidator(v); err != nil {
-			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)}
+			errs = append(errs, validatorError("name", fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)))
 		}
 	}
-	return nil
+	return errs.err()
 }
 
 func (tuo *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err error) {
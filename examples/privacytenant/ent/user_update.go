@@ -13,6 +13,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/examples/privacytenant/ent/group"
 	"entgo.io/ent/examples/privacytenant/ent/predicate"
 	"entgo.io/ent/examples/privacytenant/ent/tenant"
@@ -53,6 +54,18 @@ func (uu *UserUpdate) SetFoods(s []string) *UserUpdate {
 	return uu
 }
 
+// AppendFoods appends s to the "foods" field.
+func (uu *UserUpdate) AppendFoods(s []string) *UserUpdate {
+	uu.mutation.AppendFoods(s)
+	return uu
+}
+
+// RemoveFoods removes all occurrences of s from the "foods" field.
+func (uu *UserUpdate) RemoveFoods(s []string) *UserUpdate {
+	uu.mutation.RemoveFoods(s)
+	return uu
+}
+
 // ClearFoods clears the value of the "foods" field.
 func (uu *UserUpdate) ClearFoods() *UserUpdate {
 	uu.mutation.ClearFoods()
@@ -178,11 +191,13 @@ func (uu *UserUpdate) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uu *UserUpdate) check() error {
+	var errs ValidationErrors
 	if _, ok := uu.mutation.TenantID(); uu.mutation.TenantCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "User.tenant"`)
+		errs = append(errs, newValidationError("tenant", "Required", errors.New(`ent: clearing a required unique edge "User.tenant"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
@@ -217,6 +232,19 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: user.FieldFoods,
 		})
 	}
+	if value, ok := uu.mutation.RemovedFoods(); ok {
+		if _, ok := uu.mutation.AppendedFoods(); ok {
+			return 0, &ValidationError{Name: "foods", err: errors.New(`ent: cannot append to and remove from "User.foods" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldFoods, value)
+		})
+	}
+	if value, ok := uu.mutation.AppendedFoods(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldFoods, value)
+		})
+	}
 	if uu.mutation.FoodsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -351,6 +379,18 @@ func (uuo *UserUpdateOne) SetFoods(s []string) *UserUpdateOne {
 	return uuo
 }
 
+// AppendFoods appends s to the "foods" field.
+func (uuo *UserUpdateOne) AppendFoods(s []string) *UserUpdateOne {
+	uuo.mutation.AppendFoods(s)
+	return uuo
+}
+
+// RemoveFoods removes all occurrences of s from the "foods" field.
+func (uuo *UserUpdateOne) RemoveFoods(s []string) *UserUpdateOne {
+	uuo.mutation.RemoveFoods(s)
+	return uuo
+}
+
 // ClearFoods clears the value of the "foods" field.
 func (uuo *UserUpdateOne) ClearFoods() *UserUpdateOne {
 	uuo.mutation.ClearFoods()
@@ -483,11 +523,13 @@ func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
 }
 
 // check runs all checks and user-defined validators on the builder.
+// All failures are collected and returned as ValidationErrors.
 func (uuo *UserUpdateOne) check() error {
+	var errs ValidationErrors
 	if _, ok := uuo.mutation.TenantID(); uuo.mutation.TenantCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "User.tenant"`)
+		errs = append(errs, newValidationError("tenant", "Required", errors.New(`ent: clearing a required unique edge "User.tenant"`)))
 	}
-	return nil
+	return errs.err()
 }
 
 func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
@@ -539,6 +581,19 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 			Column: user.FieldFoods,
 		})
 	}
+	if value, ok := uuo.mutation.RemovedFoods(); ok {
+		if _, ok := uuo.mutation.AppendedFoods(); ok {
+			return nil, &ValidationError{Name: "foods", err: errors.New(`ent: cannot append to and remove from "User.foods" in the same mutation`)}
+		}
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Remove(u, user.FieldFoods, value)
+		})
+	}
+	if value, ok := uuo.mutation.AppendedFoods(); ok {
+		_spec.Modifiers = append(_spec.Modifiers, func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldFoods, value)
+		})
+	}
 	if uuo.mutation.FoodsCleared() {
 		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
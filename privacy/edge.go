@@ -0,0 +1,120 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package privacy
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent"
+)
+
+// EdgeChange describes the changes of a mutation to an edge.
+type EdgeChange struct {
+	// Added and Removed hold the IDs that were added
+	// to, or removed from the edge by the mutation.
+	Added, Removed []ent.Value
+	// Cleared reports if the edge was cleared by the mutation.
+	Cleared bool
+}
+
+// EdgeChangeOf returns the changes of the mutation to the given edge. The returned
+// boolean is false, if the mutation does not change the edge.
+func EdgeChangeOf(m ent.Mutation, edge string) (EdgeChange, bool) {
+	c := EdgeChange{
+		Added:   m.AddedIDs(edge),
+		Removed: m.RemovedIDs(edge),
+		Cleared: m.EdgeCleared(edge),
+	}
+	return c, len(c.Added) > 0 || len(c.Removed) > 0 || c.Cleared
+}
+
+// EdgeRule returns a mutation rule that calls the given function only if the
+// mutation changes the given edge. Other mutations are skipped. For example:
+//
+//	privacy.EdgeRule(group.EdgeUsers, func(ctx context.Context, m ent.Mutation, c privacy.EdgeChange) error {
+//		if c.Cleared {
+//			return privacy.Denyf("group users cannot be cleared")
+//		}
+//		return privacy.Skip
+//	})
+//
+func EdgeRule(edge string, fn func(context.Context, ent.Mutation, EdgeChange) error) MutationRule {
+	return edgeRule(func(ctx context.Context, m ent.Mutation) error {
+		c, ok := EdgeChangeOf(m, edge)
+		if !ok {
+			return Skip
+		}
+		return fn(ctx, m, c)
+	})
+}
+
+// DenyEdgeAdd returns a mutation rule that denies adding entities to the given edge, unless their
+// IDs are returned by the allowed function. For example, allowing users to join groups by themselves:
+//
+//	privacy.DenyEdgeAdd(group.EdgeUsers, func(ctx context.Context) ([]ent.Value, error) {
+//		return []ent.Value{viewer.FromContext(ctx).ID()}, nil
+//	})
+//
+// Note that the returned IDs must have the same type as the IDs of the edge, and decisions
+// that are returned by the allowed function (e.g. Skip for admins) are returned as is.
+func DenyEdgeAdd(edge string, allowed func(context.Context) ([]ent.Value, error)) MutationRule {
+	return EdgeRule(edge, func(ctx context.Context, _ ent.Mutation, c EdgeChange) error {
+		if len(c.Added) == 0 {
+			return Skip
+		}
+		ids, err := allowed(ctx)
+		if err != nil {
+			return err
+		}
+		if id, ok := notIn(c.Added, ids); ok {
+			return fmt.Errorf("ent/privacy: adding %v to edge %q is not allowed: %w", id, edge, Deny)
+		}
+		return Skip
+	})
+}
+
+// DenyEdgeRemove returns a mutation rule that denies removing entities from the given edge, unless
+// their IDs are returned by the allowed function. Clearing the edge is always denied by the rule.
+func DenyEdgeRemove(edge string, allowed func(context.Context) ([]ent.Value, error)) MutationRule {
+	return EdgeRule(edge, func(ctx context.Context, _ ent.Mutation, c EdgeChange) error {
+		switch {
+		case c.Cleared:
+			return fmt.Errorf("ent/privacy: clearing edge %q is not allowed: %w", edge, Deny)
+		case len(c.Removed) == 0:
+			return Skip
+		}
+		ids, err := allowed(ctx)
+		if err != nil {
+			return err
+		}
+		if id, ok := notIn(c.Removed, ids); ok {
+			return fmt.Errorf("ent/privacy: removing %v from edge %q is not allowed: %w", id, edge, Deny)
+		}
+		return Skip
+	})
+}
+
+// edgeRule is an adapter to allow the use of ordinary functions as mutation rules.
+type edgeRule func(context.Context, ent.Mutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f edgeRule) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	return f(ctx, m)
+}
+
+// notIn returns the first value in vs that does not exist in allowed.
+func notIn(vs, allowed []ent.Value) (ent.Value, bool) {
+	set := make(map[ent.Value]struct{}, len(allowed))
+	for _, v := range allowed {
+		set[v] = struct{}{}
+	}
+	for _, v := range vs {
+		if _, ok := set[v]; !ok {
+			return v, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,243 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package privacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+)
+
+// List of field read decisions.
+var (
+	// Omit may be returned by read rules to indicate that the field
+	// should be omitted from the query. Deny decisions behave the same.
+	Omit = errors.New("ent/privacy: omit field")
+
+	// Null may be returned by read rules to indicate that the field
+	// should be returned as null (or its zero value, if it is not nillable).
+	Null = errors.New("ent/privacy: null field")
+)
+
+// Mask returns a read decision for masking the value of the field using the given
+// function, after it was read from the database. For nillable fields, the function
+// is called only for non-null values. For example:
+//
+//	privacy.ReadRuleFunc(func(ctx context.Context) error {
+//		return privacy.Mask(func(v ent.Value) ent.Value {
+//			s := v.(string)
+//			return strings.Repeat("*", len(s)-4) + s[len(s)-4:]
+//		})
+//	})
+//
+func Mask(fn func(ent.Value) ent.Value) error {
+	return &maskDecision{fn: fn}
+}
+
+// maskDecision is the decision returned by the Mask function.
+type maskDecision struct {
+	fn func(ent.Value) ent.Value
+}
+
+// Error implements the error interface.
+func (*maskDecision) Error() string {
+	return "ent/privacy: mask field"
+}
+
+type (
+	// ReadRule defines the interface deciding how a field is read in the given context.
+	ReadRule interface {
+		EvalRead(context.Context) error
+	}
+
+	// ReadPolicy combines multiple read rules into a single policy.
+	ReadPolicy []ReadRule
+
+	// ReadRuleFunc type is an adapter to allow the use of
+	// ordinary functions as read rules.
+	ReadRuleFunc func(context.Context) error
+)
+
+// EvalRead returns f(ctx).
+func (f ReadRuleFunc) EvalRead(ctx context.Context) error {
+	return f(ctx)
+}
+
+// EvalRead evaluates the read rules until one of them returns a decision that is not Skip.
+func (policies ReadPolicy) EvalRead(ctx context.Context) error {
+	for _, policy := range policies {
+		switch decision := policy.EvalRead(ctx); {
+		case decision == nil || errors.Is(decision, Skip):
+		default:
+			return decision
+		}
+	}
+	return nil
+}
+
+// FieldPolicy is a field annotation for defining field-level privacy policies. The read
+// policy decides how the field is read (allowed, omitted, nulled-out or masked), and the
+// mutation policy decides whether a mutation is allowed to change the field. For example:
+//
+//	field.String("ssn").
+//		Annotations(privacy.FieldPolicy{
+//			Read: privacy.ReadPolicy{
+//				rule.AllowAdminRead(),
+//				privacy.ReadRuleFunc(func(context.Context) error {
+//					return privacy.Mask(lastFour)
+//				}),
+//			},
+//			Mutation: privacy.MutationPolicy{
+//				rule.DenyIfNotAdmin(),
+//			},
+//		})
+//
+// Note that field policies are stitched to the generated code by the runtime package,
+// and therefore, it should be imported in the main package.
+type FieldPolicy struct {
+	// Read decides how the field is read. A nil policy, or a policy without a
+	// decision means that the field is read as is.
+	Read ReadRule `json:"-"`
+	// Mutation decides whether mutations are allowed to set, add to, or clear
+	// the field. A nil policy, or a policy without a decision means that they
+	// are allowed.
+	Mutation MutationRule `json:"-"`
+}
+
+// Name describes the annotation name.
+func (FieldPolicy) Name() string {
+	return "FieldPolicy"
+}
+
+// FieldPolicyOf returns the FieldPolicy from the given field annotations.
+func FieldPolicyOf(annotations []schema.Annotation) FieldPolicy {
+	var policy FieldPolicy
+	for _, ant := range annotations {
+		switch ant := ant.(type) {
+		case FieldPolicy:
+			policy = ant
+		case *FieldPolicy:
+			policy = *ant
+		}
+	}
+	return policy
+}
+
+// FieldReads holds the read decisions of the field policies for a query.
+// Fields that are read as is are not stored in the map.
+type FieldReads map[string]error
+
+// EvalReads evaluates the read policies of the given fields. If an Allow decision was attached
+// to the context using DecisionContext, all fields are read as is. An error is returned in case
+// one of the policies returned an error that is not a read decision.
+func EvalReads(ctx context.Context, policies map[string]FieldPolicy) (FieldReads, error) {
+	if decision, ok := DecisionFromContext(ctx); ok && decision == nil {
+		return nil, nil
+	}
+	var reads FieldReads
+	for name, policy := range policies {
+		if policy.Read == nil {
+			continue
+		}
+		var mask *maskDecision
+		switch decision := policy.Read.EvalRead(ctx); {
+		case decision == nil || errors.Is(decision, Allow) || errors.Is(decision, Skip):
+			continue
+		case errors.Is(decision, Deny):
+			decision = Omit
+			fallthrough
+		case errors.Is(decision, Omit), errors.Is(decision, Null), errors.As(decision, &mask):
+			if reads == nil {
+				reads = make(FieldReads)
+			}
+			reads[name] = decision
+		default:
+			return nil, fmt.Errorf("ent/privacy: evaluating read policy of field %q: %w", name, decision)
+		}
+	}
+	return reads, nil
+}
+
+// Columns returns the given columns without the omitted fields.
+func (r FieldReads) Columns(columns []string) []string {
+	if len(r) == 0 {
+		return columns
+	}
+	filtered := make([]string, 0, len(columns))
+	for _, c := range columns {
+		if decision, ok := r[c]; !ok || !errors.Is(decision, Omit) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// Check returns an error if one of the given fields cannot be read as is.
+// It is used by queries that scan fields into custom values (e.g. Select).
+func (r FieldReads) Check(fields ...string) error {
+	for _, f := range fields {
+		if _, ok := r[f]; ok {
+			return fmt.Errorf("ent/privacy: field %q is not readable: %w", f, Deny)
+		}
+	}
+	return nil
+}
+
+// Value returns the value that should be assigned to the field after it was read
+// from the database. A nil value means the zero value (or null) of the field. The
+// returned boolean is false, if the value should not be changed.
+func (r FieldReads) Value(field string, v ent.Value) (ent.Value, bool) {
+	decision, ok := r[field]
+	if !ok {
+		return nil, false
+	}
+	var mask *maskDecision
+	if errors.As(decision, &mask) {
+		return mask.fn(v), true
+	}
+	return nil, true
+}
+
+// EvalMutationFields evaluates the mutation policies of the fields that are set, added
+// to, or cleared by the given mutation. If an Allow decision was attached to the context
+// using DecisionContext, the evaluation is skipped.
+func EvalMutationFields(ctx context.Context, policies map[string]FieldPolicy, m ent.Mutation) error {
+	if decision, ok := DecisionFromContext(ctx); ok {
+		return decision
+	}
+	return evalMutationFields(ctx, policies, m, append(append(m.Fields(), m.AddedFields()...), m.ClearedFields()...))
+}
+
+// EvalUpsertFields evaluates the mutation policies of the given fields, that are changed
+// by the `ON CONFLICT` clause of an upsert statement in case of a conflict. The rules are
+// called with the create mutation of the statement. If an Allow decision was attached to
+// the context using DecisionContext, the evaluation is skipped.
+func EvalUpsertFields(ctx context.Context, policies map[string]FieldPolicy, m ent.Mutation, fields []string) error {
+	if decision, ok := DecisionFromContext(ctx); ok {
+		return decision
+	}
+	return evalMutationFields(ctx, policies, m, fields)
+}
+
+func evalMutationFields(ctx context.Context, policies map[string]FieldPolicy, m ent.Mutation, fields []string) error {
+	for _, name := range fields {
+		policy, ok := policies[name]
+		if !ok || policy.Mutation == nil {
+			continue
+		}
+		decision := traceEval(ctx, TraceFromContext(ctx), "mutation", fmt.Sprintf("field %q", name), func(ctx context.Context) error {
+			return policy.Mutation.EvalMutation(ctx, m)
+		})
+		switch {
+		case decision == nil || errors.Is(decision, Allow) || errors.Is(decision, Skip):
+		default:
+			return fmt.Errorf("ent/privacy: changing field %q: %w", name, decision)
+		}
+	}
+	return nil
+}
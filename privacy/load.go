@@ -0,0 +1,171 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package privacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent"
+)
+
+type (
+	// LoadRule defines the interface deciding whether the entities that were loaded
+	// by a query are visible to the viewer. Rules are called with a batch of entities,
+	// and return a decision for each one of them (Allow, Deny or Skip). A nil decision
+	// behaves like Skip, and a nil slice means that all entities were skipped. An error
+	// that is not a decision fails the query.
+	LoadRule interface {
+		EvalLoad(context.Context, []ent.Value) ([]error, error)
+	}
+
+	// LoadPolicy combines multiple load rules into a single policy.
+	LoadPolicy []LoadRule
+
+	// LoadRuleFunc type is an adapter to allow the use of
+	// ordinary functions as load rules.
+	LoadRuleFunc func(context.Context, []ent.Value) ([]error, error)
+)
+
+// EvalLoad returns f(ctx, nodes).
+func (f LoadRuleFunc) EvalLoad(ctx context.Context, nodes []ent.Value) ([]error, error) {
+	return f(ctx, nodes)
+}
+
+// HasLoadRules reports if the load policy has any rules.
+func (policies LoadPolicy) HasLoadRules() bool {
+	return len(policies) > 0
+}
+
+// EvalLoad evaluates the loaded entities against a load policy. Each rule is called only
+// with the entities that were not decided by the previous rules, and entities that were
+// not decided by any of the rules get a nil decision.
+func (policies LoadPolicy) EvalLoad(ctx context.Context, nodes []ent.Value) ([]error, error) {
+	return evalLoad(nodes, len(policies), func(i int, nodes []ent.Value) ([]error, error) {
+		return policies[i].EvalLoad(ctx, nodes)
+	})
+}
+
+// EvalLoad evaluates the load policies of the policies that implement the LoadRule interface.
+// If the Allow decision is returned for an entity by one of the policies, the evaluation of the
+// entity stops with a nil decision.
+func (policies Policies) EvalLoad(ctx context.Context, nodes []ent.Value) ([]error, error) {
+	if decision, ok := DecisionFromContext(ctx); ok {
+		return nil, decision
+	}
+	decisions, err := evalLoad(nodes, len(policies), func(i int, nodes []ent.Value) ([]error, error) {
+		if rule, ok := policies[i].(LoadRule); ok {
+			return rule.EvalLoad(ctx, nodes)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, decision := range decisions {
+		if errors.Is(decision, Allow) {
+			decisions[i] = nil
+		}
+	}
+	return decisions, nil
+}
+
+// evalLoad runs n evaluations on the pending (not-decided) nodes.
+func evalLoad(nodes []ent.Value, n int, eval func(int, []ent.Value) ([]error, error)) ([]error, error) {
+	var (
+		decisions = make([]error, len(nodes))
+		pending   = make([]int, len(nodes))
+	)
+	for i := range pending {
+		pending[i] = i
+	}
+	for i := 0; i < n && len(pending) > 0; i++ {
+		batch := make([]ent.Value, len(pending))
+		for j, idx := range pending {
+			batch[j] = nodes[idx]
+		}
+		results, err := eval(i, batch)
+		switch {
+		case err != nil:
+			return nil, err
+		case results == nil:
+			continue
+		case len(results) != len(batch):
+			return nil, fmt.Errorf("ent/privacy: load rule returned %d decisions for %d entities", len(results), len(batch))
+		}
+		next := pending[:0]
+		for j, decision := range results {
+			switch {
+			case decision == nil || errors.Is(decision, Skip):
+				next = append(next, pending[j])
+			case errors.Is(decision, Allow) || errors.Is(decision, Deny):
+				decisions[pending[j]] = decision
+			default:
+				return nil, decision
+			}
+		}
+		pending = next
+	}
+	return decisions, nil
+}
+
+// EvalLoad evaluates the loaded entities against the given policy, if it implements the LoadRule
+// interface, and reports which of them are visible to the viewer. Entities are hidden only if they
+// were denied by the policy. A nil slice is returned if all entities are visible.
+func EvalLoad(ctx context.Context, policy ent.Policy, nodes []ent.Value) ([]bool, error) {
+	rule, ok := policy.(LoadRule)
+	if !ok || len(nodes) == 0 {
+		return nil, nil
+	}
+	if decision, ok := DecisionFromContext(ctx); ok && decision == nil {
+		return nil, nil
+	}
+	decisions, err := rule.EvalLoad(ctx, nodes)
+	switch {
+	case err != nil || decisions == nil:
+		return nil, err
+	case len(decisions) != len(nodes):
+		return nil, fmt.Errorf("ent/privacy: load policy returned %d decisions for %d entities", len(decisions), len(nodes))
+	}
+	var visible []bool
+	for i, decision := range decisions {
+		if decision == nil || !errors.Is(decision, Deny) {
+			continue
+		}
+		if visible == nil {
+			visible = make([]bool, len(nodes))
+			for j := range visible {
+				visible[j] = true
+			}
+		}
+		visible[i] = false
+	}
+	return visible, nil
+}
+
+// HasLoadRules reports if the given policy may hide entities after they were loaded. i.e. if it
+// implements the LoadRule interface, and it does not report (using a HasLoadRules method) that
+// it has no load rules. Queries that do not load entities (e.g. IDs or Exist) use it for loading
+// the entities only if it is required for evaluating the policy.
+func HasLoadRules(policy ent.Policy) bool {
+	switch p := policy.(type) {
+	case nil:
+		return false
+	case Policies:
+		for i := range p {
+			if HasLoadRules(p[i]) {
+				return true
+			}
+		}
+		return false
+	case namedPolicies:
+		return HasLoadRules(p.Policies)
+	case interface{ HasLoadRules() bool }:
+		return p.HasLoadRules()
+	}
+	_, ok := policy.(LoadRule)
+	return ok
+}
@@ -9,6 +9,7 @@ package privacy
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"entgo.io/ent"
 )
@@ -54,19 +55,41 @@ type (
 // NewPolicies creates an ent.Policy from list of mixin.Schema
 // and ent.Schema that implement the ent.Policy interface.
 func NewPolicies(schemas ...interface{ Policy() ent.Policy }) ent.Policy {
-	policies := make(Policies, 0, len(schemas))
+	policies := namedPolicies{Policies: make(Policies, 0, len(schemas))}
 	for i := range schemas {
 		if policy := schemas[i].Policy(); policy != nil {
-			policies = append(policies, policy)
+			policies.Policies = append(policies.Policies, policy)
+			policies.names = append(policies.names, fmt.Sprintf("%T", schemas[i]))
 		}
 	}
 	return policies
 }
 
+// namedPolicies holds the policies that were created by NewPolicies, with
+// the names of the schemas and mixins that defined them, for tracing.
+type namedPolicies struct {
+	Policies
+	names []string
+}
+
+// EvalQuery implements the ent.Policy interface.
+func (p namedPolicies) EvalQuery(ctx context.Context, q ent.Query) error {
+	return p.eval(ctx, "query", p.names, func(ctx context.Context, policy ent.Policy) error {
+		return policy.EvalQuery(ctx, q)
+	})
+}
+
+// EvalMutation implements the ent.Policy interface.
+func (p namedPolicies) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	return p.eval(ctx, "mutation", p.names, func(ctx context.Context, policy ent.Policy) error {
+		return policy.EvalMutation(ctx, m)
+	})
+}
+
 // EvalQuery evaluates the query policies. If the Allow error is returned
 // from one of the policies, it stops the evaluation with a nil error.
 func (policies Policies) EvalQuery(ctx context.Context, q ent.Query) error {
-	return policies.eval(ctx, func(policy ent.Policy) error {
+	return policies.eval(ctx, "query", nil, func(ctx context.Context, policy ent.Policy) error {
 		return policy.EvalQuery(ctx, q)
 	})
 }
@@ -74,17 +97,34 @@ func (policies Policies) EvalQuery(ctx context.Context, q ent.Query) error {
 // EvalMutation evaluates the mutation policies. If the Allow error is returned
 // from one of the policies, it stops the evaluation with a nil error.
 func (policies Policies) EvalMutation(ctx context.Context, m ent.Mutation) error {
-	return policies.eval(ctx, func(policy ent.Policy) error {
+	return policies.eval(ctx, "mutation", nil, func(ctx context.Context, policy ent.Policy) error {
 		return policy.EvalMutation(ctx, m)
 	})
 }
 
-func (policies Policies) eval(ctx context.Context, eval func(ent.Policy) error) error {
+// eval evaluates the policies. The names of the policies are used for tracing,
+// and if they were not provided, the policies are named by their types.
+func (policies Policies) eval(ctx context.Context, op string, names []string, eval func(context.Context, ent.Policy) error) error {
+	trace := TraceFromContext(ctx)
 	if decision, ok := DecisionFromContext(ctx); ok {
+		if trace != nil {
+			e := Evaluation{Op: op, Rule: "DecisionContext", Decision: decision}
+			if decision == nil {
+				e.Decision = Allow
+			}
+			trace.record(e)
+		}
 		return decision
 	}
-	for _, policy := range policies {
-		switch decision := eval(policy); {
+	for i, policy := range policies {
+		name := fmt.Sprintf("%T", policy)
+		if i < len(names) {
+			name = names[i]
+		}
+		decision := traceEval(ctx, trace, op, name, func(ctx context.Context) error {
+			return eval(ctx, policy)
+		})
+		switch {
 		case decision == nil || errors.Is(decision, Skip):
 		case errors.Is(decision, Allow):
 			return nil
@@ -97,8 +137,12 @@ func (policies Policies) eval(ctx context.Context, eval func(ent.Policy) error)
 
 // EvalQuery evaluates a query against a query policy.
 func (policies QueryPolicy) EvalQuery(ctx context.Context, q ent.Query) error {
+	trace := TraceFromContext(ctx)
 	for _, policy := range policies {
-		switch decision := policy.EvalQuery(ctx, q); {
+		decision := traceRule(ctx, trace, "query", policy, func(ctx context.Context) error {
+			return policy.EvalQuery(ctx, q)
+		})
+		switch {
 		case decision == nil || errors.Is(decision, Skip):
 		default:
 			return decision
@@ -109,8 +153,12 @@ func (policies QueryPolicy) EvalQuery(ctx context.Context, q ent.Query) error {
 
 // EvalMutation evaluates a mutation against a mutation policy.
 func (policies MutationPolicy) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	trace := TraceFromContext(ctx)
 	for _, policy := range policies {
-		switch decision := policy.EvalMutation(ctx, m); {
+		decision := traceRule(ctx, trace, "mutation", policy, func(ctx context.Context) error {
+			return policy.EvalMutation(ctx, m)
+		})
+		switch {
 		case decision == nil || errors.Is(decision, Skip):
 		default:
 			return decision
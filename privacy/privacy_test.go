@@ -7,6 +7,7 @@ package privacy_test
 import (
 	"context"
 	"errors"
+	"sync"
 	"testing"
 
 	"entgo.io/ent"
@@ -68,3 +69,248 @@ func (f policyFunc) EvalQuery(ctx context.Context, _ ent.Query) error {
 func (f policyFunc) EvalMutation(ctx context.Context, _ ent.Mutation) error {
 	return f(ctx)
 }
+
+func TestEvalReads(t *testing.T) {
+	decision := func(err error) privacy.ReadRule {
+		return privacy.ReadRuleFunc(func(context.Context) error { return err })
+	}
+	policies := map[string]privacy.FieldPolicy{
+		"name":  {Read: decision(privacy.Allow)},
+		"age":   {Read: privacy.ReadPolicy{decision(privacy.Skip), decision(privacy.Null)}},
+		"phone": {Read: decision(privacy.Mask(func(v ent.Value) ent.Value { return "***" + v.(string)[3:] }))},
+		"ssn":   {Read: decision(privacy.Deny)},
+		"email": {},
+	}
+	reads, err := privacy.EvalReads(context.Background(), policies)
+	assert.NoError(t, err)
+	assert.Len(t, reads, 3)
+	assert.Equal(t, []string{"id", "name", "age", "phone"}, reads.Columns([]string{"id", "name", "age", "phone", "ssn"}))
+	assert.NoError(t, reads.Check("id", "name", "email"))
+	assert.True(t, errors.Is(reads.Check("name", "phone"), privacy.Deny))
+
+	_, ok := reads.Value("name", "a8m")
+	assert.False(t, ok)
+	v, ok := reads.Value("age", 30)
+	assert.True(t, ok)
+	assert.Nil(t, v)
+	v, ok = reads.Value("phone", "0501234")
+	assert.True(t, ok)
+	assert.Equal(t, "***1234", v)
+
+	reads, err = privacy.EvalReads(privacy.DecisionContext(context.Background(), privacy.Allow), policies)
+	assert.NoError(t, err)
+	assert.Empty(t, reads)
+
+	policies["email"] = privacy.FieldPolicy{Read: decision(errors.New("unexpected"))}
+	_, err = privacy.EvalReads(context.Background(), policies)
+	assert.EqualError(t, err, `ent/privacy: evaluating read policy of field "email": unexpected`)
+}
+
+type loadPolicy struct {
+	policyFunc
+	privacy.LoadPolicy
+}
+
+func (p loadPolicy) Policy() ent.Policy {
+	return p
+}
+
+func TestEvalLoad(t *testing.T) {
+	// even returns a load rule that returns the given decision for even numbers.
+	even := func(decision error) privacy.LoadRule {
+		return privacy.LoadRuleFunc(func(_ context.Context, nodes []ent.Value) ([]error, error) {
+			decisions := make([]error, len(nodes))
+			for i, n := range nodes {
+				if n.(int)%2 == 0 {
+					decisions[i] = decision
+				}
+			}
+			return decisions, nil
+		})
+	}
+	var (
+		calls int
+		count = privacy.LoadRuleFunc(func(_ context.Context, nodes []ent.Value) ([]error, error) {
+			calls += len(nodes)
+			return nil, nil
+		})
+		nodes = []ent.Value{1, 2, 3, 4}
+		ctx   = context.Background()
+	)
+	policy := privacy.NewPolicies(
+		loadPolicy{LoadPolicy: privacy.LoadPolicy{count, even(privacy.Allow)}},
+		loadPolicy{LoadPolicy: privacy.LoadPolicy{count, privacy.LoadRuleFunc(func(context.Context, []ent.Value) ([]error, error) {
+			return []error{privacy.Deny, privacy.Skip}, nil
+		})}},
+	)
+	visible, err := privacy.EvalLoad(ctx, policy, nodes)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{false, true, true, true}, visible)
+	assert.Equal(t, 6, calls, "second policy is evaluated only on undecided nodes")
+
+	visible, err = privacy.EvalLoad(privacy.DecisionContext(ctx, privacy.Allow), policy, nodes)
+	assert.NoError(t, err)
+	assert.Nil(t, visible)
+	visible, err = privacy.EvalLoad(ctx, privacy.NewPolicies(loadPolicy{LoadPolicy: privacy.LoadPolicy{count}}), nodes)
+	assert.NoError(t, err)
+	assert.Nil(t, visible)
+
+	_, err = privacy.EvalLoad(ctx, privacy.NewPolicies(loadPolicy{LoadPolicy: privacy.LoadPolicy{even(errors.New("unexpected"))}}), nodes)
+	assert.EqualError(t, err, "unexpected")
+	_, err = privacy.EvalLoad(ctx, privacy.NewPolicies(loadPolicy{LoadPolicy: privacy.LoadPolicy{privacy.LoadRuleFunc(func(context.Context, []ent.Value) ([]error, error) {
+		return []error{privacy.Deny}, nil
+	})}}), nodes)
+	assert.EqualError(t, err, "ent/privacy: load rule returned 1 decisions for 4 entities")
+}
+
+func TestHasLoadRules(t *testing.T) {
+	assert.False(t, privacy.HasLoadRules(nil))
+	assert.False(t, privacy.HasLoadRules(privacy.NewPolicies(loadPolicy{})))
+	allow := policyFunc(func(context.Context) error { return privacy.Allow })
+	assert.False(t, privacy.HasLoadRules(privacy.NewPolicies(allow)))
+	count := privacy.LoadRuleFunc(func(context.Context, []ent.Value) ([]error, error) { return nil, nil })
+	assert.True(t, privacy.HasLoadRules(privacy.NewPolicies(allow, loadPolicy{LoadPolicy: privacy.LoadPolicy{count}})))
+}
+
+type mutationRuleFunc func(context.Context, ent.Mutation) error
+
+func (f mutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	return f(ctx, m)
+}
+
+type mutationPolicy struct {
+	policyFunc
+	privacy.MutationPolicy
+}
+
+func (p mutationPolicy) Policy() ent.Policy {
+	return p
+}
+
+func (p mutationPolicy) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	return p.MutationPolicy.EvalMutation(ctx, m)
+}
+
+func TestTrace(t *testing.T) {
+	skip := mutationRuleFunc(func(context.Context, ent.Mutation) error {
+		return privacy.Skip
+	})
+	deny := mutationRuleFunc(func(context.Context, ent.Mutation) error {
+		return privacy.Deny
+	})
+	policy := privacy.NewPolicies(
+		mutationPolicy{MutationPolicy: privacy.MutationPolicy{skip}},
+		mutationPolicy{MutationPolicy: privacy.MutationPolicy{skip, deny}},
+	)
+	trace := &privacy.Trace{}
+	ctx := privacy.TraceContext(context.Background(), trace)
+	assert.Equal(t, trace, privacy.TraceFromContext(ctx))
+	err := policy.EvalMutation(ctx, nil)
+	assert.True(t, errors.Is(err, privacy.Deny))
+	evals := trace.Evaluations()
+	assert.Len(t, evals, 5)
+	for _, e := range evals {
+		assert.Equal(t, "mutation", e.Op)
+		assert.Equal(t, "privacy_test.mutationPolicy", e.Policy)
+	}
+	assert.Equal(t, "privacy_test.TestTrace.func1", evals[0].Rule)
+	assert.Contains(t, evals[0].Source, "privacy_test.go:")
+	assert.Empty(t, evals[1].Rule)
+	assert.Equal(t, "privacy_test.TestTrace.func2", evals[3].Rule)
+	assert.True(t, errors.Is(evals[3].Decision, privacy.Deny))
+	assert.True(t, errors.Is(evals[4].Decision, privacy.Deny))
+
+	trace = &privacy.Trace{}
+	ctx = privacy.TraceContext(privacy.DecisionContext(context.Background(), privacy.Allow), trace)
+	assert.NoError(t, policy.EvalMutation(ctx, nil))
+	assert.Equal(t, "mutation DecisionContext: allow\n", trace.String())
+
+	// Evaluations that share a trace concurrently record their own policies.
+	var (
+		wg    sync.WaitGroup
+		other = privacy.NewPolicies(&mutationPolicy{MutationPolicy: privacy.MutationPolicy{skip}})
+	)
+	trace = &privacy.Trace{}
+	ctx = privacy.TraceContext(context.Background(), trace)
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_ = policy.EvalMutation(ctx, nil)
+		}()
+		go func() {
+			defer wg.Done()
+			_ = other.EvalMutation(ctx, nil)
+		}()
+	}
+	wg.Wait()
+	evals = trace.Evaluations()
+	assert.Len(t, evals, 10*(5+2))
+	var named int
+	for _, e := range evals {
+		if e.Policy == "*privacy_test.mutationPolicy" {
+			named++
+			assert.NotEqual(t, "privacy_test.TestTrace.func2", e.Rule)
+		}
+	}
+	assert.Equal(t, 10*2, named)
+}
+
+func TestTrace_FieldPolicies(t *testing.T) {
+	deny := mutationRuleFunc(func(context.Context, ent.Mutation) error {
+		return privacy.Deny
+	})
+	trace := &privacy.Trace{}
+	ctx := privacy.TraceContext(context.Background(), trace)
+	err := privacy.EvalUpsertFields(ctx, map[string]privacy.FieldPolicy{
+		"phone": {Mutation: privacy.MutationPolicy{deny}},
+	}, nil, []string{"name", "phone"})
+	assert.True(t, errors.Is(err, privacy.Deny))
+	evals := trace.Evaluations()
+	assert.Len(t, evals, 2)
+	assert.Equal(t, `field "phone"`, evals[0].Policy)
+	assert.Equal(t, "privacy_test.TestTrace_FieldPolicies.func1", evals[0].Rule)
+	assert.Equal(t, `field "phone"`, evals[1].Policy)
+	assert.Empty(t, evals[1].Rule)
+}
+
+type edgeMutation struct {
+	ent.Mutation
+	added, removed []ent.Value
+	cleared        bool
+}
+
+func (m edgeMutation) AddedIDs(string) []ent.Value   { return m.added }
+func (m edgeMutation) RemovedIDs(string) []ent.Value { return m.removed }
+func (m edgeMutation) EdgeCleared(string) bool       { return m.cleared }
+
+func TestEdgeRules(t *testing.T) {
+	ctx := context.Background()
+	self := func(context.Context) ([]ent.Value, error) { return []ent.Value{1}, nil }
+	add, remove := privacy.DenyEdgeAdd("users", self), privacy.DenyEdgeRemove("users", self)
+
+	m := edgeMutation{}
+	_, ok := privacy.EdgeChangeOf(m, "users")
+	assert.False(t, ok)
+	assert.True(t, errors.Is(add.EvalMutation(ctx, m), privacy.Skip))
+	assert.True(t, errors.Is(remove.EvalMutation(ctx, m), privacy.Skip))
+
+	m = edgeMutation{added: []ent.Value{1}, removed: []ent.Value{1}}
+	c, ok := privacy.EdgeChangeOf(m, "users")
+	assert.True(t, ok)
+	assert.Equal(t, privacy.EdgeChange{Added: []ent.Value{1}, Removed: []ent.Value{1}}, c)
+	assert.True(t, errors.Is(add.EvalMutation(ctx, m), privacy.Skip))
+	assert.True(t, errors.Is(remove.EvalMutation(ctx, m), privacy.Skip))
+
+	m = edgeMutation{added: []ent.Value{1, 2}, removed: []ent.Value{3}}
+	err := add.EvalMutation(ctx, m)
+	assert.True(t, errors.Is(err, privacy.Deny))
+	assert.EqualError(t, err, `ent/privacy: adding 2 to edge "users" is not allowed: ent/privacy: deny rule`)
+	err = remove.EvalMutation(ctx, m)
+	assert.EqualError(t, err, `ent/privacy: removing 3 from edge "users" is not allowed: ent/privacy: deny rule`)
+	err = remove.EvalMutation(ctx, edgeMutation{cleared: true})
+	assert.EqualError(t, err, `ent/privacy: clearing edge "users" is not allowed: ent/privacy: deny rule`)
+
+	skip := privacy.DenyEdgeAdd("users", func(context.Context) ([]ent.Value, error) { return nil, privacy.Skip })
+	assert.True(t, errors.Is(skip.EvalMutation(ctx, m), privacy.Skip))
+}
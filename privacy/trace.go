@@ -0,0 +1,206 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package privacy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// Trace records the evaluation of privacy policies and their rules. It is attached
+// to a context using TraceContext, and can be inspected after the operation ends.
+// Query and mutation policies are recorded, including the mutation policies of
+// fields, but load policies and field read policies are not. A Trace is safe for
+// concurrent use. For example:
+//
+//	trace := &privacy.Trace{}
+//	_, err := client.User.Query().All(privacy.TraceContext(ctx, trace))
+//	if errors.Is(err, privacy.Deny) {
+//		fmt.Println(trace)
+//	}
+//
+type Trace struct {
+	mu    sync.Mutex
+	evals []Evaluation
+}
+
+// Evaluation describes the evaluation of a privacy rule, or a policy.
+type Evaluation struct {
+	// Op is the evaluated operation. i.e. "query" or "mutation".
+	Op string
+	// Policy is the name of the schema or mixin that defined the policy (e.g. "schema.User"),
+	// or the name of the field for field policies (e.g. `field "phone"`).
+	Policy string
+	// Rule is the name of the evaluated rule (e.g. its function name). It
+	// is empty for evaluations that record the decision of the policy.
+	Rule string
+	// Source is the location of the rule definition in the source code.
+	Source string
+	// Decision is the decision returned by the rule or policy. A nil value means
+	// that the rule did not return a decision and the evaluation continued.
+	Decision error
+	// Filters holds the filters (entql predicates) that were applied by the rule.
+	Filters []string
+}
+
+// String implements the fmt.Stringer interface.
+func (e Evaluation) String() string {
+	var b strings.Builder
+	b.WriteString(e.Op)
+	if e.Policy != "" {
+		fmt.Fprintf(&b, " %s", e.Policy)
+	}
+	if e.Rule != "" {
+		fmt.Fprintf(&b, " %s", e.Rule)
+	}
+	if e.Source != "" {
+		fmt.Fprintf(&b, " (%s)", e.Source)
+	}
+	fmt.Fprintf(&b, ": %s", decisionName(e.Decision))
+	if len(e.Filters) > 0 {
+		fmt.Fprintf(&b, " [%s]", strings.Join(e.Filters, ", "))
+	}
+	return b.String()
+}
+
+// Evaluations returns the recorded evaluations in the order they occurred.
+func (t *Trace) Evaluations() []Evaluation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Evaluation(nil), t.evals...)
+}
+
+// String returns the recorded evaluations, one per line.
+func (t *Trace) String() string {
+	var b strings.Builder
+	for _, e := range t.Evaluations() {
+		b.WriteString(e.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// record records the given evaluation.
+func (t *Trace) record(e Evaluation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.evals = append(t.evals, e)
+}
+
+type (
+	traceCtxKey   struct{}
+	traceFrameKey struct{}
+	// traceFrame holds the state of a policy or a rule evaluation. It is
+	// stored in the context that is passed to the evaluated policy or rule,
+	// and therefore, concurrent evaluations do not share it.
+	traceFrame struct {
+		policy  string
+		mu      sync.Mutex
+		filters []string
+	}
+)
+
+// traceEval evaluates the policy with the given name, and records its decision in the trace.
+func traceEval(ctx context.Context, trace *Trace, op, name string, eval func(context.Context) error) error {
+	if trace == nil {
+		return eval(ctx)
+	}
+	decision := eval(context.WithValue(ctx, traceFrameKey{}, &traceFrame{policy: name}))
+	trace.record(Evaluation{Op: op, Policy: name, Decision: decision})
+	return decision
+}
+
+// traceRule evaluates the rule, and records its decision and the filters it applied in the trace.
+func traceRule(ctx context.Context, trace *Trace, op string, rule interface{}, eval func(context.Context) error) error {
+	if trace == nil {
+		return eval(ctx)
+	}
+	frame := &traceFrame{}
+	if f, ok := ctx.Value(traceFrameKey{}).(*traceFrame); ok {
+		frame.policy = f.policy
+	}
+	decision := eval(context.WithValue(ctx, traceFrameKey{}, frame))
+	name, source := ruleInfo(rule)
+	frame.mu.Lock()
+	filters := frame.filters
+	frame.mu.Unlock()
+	trace.record(Evaluation{
+		Op:       op,
+		Policy:   frame.policy,
+		Rule:     name,
+		Source:   source,
+		Decision: decision,
+		Filters:  filters,
+	})
+	return decision
+}
+
+// TraceContext returns a new context from the given parent context with the
+// trace attached to it. All privacy evaluations that use the returned context
+// (and its descendants) are recorded in the trace.
+func TraceContext(parent context.Context, t *Trace) context.Context {
+	return context.WithValue(parent, traceCtxKey{}, t)
+}
+
+// TraceFromContext returns the Trace attached to the context, or nil if there is none.
+func TraceFromContext(ctx context.Context) *Trace {
+	t, _ := ctx.Value(traceCtxKey{}).(*Trace)
+	return t
+}
+
+// TraceFilter records a filter that was applied by the currently evaluated rule in
+// the trace attached to the context, if any. It is called by the generated filters.
+func TraceFilter(ctx context.Context, filter fmt.Stringer) {
+	if f, ok := ctx.Value(traceFrameKey{}).(*traceFrame); ok && TraceFromContext(ctx) != nil {
+		f.mu.Lock()
+		f.filters = append(f.filters, filter.String())
+		f.mu.Unlock()
+	}
+}
+
+// ruleInfo returns the name and the source location of a rule. For rules that are functions,
+// or structs that wrap functions, the name is the function name. Otherwise, it is the type name.
+func ruleInfo(rule interface{}) (string, string) {
+	v := reflect.ValueOf(rule)
+	if v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.Kind() == reflect.Func && !f.IsNil() {
+				v = f
+				break
+			}
+		}
+	}
+	if v.Kind() == reflect.Func && !v.IsNil() {
+		if fn := runtime.FuncForPC(v.Pointer()); fn != nil {
+			name := fn.Name()
+			file, line := fn.FileLine(fn.Entry())
+			return name[strings.LastIndexByte(name, '/')+1:], fmt.Sprintf("%s:%d", filepath.Base(file), line)
+		}
+	}
+	return fmt.Sprintf("%T", rule), ""
+}
+
+// decisionName returns the name of the decision for the trace output.
+func decisionName(decision error) string {
+	switch {
+	case decision == nil || errors.Is(decision, Skip):
+		return "skip"
+	case errors.Is(decision, Allow):
+		return "allow"
+	case errors.Is(decision, Deny):
+		return fmt.Sprintf("deny (%v)", decision)
+	default:
+		return fmt.Sprintf("error (%v)", decision)
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"entgo.io/ent/schema"
+)
+
+// Decimal returns a new Field with type decimal. A decimal field is an exact numeric
+// field that is stored as the DECIMAL/NUMERIC type in SQL databases. By default, its
+// Go type is string, and it can be changed using the GoType option. For example:
+//
+//	field.Decimal("price").
+//		Precision(10).
+//		Scale(2)
+//
+//	field.Decimal("balance").
+//		GoType(field.Rat{})
+//
+func Decimal(name string) *decimalBuilder {
+	return &decimalBuilder{&Descriptor{
+		Name: name,
+		Info: &TypeInfo{Type: TypeDecimal},
+	}}
+}
+
+// decimalBuilder is the builder for decimal fields.
+type decimalBuilder struct {
+	desc *Descriptor
+}
+
+// Precision sets the precision of the field. i.e. the maximum number of digits.
+// If not set, the dialect default is used. That is "numeric" without precision
+// in PostgreSQL, and the maximum precision and scale ("decimal(65,30)") in MySQL.
+func (b *decimalBuilder) Precision(p int) *decimalBuilder {
+	b.desc.Precision = p
+	return b
+}
+
+// Scale sets the scale of the field. i.e. the number of digits after the decimal
+// point. Scale requires the Precision option to be set.
+func (b *decimalBuilder) Scale(s int) *decimalBuilder {
+	b.desc.Scale = s
+	return b
+}
+
+// Unique makes the field unique within all vertices of this type.
+func (b *decimalBuilder) Unique() *decimalBuilder {
+	b.desc.Unique = true
+	return b
+}
+
+// Sensitive fields not printable and not serializable.
+func (b *decimalBuilder) Sensitive() *decimalBuilder {
+	b.desc.Sensitive = true
+	return b
+}
+
+// Default sets the default value of the field. Defaults are supported only
+// for fields with a string Go type.
+//
+//	field.Decimal("price").
+//		Default("0.00")
+//
+func (b *decimalBuilder) Default(s string) *decimalBuilder {
+	b.desc.Default = s
+	return b
+}
+
+// Nillable indicates that this field is a nillable.
+// Unlike "Optional" only fields, "Nillable" fields are pointers in the generated struct.
+func (b *decimalBuilder) Nillable() *decimalBuilder {
+	b.desc.Nillable = true
+	return b
+}
+
+// Optional indicates that this field is optional on create.
+// Unlike edges, fields are required by default.
+func (b *decimalBuilder) Optional() *decimalBuilder {
+	b.desc.Optional = true
+	return b
+}
+
+// Immutable indicates that this field cannot be updated.
+func (b *decimalBuilder) Immutable() *decimalBuilder {
+	b.desc.Immutable = true
+	return b
+}
+
+// Comment sets the comment of the field.
+func (b *decimalBuilder) Comment(c string) *decimalBuilder {
+	b.desc.Comment = c
+	return b
+}
+
+// Deprecated marks the field as deprecated with the given reason.
+func (b *decimalBuilder) Deprecated(reason string) *decimalBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
+// StructTag sets the struct tag of the field.
+func (b *decimalBuilder) StructTag(s string) *decimalBuilder {
+	b.desc.Tag = s
+	return b
+}
+
+// StorageKey sets the storage key of the field.
+// In SQL dialects is the column name and Gremlin is the property.
+func (b *decimalBuilder) StorageKey(key string) *decimalBuilder {
+	b.desc.StorageKey = key
+	return b
+}
+
+// SchemaType overrides the default database type with a custom
+// schema type (per dialect) for decimal.
+//
+//	field.Decimal("amount").
+//		SchemaType(map[string]string{
+//			dialect.MySQL:    "decimal(6,2) unsigned",
+//			dialect.Postgres: "money",
+//		})
+//
+func (b *decimalBuilder) SchemaType(types map[string]string) *decimalBuilder {
+	b.desc.SchemaType = types
+	return b
+}
+
+// GoType overrides the default Go type (string) with a custom one. The type must be
+// a string type or implement the ValueScanner interface. Custom types that implement
+// the "Add(T) T" method (like Rat), support the Add<F> mutations.
+//
+//	field.Decimal("amount").
+//		GoType(field.Rat{})
+//
+//	field.Decimal("amount").
+//		GoType(decimal.Decimal{})
+//
+func (b *decimalBuilder) GoType(typ interface{}) *decimalBuilder {
+	b.desc.goType(typ, stringType)
+	return b
+}
+
+// Annotations adds a list of annotations to the field object to be used by
+// codegen extensions.
+//
+//	field.Decimal("price").
+//		Annotations(
+//			entgql.OrderField("PRICE"),
+//		)
+//
+func (b *decimalBuilder) Annotations(annotations ...schema.Annotation) *decimalBuilder {
+	b.desc.Annotations = append(b.desc.Annotations, annotations...)
+	return b
+}
+
+// Descriptor implements the ent.Field interface by returning its descriptor.
+func (b *decimalBuilder) Descriptor() *Descriptor {
+	switch d := b.desc; {
+	case d.Err != nil:
+	case d.Precision < 0 || d.Scale < 0:
+		d.Err = fmt.Errorf("precision and scale of decimal field %q must be positive", d.Name)
+	case d.Scale > 0 && d.Precision == 0:
+		d.Err = fmt.Errorf("scale of decimal field %q requires the precision to be set", d.Name)
+	case d.Scale > d.Precision:
+		d.Err = fmt.Errorf("scale of decimal field %q (%d) is greater than its precision (%d)", d.Name, d.Scale, d.Precision)
+	case d.Default != nil && d.Info.RType != nil && d.Info.RType.Kind != reflect.String:
+		d.Err = fmt.Errorf("default value of decimal field %q is not supported for GoType %s", d.Name, d.Info)
+	case d.Default != nil:
+		if _, ok := new(big.Rat).SetString(d.Default.(string)); !ok {
+			d.Err = fmt.Errorf("invalid default value %q for decimal field %q", d.Default, d.Name)
+		}
+	}
+	return b.desc
+}
+
+// Rat is an arbitrary-precision decimal value that can be used as the Go type of decimal
+// fields. Unlike big.Rat, Rat is an immutable value type, and it implements the ValueScanner
+// interface and the "Add(T) T" method, which enables the Add<F> mutations in the generated
+// code. The zero value of Rat is 0.
+type Rat struct {
+	r *big.Rat
+}
+
+// NewRat returns a new Rat with the value of the given big.Rat.
+func NewRat(r *big.Rat) Rat {
+	return Rat{r: new(big.Rat).Set(r)}
+}
+
+// ParseRat parses the given decimal (e.g. "12.50") or fraction (e.g. "1/4") string to a Rat.
+func ParseRat(s string) (Rat, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Rat{}, fmt.Errorf("field: invalid decimal value %q", s)
+	}
+	return Rat{r: r}, nil
+}
+
+// Rat returns a copy of the value as a big.Rat.
+func (r Rat) Rat() *big.Rat {
+	if r.r == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).Set(r.r)
+}
+
+// Add returns the sum r+x.
+func (r Rat) Add(x Rat) Rat {
+	return Rat{r: new(big.Rat).Add(r.Rat(), x.Rat())}
+}
+
+// Cmp compares r and x and returns -1 if r < x, 0 if r == x and +1 if r > x.
+func (r Rat) Cmp(x Rat) int {
+	return r.Rat().Cmp(x.Rat())
+}
+
+// maxScale is the maximum number of digits after the decimal point that are
+// used for representing values with an infinite decimal expansion (e.g. 1/3).
+const maxScale = 30
+
+// String returns the decimal representation of r. Values with an infinite
+// decimal expansion are rounded to 30 digits after the decimal point.
+func (r Rat) String() string {
+	v := r.Rat()
+	if v.IsInt() {
+		return v.Num().String()
+	}
+	// A fraction has a finite decimal expansion, if and only if the prime
+	// factors of its (normalized) denominator are only 2 and 5.
+	var (
+		n2, n5 int
+		m      big.Int
+		d      = new(big.Int).Set(v.Denom())
+		two    = big.NewInt(2)
+		five   = big.NewInt(5)
+	)
+	for ; m.Mod(d, two).Sign() == 0; n2++ {
+		d.Quo(d, two)
+	}
+	for ; m.Mod(d, five).Sign() == 0; n5++ {
+		d.Quo(d, five)
+	}
+	scale := n2
+	if n5 > scale {
+		scale = n5
+	}
+	if d.Cmp(big.NewInt(1)) != 0 || scale > maxScale {
+		scale = maxScale
+	}
+	return v.FloatString(scale)
+}
+
+// Value implements the driver.Valuer interface.
+func (r Rat) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *Rat) Scan(src interface{}) (err error) {
+	switch v := src.(type) {
+	case nil:
+		*r = Rat{}
+	case string:
+		*r, err = ParseRat(v)
+	case []byte:
+		*r, err = ParseRat(string(v))
+	case int64:
+		*r = Rat{r: new(big.Rat).SetInt64(v)}
+	case float64:
+		// Use the shortest decimal representation of the float
+		// instead of its exact binary value. e.g. 0.1 and not
+		// 0.1000000000000000055511151231257827021181583404541015625.
+		*r, err = ParseRat(strconv.FormatFloat(v, 'f', -1, 64))
+	default:
+		err = fmt.Errorf("field: unexpected type %T for decimal value", src)
+	}
+	return err
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (r Rat) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (r *Rat) UnmarshalText(text []byte) (err error) {
+	*r, err = ParseRat(string(text))
+	return err
+}
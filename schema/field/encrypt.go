@@ -0,0 +1,72 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Encrypter is the interface implemented by the encryption schemes of encrypted
+// fields. The entc/gen package uses it for encrypting field values before they are
+// written to the database, and for decrypting them after they are scanned.
+//
+// See the entgo.io/ent/schema/field/encrypt package for an AES-GCM implementation.
+type Encrypter interface {
+	// Encrypt encrypts the given plaintext.
+	Encrypt(plaintext []byte) ([]byte, error)
+	// Decrypt decrypts the given ciphertext.
+	Decrypt(ciphertext []byte) ([]byte, error)
+	// Deterministic reports if encrypting the same plaintext always yields the
+	// same ciphertext. Equality predicates are generated only for fields that are
+	// encrypted deterministically.
+	Deterministic() bool
+}
+
+// EncryptedValue returns a driver.Valuer that encrypts the given string or []byte
+// value using the Encrypter when it is passed to the database driver. It is used by
+// the generated code for setting encrypted fields and for comparing them in predicates.
+func EncryptedValue(e Encrypter, v interface{}) driver.Valuer {
+	return encryptedValue{e: e, v: v}
+}
+
+// encryptedValue is the driver.Valuer returned by EncryptedValue.
+type encryptedValue struct {
+	e Encrypter
+	v interface{}
+}
+
+// Value implements the driver.Valuer interface.
+func (ev encryptedValue) Value() (driver.Value, error) {
+	if ev.e == nil {
+		return nil, fmt.Errorf("field: missing encrypter for encrypted value")
+	}
+	var plaintext []byte
+	switch v := ev.v.(type) {
+	case string:
+		plaintext = []byte(v)
+	case []byte:
+		plaintext = v
+	case *string:
+		if v == nil {
+			return nil, nil
+		}
+		plaintext = []byte(*v)
+	case *[]byte:
+		if v == nil {
+			return nil, nil
+		}
+		plaintext = *v
+	default:
+		return nil, fmt.Errorf("field: unexpected type %T for encrypted value", ev.v)
+	}
+	return ev.e.Encrypt(plaintext)
+}
+
+// String implements the fmt.Stringer interface, and ensures the
+// plaintext is not printed when query arguments are logged.
+func (encryptedValue) String() string {
+	return "<encrypted>"
+}
@@ -0,0 +1,200 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+// Package encrypt provides AES-GCM implementations of the field.Encrypter interface
+// for encrypting field values at rest.
+//
+//	keys := encrypt.Keys{
+//		Current: "2021-10",
+//		Keys: map[string][]byte{
+//			"2021-04": oldKey,
+//			"2021-10": newKey,
+//		},
+//	}
+//	field.String("ssn").
+//		Encrypted(encrypt.DeterministicAES(keys))
+//
+// Ciphertexts hold the ID of the key that was used for encrypting them. Hence, keys
+// can be rotated by changing the current key of the KeyProvider, and keeping the old
+// keys available for decrypting values that were stored before the rotation.
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"entgo.io/ent/schema/field"
+)
+
+// version is the version of the ciphertext format:
+//
+//	version (1 byte) | key id length (1 byte) | key id | nonce | sealed data
+//
+const version = 1
+
+// MinKeySize is the minimum size in bytes of the keys returned by a KeyProvider.
+const MinKeySize = 16
+
+// KeyProvider provides the keys that are used for encrypting and decrypting values.
+type KeyProvider interface {
+	// CurrentKey returns the key that is used for encrypting new values, and its ID.
+	CurrentKey() (id string, key []byte, err error)
+	// Key returns the key with the given ID. It is used for decrypting values that
+	// were encrypted with a previous key, after the current key was rotated.
+	Key(id string) ([]byte, error)
+}
+
+// Keys is a static KeyProvider that holds its keys in memory.
+type Keys struct {
+	// Current is the ID of the key that is used for encrypting new values.
+	Current string
+	// Keys maps key IDs to their keys.
+	Keys map[string][]byte
+}
+
+// CurrentKey implements the KeyProvider interface.
+func (k Keys) CurrentKey() (string, []byte, error) {
+	key, err := k.Key(k.Current)
+	if err != nil {
+		return "", nil, err
+	}
+	return k.Current, key, nil
+}
+
+// Key implements the KeyProvider interface.
+func (k Keys) Key(id string) ([]byte, error) {
+	key, ok := k.Keys[id]
+	if !ok {
+		return nil, fmt.Errorf("encrypt: key %q was not found", id)
+	}
+	return key, nil
+}
+
+// AES returns a field.Encrypter that encrypts values using AES-256-GCM with a random
+// nonce. Encrypting the same plaintext twice yields different ciphertexts, and therefore,
+// fields that use it cannot be queried using equality predicates.
+func AES(p KeyProvider) field.Encrypter {
+	return &encrypter{provider: p}
+}
+
+// DeterministicAES returns a field.Encrypter that encrypts values using AES-256-GCM with
+// a nonce derived from the HMAC-SHA256 of the plaintext (a synthetic nonce). Encrypting
+// the same plaintext with the same key always yields the same ciphertext, which allows
+// querying fields using equality predicates, at the cost of revealing which values are
+// equal. Note that predicates match only values that were encrypted with the current key.
+func DeterministicAES(p KeyProvider) field.Encrypter {
+	return &encrypter{provider: p, deterministic: true}
+}
+
+// KeyID returns the ID of the key that was used for encrypting the given ciphertext.
+// It can be used for finding values that need to be re-encrypted after key rotation.
+func KeyID(ciphertext []byte) (string, error) {
+	id, _, err := split(ciphertext)
+	return id, err
+}
+
+type encrypter struct {
+	provider      KeyProvider
+	deterministic bool
+}
+
+// Deterministic implements the field.Encrypter interface.
+func (e *encrypter) Deterministic() bool {
+	return e.deterministic
+}
+
+// Encrypt implements the field.Encrypter interface.
+func (e *encrypter) Encrypt(plaintext []byte) ([]byte, error) {
+	id, key, err := e.provider.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("encrypt: key id %q is too long", id)
+	}
+	aead, nonceKey, err := derive(key)
+	if err != nil {
+		return nil, err
+	}
+	header := append([]byte{version, byte(len(id))}, id...)
+	nonce := make([]byte, aead.NonceSize())
+	if e.deterministic {
+		mac := hmac.New(sha256.New, nonceKey)
+		mac.Write(header)
+		mac.Write(plaintext)
+		copy(nonce, mac.Sum(nil))
+	} else if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("encrypt: generating nonce: %w", err)
+	}
+	out := make([]byte, 0, len(header)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(append(out, header...), nonce...)
+	return aead.Seal(out, nonce, plaintext, header), nil
+}
+
+// Decrypt implements the field.Encrypter interface.
+func (e *encrypter) Decrypt(ciphertext []byte) ([]byte, error) {
+	id, data, err := split(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	key, err := e.provider.Key(id)
+	if err != nil {
+		return nil, err
+	}
+	aead, _, err := derive(key)
+	if err != nil {
+		return nil, err
+	}
+	n := aead.NonceSize()
+	if len(data) < n {
+		return nil, errors.New("encrypt: ciphertext is too short")
+	}
+	plaintext, err := aead.Open(nil, data[:n], data[n:], ciphertext[:len(ciphertext)-len(data)])
+	if err != nil {
+		return nil, fmt.Errorf("encrypt: decrypting value with key %q: %w", id, err)
+	}
+	return plaintext, nil
+}
+
+// split splits the given ciphertext into its key id and its nonce and sealed data.
+func split(ciphertext []byte) (string, []byte, error) {
+	if len(ciphertext) < 2 || ciphertext[0] != version {
+		return "", nil, errors.New("encrypt: unexpected ciphertext format")
+	}
+	n := int(ciphertext[1])
+	if len(ciphertext) < 2+n {
+		return "", nil, errors.New("encrypt: ciphertext is too short")
+	}
+	return string(ciphertext[2 : 2+n]), ciphertext[2+n:], nil
+}
+
+// derive derives the AES-256-GCM cipher and the nonce key from the given key. Separate
+// keys are derived, because the nonce key is used only by the deterministic mode.
+func derive(key []byte) (cipher.AEAD, []byte, error) {
+	if len(key) < MinKeySize {
+		return nil, nil, fmt.Errorf("encrypt: key must be at least %d bytes", MinKeySize)
+	}
+	block, err := aes.NewCipher(sum(key, "ent/encrypt/aes"))
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, sum(key, "ent/encrypt/nonce"), nil
+}
+
+// sum returns the HMAC-SHA256 of the given label using the given key.
+func sum(key []byte, label string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(label))
+	return mac.Sum(nil)
+}
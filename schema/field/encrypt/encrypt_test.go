@@ -0,0 +1,92 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package encrypt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAES(t *testing.T) {
+	keys := Keys{Current: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte("a"), 32)}}
+	e := AES(keys)
+	require.False(t, e.Deterministic())
+	c1, err := e.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	c2, err := e.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.NotEqual(t, c1, c2, "random nonce")
+	require.NotContains(t, string(c1), "secret")
+	for _, c := range [][]byte{c1, c2} {
+		p, err := e.Decrypt(c)
+		require.NoError(t, err)
+		require.Equal(t, "secret", string(p))
+	}
+	id, err := KeyID(c1)
+	require.NoError(t, err)
+	require.Equal(t, "k1", id)
+
+	// Tampered ciphertext.
+	c1[len(c1)-1] ^= 1
+	_, err = e.Decrypt(c1)
+	require.Error(t, err)
+	_, err = e.Decrypt([]byte("plaintext"))
+	require.Error(t, err)
+}
+
+func TestDeterministicAES(t *testing.T) {
+	keys := Keys{Current: "k1", Keys: map[string][]byte{"k1": bytes.Repeat([]byte("a"), 16)}}
+	e := DeterministicAES(keys)
+	require.True(t, e.Deterministic())
+	c1, err := e.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	c2, err := e.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.Equal(t, c1, c2)
+	c3, err := e.Encrypt([]byte("secreT"))
+	require.NoError(t, err)
+	require.NotEqual(t, c1, c3)
+	p, err := e.Decrypt(c1)
+	require.NoError(t, err)
+	require.Equal(t, "secret", string(p))
+}
+
+func TestRotation(t *testing.T) {
+	keys := Keys{
+		Current: "k1",
+		Keys: map[string][]byte{
+			"k1": bytes.Repeat([]byte("a"), 32),
+			"k2": bytes.Repeat([]byte("b"), 32),
+		},
+	}
+	c1, err := DeterministicAES(keys).Encrypt([]byte("secret"))
+	require.NoError(t, err)
+
+	keys.Current = "k2"
+	e := DeterministicAES(keys)
+	c2, err := e.Encrypt([]byte("secret"))
+	require.NoError(t, err)
+	require.NotEqual(t, c1, c2)
+	for _, c := range [][]byte{c1, c2} {
+		p, err := e.Decrypt(c)
+		require.NoError(t, err)
+		require.Equal(t, "secret", string(p))
+	}
+	id, err := KeyID(c2)
+	require.NoError(t, err)
+	require.Equal(t, "k2", id)
+
+	delete(keys.Keys, "k1")
+	_, err = e.Decrypt(c1)
+	require.EqualError(t, err, `encrypt: key "k1" was not found`)
+}
+
+func TestKeySize(t *testing.T) {
+	keys := Keys{Current: "k1", Keys: map[string][]byte{"k1": []byte("short")}}
+	_, err := AES(keys).Encrypt([]byte("secret"))
+	require.EqualError(t, err, "encrypt: key must be at least 16 bytes")
+}
@@ -7,11 +7,13 @@ package field
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"reflect"
 	"regexp"
+	"strings"
 	"time"
 
 	"entgo.io/ent/schema"
@@ -86,6 +88,9 @@ func JSON(name string, typ interface{}) *jsonBuilder {
 		b.desc.Info.Nillable = true
 		b.desc.Info.PkgPath = pkgPath(t)
 	}
+	if b.desc.Info.RType != nil {
+		b.desc.Info.RType.Fields = structFields(t)
+	}
 	return b
 }
 
@@ -142,8 +147,9 @@ func UUID(name string, typ driver.Valuer) *uuidBuilder {
 // Other represents a field that is not a good fit for any of the standard field types.
 //
 // The second argument defines the GoType and must implement the ValueScanner interface.
-// The SchemaType option must be set because the field type cannot be inferred.
-// An example for defining Other field is as follows:
+// The SchemaType option must be set because the field type cannot be inferred, unless
+// the GoType implements the SchemaTyper interface. An example for defining Other field
+// is as follows:
 //
 //	field.Other("link", &Link{}).
 //		SchemaType(map[string]string{
@@ -157,9 +163,19 @@ func Other(name string, typ driver.Valuer) *otherBuilder {
 		Info: &TypeInfo{Type: TypeOther},
 	}}
 	ob.desc.goType(typ, valueScannerType)
+	if st, ok := typ.(SchemaTyper); ok {
+		ob.desc.SchemaType = st.SchemaType()
+	}
 	return ob
 }
 
+// SchemaTyper is the interface implemented by GoTypes of Other fields
+// that provide their own database types. For example, the types of the
+// entgo.io/ent/dialect/sql/pgtype package.
+type SchemaTyper interface {
+	SchemaType() map[string]string
+}
+
 // stringBuilder is the builder for string fields.
 type stringBuilder struct {
 	desc *Descriptor
@@ -177,11 +193,24 @@ func (b *stringBuilder) Sensitive() *stringBuilder {
 	return b
 }
 
+// Encrypted configures the field to be encrypted at rest using the given Encrypter.
+// Values are encrypted before they are written to the database and decrypted when
+// they are scanned. Fields that are encrypted using a non-deterministic Encrypter
+// do not support predicates, except for nil checks.
+//
+//	field.String("ssn").
+//		Encrypted(encrypt.AES(keys))
+//
+func (b *stringBuilder) Encrypted(e Encrypter) *stringBuilder {
+	b.desc.Encrypter = e
+	return b
+}
+
 // Match adds a regex matcher for this field. Operation fails if the regex fails.
 func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
 		if !re.MatchString(v) {
-			return errors.New("value does not match validation")
+			return validatorError("value does not match validation", "Match", re.String())
 		}
 		return nil
 	})
@@ -193,7 +222,7 @@ func (b *stringBuilder) Match(re *regexp.Regexp) *stringBuilder {
 func (b *stringBuilder) MinLen(i int) *stringBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
 		if len(v) < i {
-			return errors.New("value is less than the required length")
+			return validatorError("value is less than the required length", "MinLen", i)
 		}
 		return nil
 	})
@@ -212,7 +241,7 @@ func (b *stringBuilder) MaxLen(i int) *stringBuilder {
 	b.desc.Size = i
 	b.desc.Validators = append(b.desc.Validators, func(v string) error {
 		if len(v) > i {
-			return errors.New("value is greater than the required length")
+			return validatorError("value is greater than the required length", "MaxLen", i)
 		}
 		return nil
 	})
@@ -268,6 +297,13 @@ func (b *stringBuilder) Comment(c string) *stringBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *stringBuilder) Deprecated(reason string) *stringBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *stringBuilder) StructTag(s string) *stringBuilder {
 	b.desc.Tag = s
@@ -358,6 +394,13 @@ func (b *timeBuilder) Comment(c string) *timeBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *timeBuilder) Deprecated(reason string) *timeBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *timeBuilder) StructTag(s string) *timeBuilder {
 	b.desc.Tag = s
@@ -473,6 +516,13 @@ func (b *boolBuilder) Comment(c string) *boolBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *boolBuilder) Deprecated(reason string) *boolBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *boolBuilder) StructTag(s string) *boolBuilder {
 	b.desc.Tag = s
@@ -563,6 +613,15 @@ func (b *bytesBuilder) Unique() *bytesBuilder {
 	return b
 }
 
+// Encrypted configures the field to be encrypted at rest using the given Encrypter.
+// Values are encrypted before they are written to the database and decrypted when
+// they are scanned. Fields that are encrypted using a non-deterministic Encrypter
+// do not support predicates, except for nil checks.
+func (b *bytesBuilder) Encrypted(e Encrypter) *bytesBuilder {
+	b.desc.Encrypter = e
+	return b
+}
+
 // Immutable indicates that this field cannot be updated.
 func (b *bytesBuilder) Immutable() *bytesBuilder {
 	b.desc.Immutable = true
@@ -575,6 +634,13 @@ func (b *bytesBuilder) Comment(c string) *bytesBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *bytesBuilder) Deprecated(reason string) *bytesBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *bytesBuilder) StructTag(s string) *bytesBuilder {
 	b.desc.Tag = s
@@ -588,7 +654,7 @@ func (b *bytesBuilder) MaxLen(i int) *bytesBuilder {
 	b.desc.Size = i
 	b.desc.Validators = append(b.desc.Validators, func(buf []byte) error {
 		if len(buf) > i {
-			return errors.New("value is greater than the required length")
+			return validatorError("value is greater than the required length", "MaxLen", i)
 		}
 		return nil
 	})
@@ -600,7 +666,7 @@ func (b *bytesBuilder) MaxLen(i int) *bytesBuilder {
 func (b *bytesBuilder) MinLen(i int) *bytesBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(b []byte) error {
 		if len(b) < i {
-			return errors.New("value is less than the required length")
+			return validatorError("value is less than the required length", "MinLen", i)
 		}
 		return nil
 	})
@@ -705,6 +771,13 @@ func (b *jsonBuilder) Comment(c string) *jsonBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *jsonBuilder) Deprecated(reason string) *jsonBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *jsonBuilder) StructTag(s string) *jsonBuilder {
 	b.desc.Tag = s
@@ -832,6 +905,13 @@ func (b *enumBuilder) Comment(c string) *enumBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *enumBuilder) Deprecated(reason string) *enumBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Nillable indicates that this field is a nillable.
 // Unlike "Optional" only fields, "Nillable" fields are pointers in the generated struct.
 func (b *enumBuilder) Nillable() *enumBuilder {
@@ -942,6 +1022,13 @@ func (b *uuidBuilder) Comment(c string) *uuidBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *uuidBuilder) Deprecated(reason string) *uuidBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *uuidBuilder) StructTag(s string) *uuidBuilder {
 	b.desc.Tag = s
@@ -1068,6 +1155,13 @@ func (b *otherBuilder) Comment(c string) *otherBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *otherBuilder) Deprecated(reason string) *otherBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // StructTag sets the struct tag of the field.
 func (b *otherBuilder) StructTag(s string) *otherBuilder {
 	b.desc.Tag = s
@@ -1122,24 +1216,29 @@ func (b *otherBuilder) Descriptor() *Descriptor {
 
 // A Descriptor for field configuration.
 type Descriptor struct {
-	Tag           string                  // struct tag.
-	Size          int                     // varchar size.
-	Name          string                  // field name.
-	Info          *TypeInfo               // field type info.
-	Unique        bool                    // unique index of field.
-	Nillable      bool                    // nillable struct field.
-	Optional      bool                    // nullable field in database.
-	Immutable     bool                    // create-only field.
-	Default       interface{}             // default value on create.
-	UpdateDefault interface{}             // default value on update.
-	Validators    []interface{}           // validator functions.
-	StorageKey    string                  // sql column or gremlin property.
-	Enums         []struct{ N, V string } // enum values.
-	Sensitive     bool                    // sensitive info string field.
-	SchemaType    map[string]string       // override the schema type.
-	Annotations   []schema.Annotation     // field annotations.
-	Comment       string                  // field comment.
-	Err           error
+	Tag              string                  // struct tag.
+	Size             int                     // varchar size.
+	Precision        int                     // decimal precision.
+	Scale            int                     // decimal scale.
+	Name             string                  // field name.
+	Info             *TypeInfo               // field type info.
+	Unique           bool                    // unique index of field.
+	Nillable         bool                    // nillable struct field.
+	Optional         bool                    // nullable field in database.
+	Immutable        bool                    // create-only field.
+	Default          interface{}             // default value on create.
+	UpdateDefault    interface{}             // default value on update.
+	Validators       []interface{}           // validator functions.
+	StorageKey       string                  // sql column or gremlin property.
+	Enums            []struct{ N, V string } // enum values.
+	Sensitive        bool                    // sensitive info string field.
+	Encrypter        Encrypter               // encrypter of encrypted fields.
+	SchemaType       map[string]string       // override the schema type.
+	Annotations      []schema.Annotation     // field annotations.
+	Comment          string                  // field comment.
+	Deprecated       bool                    // deprecated field.
+	DeprecatedReason string                  // deprecation reason.
+	Err              error
 }
 
 func (d *Descriptor) goType(typ interface{}, expectType reflect.Type) {
@@ -1209,12 +1308,13 @@ func (d *Descriptor) checkDefaultFunc(expectType reflect.Type) {
 }
 
 var (
-	boolType         = reflect.TypeOf(false)
-	bytesType        = reflect.TypeOf([]byte(nil))
-	timeType         = reflect.TypeOf(time.Time{})
-	stringType       = reflect.TypeOf("")
-	valuerType       = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
-	valueScannerType = reflect.TypeOf((*ValueScanner)(nil)).Elem()
+	boolType          = reflect.TypeOf(false)
+	bytesType         = reflect.TypeOf([]byte(nil))
+	timeType          = reflect.TypeOf(time.Time{})
+	stringType        = reflect.TypeOf("")
+	valuerType        = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	valueScannerType  = reflect.TypeOf((*ValueScanner)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
 )
 
 // ValueScanner is the interface that groups the Value and the Scan methods.
@@ -1231,6 +1331,47 @@ func indirect(t reflect.Type) reflect.Type {
 	return t
 }
 
+// structFields returns the exported fields of the given struct type
+// (or a pointer to struct), as they are encoded in JSON. Embedded and
+// ignored fields (tagged with "-") are skipped, and types with custom
+// JSON encoding are ignored.
+func structFields(t reflect.Type) []*RStructField {
+	t = indirect(t)
+	if t.Kind() != reflect.Struct || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return nil
+	}
+	fields := make([]*RStructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous || f.PkgPath != "" {
+			continue
+		}
+		key := f.Name
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			name := strings.Split(tag, ",")[0]
+			if name == "-" {
+				continue
+			}
+			if name != "" {
+				key = name
+			}
+		}
+		ft := indirect(f.Type)
+		rt := &RType{
+			Name:    ft.Name(),
+			Ident:   f.Type.String(),
+			Kind:    f.Type.Kind(),
+			PkgPath: ft.PkgPath(),
+		}
+		// Nested (non-pointer) structs cannot be recursive.
+		if f.Type.Kind() == reflect.Struct {
+			rt.Fields = structFields(f.Type)
+		}
+		fields = append(fields, &RStructField{Name: f.Name, Key: key, Type: rt})
+	}
+	return fields
+}
+
 func pkgPath(t reflect.Type) string {
 	pkg := t.PkgPath()
 	if pkg != "" {
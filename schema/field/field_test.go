@@ -7,7 +7,10 @@ package field_test
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math/big"
 	"net"
 	"net/http"
 	"net/url"
@@ -150,6 +153,88 @@ func TestFloat(t *testing.T) {
 	assert.Error(t, fd.Err)
 }
 
+func TestDecimal(t *testing.T) {
+	fd := field.Decimal("price").Precision(10).Scale(2).Default("0.00").Comment("comment").Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "price", fd.Name)
+	assert.Equal(t, field.TypeDecimal, fd.Info.Type)
+	assert.Equal(t, "string", fd.Info.String())
+	assert.Equal(t, 10, fd.Precision)
+	assert.Equal(t, 2, fd.Scale)
+	assert.Equal(t, "0.00", fd.Default)
+	assert.Equal(t, "comment", fd.Comment)
+	assert.True(t, fd.Info.Numeric())
+	assert.False(t, fd.Info.Type.Integer())
+
+	fd = field.Decimal("price").Scale(2).Descriptor()
+	assert.Error(t, fd.Err, "scale requires precision")
+	fd = field.Decimal("price").Precision(2).Scale(4).Descriptor()
+	assert.Error(t, fd.Err, "scale is greater than precision")
+	fd = field.Decimal("price").Default("1.2.3").Descriptor()
+	assert.Error(t, fd.Err, "invalid default value")
+
+	type Amount string
+	fd = field.Decimal("amount").GoType(Amount("")).Default("1").Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "field_test.Amount", fd.Info.Ident)
+	assert.False(t, fd.Info.ValueScanner())
+
+	fd = field.Decimal("amount").GoType(field.Rat{}).Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, "field.Rat", fd.Info.Ident)
+	assert.Equal(t, "entgo.io/ent/schema/field", fd.Info.PkgPath)
+	assert.True(t, fd.Info.ValueScanner())
+	assert.Contains(t, fd.Info.RType.Methods, "Add")
+
+	fd = field.Decimal("amount").GoType(field.Rat{}).Default("1").Descriptor()
+	assert.Error(t, fd.Err, "default value is supported only for string types")
+	fd = field.Decimal("amount").GoType(1).Descriptor()
+	assert.Error(t, fd.Err)
+}
+
+func TestRat(t *testing.T) {
+	var zero field.Rat
+	assert.Equal(t, "0", zero.String())
+
+	r, err := field.ParseRat("10.25")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.25", r.String())
+	assert.Equal(t, "20.5", r.Add(r).String())
+	assert.Equal(t, "10.25", r.String(), "values are immutable")
+	assert.Equal(t, 1, r.Cmp(zero))
+
+	r, err = field.ParseRat("1/3")
+	assert.NoError(t, err)
+	assert.Equal(t, "0.333333333333333333333333333333", r.String())
+	_, err = field.ParseRat("1.2.3")
+	assert.Error(t, err)
+
+	v, err := field.NewRat(big.NewRat(-1, 8)).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "-0.125", v)
+
+	for src, want := range map[interface{}]string{
+		nil:          "0",
+		"12.50":      "12.5",
+		"-1e-3":      "-0.001",
+		int64(42):    "42",
+		float64(0.1): "0.1",
+	} {
+		assert.NoError(t, r.Scan(src))
+		assert.Equal(t, want, r.String())
+	}
+	assert.NoError(t, r.Scan([]byte("99.99")))
+	assert.Equal(t, "99.99", r.String())
+	assert.Error(t, r.Scan(true))
+
+	b, err := json.Marshal(struct{ V field.Rat }{V: r})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"V":"99.99"}`, string(b))
+	var out struct{ V field.Rat }
+	assert.NoError(t, json.Unmarshal(b, &out))
+	assert.Zero(t, out.V.Cmp(r))
+}
+
 func TestBool(t *testing.T) {
 	fd := field.Bool("active").Default(true).Comment("comment").Immutable().Descriptor()
 	assert.Equal(t, "active", fd.Name)
@@ -302,6 +387,66 @@ func TestString_DefaultFunc(t *testing.T) {
 	assert.Error(t, fd.Err, "`var _ http.Dir = f4()` should fail")
 }
 
+func TestValidatorError(t *testing.T) {
+	tests := []struct {
+		validator func() error
+		rule      string
+		params    []interface{}
+	}{
+		{
+			validator: func() error {
+				return field.String("name").MinLen(2).Descriptor().Validators[0].(func(string) error)("a")
+			},
+			rule:   "MinLen",
+			params: []interface{}{2},
+		},
+		{
+			validator: func() error {
+				return field.String("name").MaxLen(1).Descriptor().Validators[0].(func(string) error)("ab")
+			},
+			rule:   "MaxLen",
+			params: []interface{}{1},
+		},
+		{
+			validator: func() error {
+				return field.String("name").Match(regexp.MustCompile("^a+$")).Descriptor().Validators[0].(func(string) error)("b")
+			},
+			rule:   "Match",
+			params: []interface{}{"^a+$"},
+		},
+		{
+			validator: func() error {
+				return field.Bytes("blob").NotEmpty().Descriptor().Validators[0].(func([]byte) error)(nil)
+			},
+			rule:   "MinLen",
+			params: []interface{}{1},
+		},
+		{
+			validator: func() error {
+				return field.Int("age").Range(1, 10).Descriptor().Validators[0].(func(int) error)(11)
+			},
+			rule:   "Range",
+			params: []interface{}{1, 10},
+		},
+		{
+			validator: func() error {
+				return field.Float("score").Positive().Descriptor().Validators[0].(func(float64) error)(-1)
+			},
+			rule:   "Min",
+			params: []interface{}{1e-06},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			var verr *field.ValidatorError
+			err := fmt.Errorf("wrapped: %w", tt.validator())
+			assert.True(t, errors.As(err, &verr))
+			assert.Equal(t, tt.rule, verr.Rule)
+			assert.Equal(t, tt.params, verr.Params)
+		})
+	}
+}
+
 type VString string
 
 func (s *VString) Scan(interface{}) error {
@@ -383,6 +528,56 @@ func TestString(t *testing.T) {
 	assert.Error(t, fd.Err)
 }
 
+type reverse struct{}
+
+func (reverse) Encrypt(b []byte) ([]byte, error) {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r, nil
+}
+func (e reverse) Decrypt(b []byte) ([]byte, error) { return e.Encrypt(b) }
+func (reverse) Deterministic() bool                { return true }
+
+func TestEncrypted(t *testing.T) {
+	fd := field.String("ssn").Encrypted(reverse{}).Descriptor()
+	assert.Equal(t, reverse{}, fd.Encrypter)
+	fd = field.Bytes("notes").Encrypted(reverse{}).Descriptor()
+	assert.Equal(t, reverse{}, fd.Encrypter)
+
+	v, err := field.EncryptedValue(reverse{}, "abc").Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("cba"), v)
+	v, err = field.EncryptedValue(reverse{}, []byte("ab")).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("ba"), v)
+	v, err = field.EncryptedValue(reverse{}, (*string)(nil)).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	_, err = field.EncryptedValue(reverse{}, 1).Value()
+	assert.Error(t, err)
+	assert.Equal(t, "<encrypted>", fmt.Sprint(field.EncryptedValue(reverse{}, "abc")))
+}
+
+func TestDeprecated(t *testing.T) {
+	for _, fd := range []*field.Descriptor{
+		field.String("nickname").Optional().Deprecated("use name").Descriptor(),
+		field.Int("rank").Default(0).Deprecated("use score").Descriptor(),
+		field.Float("ratio").Optional().Deprecated("use score").Descriptor(),
+		field.Time("seen_at").Optional().Deprecated("use last_seen").Descriptor(),
+		field.Enum("state").Values("on", "off").Optional().Deprecated("use status").Descriptor(),
+	} {
+		assert.True(t, fd.Deprecated)
+		assert.NotEmpty(t, fd.DeprecatedReason)
+	}
+	fd := field.Bool("active").Deprecated("").Descriptor()
+	assert.True(t, fd.Deprecated)
+	assert.Empty(t, fd.DeprecatedReason)
+	fd = field.Bool("active").Descriptor()
+	assert.False(t, fd.Deprecated)
+}
+
 func TestTime(t *testing.T) {
 	now := time.Now()
 	fd := field.Time("created_at").
@@ -454,6 +649,32 @@ func TestJSON(t *testing.T) {
 	assert.True(t, fd.Info.RType.IsPtr())
 	assert.Equal(t, "T", fd.Info.RType.Name)
 	assert.Equal(t, "entgo.io/ent/schema/field_test", fd.Info.RType.PkgPath)
+	assert.Len(t, fd.Info.RType.Fields, 1)
+	assert.Equal(t, "S", fd.Info.RType.Fields[0].Name)
+	assert.Equal(t, "S", fd.Info.RType.Fields[0].Key)
+	assert.Equal(t, reflect.String, fd.Info.RType.Fields[0].Type.Kind)
+
+	type Settings struct {
+		Theme   string `json:"theme,omitempty"`
+		Ignored string `json:"-"`
+		Limits  struct {
+			Max int `json:"max"`
+		} `json:"limits"`
+		unexported bool
+	}
+	fd = field.JSON("settings", Settings{}).
+		Descriptor()
+	fields := fd.Info.RType.Fields
+	assert.Len(t, fields, 2)
+	assert.Equal(t, "Theme", fields[0].Name)
+	assert.Equal(t, "theme", fields[0].Key)
+	assert.Equal(t, "string", fields[0].Type.Ident)
+	assert.Equal(t, "limits", fields[1].Key)
+	assert.Equal(t, reflect.Struct, fields[1].Type.Kind)
+	assert.Len(t, fields[1].Type.Fields, 1)
+	assert.Equal(t, "max", fields[1].Type.Fields[0].Key)
+	assert.Equal(t, reflect.Int, fields[1].Type.Fields[0].Type.Kind)
+	assert.Empty(t, field.Strings("strings").Descriptor().Info.RType.Fields)
 
 	fd = field.JSON("dir", http.Dir("dir")).
 		Optional().
@@ -676,6 +897,17 @@ func TestField_Other(t *testing.T) {
 		Default(func() custom { return custom{} }).
 		Descriptor()
 	assert.Error(t, fd.Err, "invalid default value")
+
+	fd = field.Other("other", typed{}).
+		Descriptor()
+	assert.NoError(t, fd.Err)
+	assert.Equal(t, map[string]string{dialect.Postgres: "text[]"}, fd.SchemaType)
+}
+
+type typed struct{ custom }
+
+func (typed) SchemaType() map[string]string {
+	return map[string]string{dialect.Postgres: "text[]"}
 }
 
 func TestTypeString(t *testing.T) {
@@ -683,7 +915,7 @@ func TestTypeString(t *testing.T) {
 	assert.Equal(t, "bool", typ.String())
 	typ = field.TypeInvalid
 	assert.Equal(t, "invalid", typ.String())
-	typ = 21
+	typ = 22
 	assert.Equal(t, "invalid", typ.String())
 }
 
@@ -699,7 +931,7 @@ func TestTypeValid(t *testing.T) {
 	assert.True(t, typ.Valid())
 	typ = 0
 	assert.False(t, typ.Valid())
-	typ = 21
+	typ = 22
 	assert.False(t, typ.Valid())
 }
 
@@ -712,6 +944,6 @@ func TestTypeConstName(t *testing.T) {
 	assert.Equal(t, "TypeInt64", typ.ConstName())
 	typ = field.TypeOther
 	assert.Equal(t, "TypeOther", typ.ConstName())
-	typ = 21
+	typ = 22
 	assert.Equal(t, "invalid", typ.ConstName())
 }
@@ -7,7 +7,6 @@
 package field
 
 import (
-	"errors"
 	"reflect"
 
 	"entgo.io/ent/schema"
@@ -126,7 +125,7 @@ func (b *intBuilder) Unique() *intBuilder {
 func (b *intBuilder) Range(i, j int) *intBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v int) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -137,7 +136,7 @@ func (b *intBuilder) Range(i, j int) *intBuilder {
 func (b *intBuilder) Min(i int) *intBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v int) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -148,7 +147,7 @@ func (b *intBuilder) Min(i int) *intBuilder {
 func (b *intBuilder) Max(i int) *intBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v int) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -208,6 +207,13 @@ func (b *intBuilder) Comment(c string) *intBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *intBuilder) Deprecated(reason string) *intBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *intBuilder) Optional() *intBuilder {
@@ -305,7 +311,7 @@ func (b *uintBuilder) Unique() *uintBuilder {
 func (b *uintBuilder) Range(i, j uint) *uintBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -316,7 +322,7 @@ func (b *uintBuilder) Range(i, j uint) *uintBuilder {
 func (b *uintBuilder) Min(i uint) *uintBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -327,7 +333,7 @@ func (b *uintBuilder) Min(i uint) *uintBuilder {
 func (b *uintBuilder) Max(i uint) *uintBuilder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -377,6 +383,13 @@ func (b *uintBuilder) Comment(c string) *uintBuilder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *uintBuilder) Deprecated(reason string) *uintBuilder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *uintBuilder) Optional() *uintBuilder {
@@ -474,7 +487,7 @@ func (b *int8Builder) Unique() *int8Builder {
 func (b *int8Builder) Range(i, j int8) *int8Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int8) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -485,7 +498,7 @@ func (b *int8Builder) Range(i, j int8) *int8Builder {
 func (b *int8Builder) Min(i int8) *int8Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int8) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -496,7 +509,7 @@ func (b *int8Builder) Min(i int8) *int8Builder {
 func (b *int8Builder) Max(i int8) *int8Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int8) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -556,6 +569,13 @@ func (b *int8Builder) Comment(c string) *int8Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *int8Builder) Deprecated(reason string) *int8Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *int8Builder) Optional() *int8Builder {
@@ -653,7 +673,7 @@ func (b *int16Builder) Unique() *int16Builder {
 func (b *int16Builder) Range(i, j int16) *int16Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int16) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -664,7 +684,7 @@ func (b *int16Builder) Range(i, j int16) *int16Builder {
 func (b *int16Builder) Min(i int16) *int16Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int16) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -675,7 +695,7 @@ func (b *int16Builder) Min(i int16) *int16Builder {
 func (b *int16Builder) Max(i int16) *int16Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int16) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -735,6 +755,13 @@ func (b *int16Builder) Comment(c string) *int16Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *int16Builder) Deprecated(reason string) *int16Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *int16Builder) Optional() *int16Builder {
@@ -832,7 +859,7 @@ func (b *int32Builder) Unique() *int32Builder {
 func (b *int32Builder) Range(i, j int32) *int32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int32) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -843,7 +870,7 @@ func (b *int32Builder) Range(i, j int32) *int32Builder {
 func (b *int32Builder) Min(i int32) *int32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int32) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -854,7 +881,7 @@ func (b *int32Builder) Min(i int32) *int32Builder {
 func (b *int32Builder) Max(i int32) *int32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int32) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -914,6 +941,13 @@ func (b *int32Builder) Comment(c string) *int32Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *int32Builder) Deprecated(reason string) *int32Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *int32Builder) Optional() *int32Builder {
@@ -1011,7 +1045,7 @@ func (b *int64Builder) Unique() *int64Builder {
 func (b *int64Builder) Range(i, j int64) *int64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int64) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -1022,7 +1056,7 @@ func (b *int64Builder) Range(i, j int64) *int64Builder {
 func (b *int64Builder) Min(i int64) *int64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int64) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -1033,7 +1067,7 @@ func (b *int64Builder) Min(i int64) *int64Builder {
 func (b *int64Builder) Max(i int64) *int64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v int64) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -1093,6 +1127,13 @@ func (b *int64Builder) Comment(c string) *int64Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *int64Builder) Deprecated(reason string) *int64Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *int64Builder) Optional() *int64Builder {
@@ -1190,7 +1231,7 @@ func (b *uint8Builder) Unique() *uint8Builder {
 func (b *uint8Builder) Range(i, j uint8) *uint8Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint8) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -1201,7 +1242,7 @@ func (b *uint8Builder) Range(i, j uint8) *uint8Builder {
 func (b *uint8Builder) Min(i uint8) *uint8Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint8) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -1212,7 +1253,7 @@ func (b *uint8Builder) Min(i uint8) *uint8Builder {
 func (b *uint8Builder) Max(i uint8) *uint8Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint8) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -1262,6 +1303,13 @@ func (b *uint8Builder) Comment(c string) *uint8Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *uint8Builder) Deprecated(reason string) *uint8Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *uint8Builder) Optional() *uint8Builder {
@@ -1359,7 +1407,7 @@ func (b *uint16Builder) Unique() *uint16Builder {
 func (b *uint16Builder) Range(i, j uint16) *uint16Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint16) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -1370,7 +1418,7 @@ func (b *uint16Builder) Range(i, j uint16) *uint16Builder {
 func (b *uint16Builder) Min(i uint16) *uint16Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint16) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -1381,7 +1429,7 @@ func (b *uint16Builder) Min(i uint16) *uint16Builder {
 func (b *uint16Builder) Max(i uint16) *uint16Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint16) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -1431,6 +1479,13 @@ func (b *uint16Builder) Comment(c string) *uint16Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *uint16Builder) Deprecated(reason string) *uint16Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *uint16Builder) Optional() *uint16Builder {
@@ -1528,7 +1583,7 @@ func (b *uint32Builder) Unique() *uint32Builder {
 func (b *uint32Builder) Range(i, j uint32) *uint32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint32) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -1539,7 +1594,7 @@ func (b *uint32Builder) Range(i, j uint32) *uint32Builder {
 func (b *uint32Builder) Min(i uint32) *uint32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint32) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -1550,7 +1605,7 @@ func (b *uint32Builder) Min(i uint32) *uint32Builder {
 func (b *uint32Builder) Max(i uint32) *uint32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint32) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -1600,6 +1655,13 @@ func (b *uint32Builder) Comment(c string) *uint32Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *uint32Builder) Deprecated(reason string) *uint32Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *uint32Builder) Optional() *uint32Builder {
@@ -1697,7 +1759,7 @@ func (b *uint64Builder) Unique() *uint64Builder {
 func (b *uint64Builder) Range(i, j uint64) *uint64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint64) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -1708,7 +1770,7 @@ func (b *uint64Builder) Range(i, j uint64) *uint64Builder {
 func (b *uint64Builder) Min(i uint64) *uint64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint64) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -1719,7 +1781,7 @@ func (b *uint64Builder) Min(i uint64) *uint64Builder {
 func (b *uint64Builder) Max(i uint64) *uint64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v uint64) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -1769,6 +1831,13 @@ func (b *uint64Builder) Comment(c string) *uint64Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *uint64Builder) Deprecated(reason string) *uint64Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *uint64Builder) Optional() *uint64Builder {
@@ -1879,7 +1948,7 @@ func (b *float64Builder) Unique() *float64Builder {
 func (b *float64Builder) Range(i, j float64) *float64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v float64) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -1890,7 +1959,7 @@ func (b *float64Builder) Range(i, j float64) *float64Builder {
 func (b *float64Builder) Min(i float64) *float64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v float64) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -1901,7 +1970,7 @@ func (b *float64Builder) Min(i float64) *float64Builder {
 func (b *float64Builder) Max(i float64) *float64Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v float64) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -1937,6 +2006,13 @@ func (b *float64Builder) Comment(c string) *float64Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *float64Builder) Deprecated(reason string) *float64Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *float64Builder) Optional() *float64Builder {
@@ -2032,7 +2108,7 @@ func (b *float32Builder) Unique() *float32Builder {
 func (b *float32Builder) Range(i, j float32) *float32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v float32) error {
 		if v < i || v > j {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Range", i, j)
 		}
 		return nil
 	})
@@ -2043,7 +2119,7 @@ func (b *float32Builder) Range(i, j float32) *float32Builder {
 func (b *float32Builder) Min(i float32) *float32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v float32) error {
 		if v < i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Min", i)
 		}
 		return nil
 	})
@@ -2054,7 +2130,7 @@ func (b *float32Builder) Min(i float32) *float32Builder {
 func (b *float32Builder) Max(i float32) *float32Builder {
 	b.desc.Validators = append(b.desc.Validators, func(v float32) error {
 		if v > i {
-			return errors.New("value out of range")
+			return validatorError("value out of range", "Max", i)
 		}
 		return nil
 	})
@@ -2090,6 +2166,13 @@ func (b *float32Builder) Comment(c string) *float32Builder {
 	return b
 }
 
+// Deprecated marks the field as deprecated with the given reason.
+func (b *float32Builder) Deprecated(reason string) *float32Builder {
+	b.desc.Deprecated = true
+	b.desc.DeprecatedReason = reason
+	return b
+}
+
 // Optional indicates that this field is optional on create.
 // Unlike edges, fields are required by default.
 func (b *float32Builder) Optional() *float32Builder {
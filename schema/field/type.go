@@ -36,6 +36,7 @@ const (
 	TypeUint64
 	TypeFloat32
 	TypeFloat64
+	TypeDecimal
 	endTypes
 )
 
@@ -57,9 +58,14 @@ func (t Type) Float() bool {
 	return t == TypeFloat32 || t == TypeFloat64
 }
 
+// Decimal reports if the given type is a decimal type.
+func (t Type) Decimal() bool {
+	return t == TypeDecimal
+}
+
 // Integer reports if the given type is an integral type.
 func (t Type) Integer() bool {
-	return t.Numeric() && !t.Float()
+	return t.Numeric() && !t.Float() && !t.Decimal()
 }
 
 // Valid reports if the given type if known type.
@@ -173,14 +179,16 @@ var (
 		TypeUint64:  "uint64",
 		TypeFloat32: "float32",
 		TypeFloat64: "float64",
+		TypeDecimal: "string",
 	}
 	constNames = [...]string{
-		TypeJSON:  "TypeJSON",
-		TypeUUID:  "TypeUUID",
-		TypeTime:  "TypeTime",
-		TypeEnum:  "TypeEnum",
-		TypeBytes: "TypeBytes",
-		TypeOther: "TypeOther",
+		TypeJSON:    "TypeJSON",
+		TypeUUID:    "TypeUUID",
+		TypeTime:    "TypeTime",
+		TypeEnum:    "TypeEnum",
+		TypeBytes:   "TypeBytes",
+		TypeOther:   "TypeOther",
+		TypeDecimal: "TypeDecimal",
 	}
 )
 
@@ -192,10 +200,21 @@ type RType struct {
 	Kind    reflect.Kind
 	PkgPath string
 	Methods map[string]struct{ In, Out []*RType }
+	// Fields holds the exported fields of struct types that are
+	// used by JSON fields. Used for generating typed predicates.
+	Fields []*RStructField
 	// Used only for in-package checks.
 	rtype reflect.Type
 }
 
+// RStructField holds a serializable information of an exported
+// struct field. Used by the entc package.
+type RStructField struct {
+	Name string // reflect.StructField.Name
+	Key  string // JSON key of the field.
+	Type *RType
+}
+
 // TypeEqual reports if the underlying type is equal to the RType (after pointer indirections).
 func (r *RType) TypeEqual(t reflect.Type) bool {
 	tv := indirect(t)
@@ -0,0 +1,31 @@
+// Copyright 2019-present Facebook Inc. All rights reserved.
+// This source code is licensed under the Apache 2.0 license found
+// in the LICENSE file in the root directory of this source tree.
+
+package field
+
+// ValidatorError is the error that is returned by the built-in field validators
+// (e.g. MinLen, MaxLen, Match or Range). It holds the name of the validation rule
+// and its parameters, and can be extracted from the errors returned by the generated
+// builders using errors.As. For example:
+//
+//	var verr *field.ValidatorError
+//	if errors.As(err, &verr) && verr.Rule == "MaxLen" {
+//		return fmt.Errorf("must be at most %d characters", verr.Params[0])
+//	}
+//
+type ValidatorError struct {
+	Rule   string        // Name of the validation rule. e.g. MinLen.
+	Params []interface{} // Parameters of the validation rule. e.g. the length in MinLen.
+	msg    string
+}
+
+// Error implements the error interface.
+func (e *ValidatorError) Error() string {
+	return e.msg
+}
+
+// validatorError returns a new ValidatorError for the given rule.
+func validatorError(msg, rule string, params ...interface{}) error {
+	return &ValidatorError{Rule: rule, Params: params, msg: msg}
+}